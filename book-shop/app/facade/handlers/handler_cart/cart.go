@@ -0,0 +1,210 @@
+// Copyright 2023 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package handler_cart
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/cloudwego/biz-demo/book-shop/app/facade/infras/client"
+	"github.com/cloudwego/biz-demo/book-shop/app/facade/model"
+	"github.com/cloudwego/biz-demo/book-shop/kitex_gen/cwg/bookshop/order"
+	"github.com/cloudwego/biz-demo/book-shop/pkg/conf"
+	"github.com/cloudwego/biz-demo/book-shop/pkg/errno"
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/hertz-contrib/jwt"
+)
+
+// AddCartItem godoc
+// @Summary consumer adds a book to the cart
+// @Description the stock num is added to the one already in the cart, only books on sale can be added
+// @Tags cart module
+// @Accept json
+// @Produce json
+// @Param cartItemReq body model.CartItemReq true "request param to add a book to the cart"
+// @Security TokenAuth
+// @Success 200 {object} model.Response
+// @Router /cart/add [post]
+func AddCartItem(ctx context.Context, c *app.RequestContext) {
+	var itemReq model.CartItemReq
+	if err := c.BindAndValidate(&itemReq); err != nil {
+		model.SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+
+	pid, skuId, err := parseBook(itemReq.ProductId, itemReq.SkuId)
+	if err != nil {
+		model.SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+	err = client.AddCartItem(ctx, &order.AddCartItemReq{
+		UserId:    currentUserId(ctx, c),
+		ProductId: pid,
+		SkuId:     skuId,
+		StockNum:  itemReq.StockNum,
+	})
+	if err != nil {
+		model.SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+	model.SendResponse(c, errno.Success, nil)
+}
+
+// UpdateCartItem godoc
+// @Summary consumer changes the stock num of a book in the cart
+// @Description consumer changes the stock num of a book in the cart
+// @Tags cart module
+// @Accept json
+// @Produce json
+// @Param cartItemReq body model.CartItemReq true "request param to change the stock num"
+// @Security TokenAuth
+// @Success 200 {object} model.Response
+// @Router /cart/update [post]
+func UpdateCartItem(ctx context.Context, c *app.RequestContext) {
+	var itemReq model.CartItemReq
+	if err := c.BindAndValidate(&itemReq); err != nil {
+		model.SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+
+	pid, skuId, err := parseBook(itemReq.ProductId, itemReq.SkuId)
+	if err != nil {
+		model.SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+	err = client.UpdateCartItem(ctx, &order.UpdateCartItemReq{
+		UserId:    currentUserId(ctx, c),
+		ProductId: pid,
+		SkuId:     skuId,
+		StockNum:  itemReq.StockNum,
+	})
+	if err != nil {
+		model.SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+	model.SendResponse(c, errno.Success, nil)
+}
+
+// RemoveCartItem godoc
+// @Summary consumer removes a book from the cart
+// @Description consumer removes a book from the cart
+// @Tags cart module
+// @Accept json
+// @Produce json
+// @Param removeCartItemReq body model.RemoveCartItemReq true "request param to remove a book from the cart"
+// @Security TokenAuth
+// @Success 200 {object} model.Response
+// @Router /cart/remove [post]
+func RemoveCartItem(ctx context.Context, c *app.RequestContext) {
+	var removeReq model.RemoveCartItemReq
+	if err := c.BindAndValidate(&removeReq); err != nil {
+		model.SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+
+	pid, skuId, err := parseBook(removeReq.ProductId, removeReq.SkuId)
+	if err != nil {
+		model.SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+	err = client.RemoveCartItem(ctx, &order.RemoveCartItemReq{
+		UserId:    currentUserId(ctx, c),
+		ProductId: pid,
+		SkuId:     skuId,
+	})
+	if err != nil {
+		model.SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+	model.SendResponse(c, errno.Success, nil)
+}
+
+// GetCart godoc
+// @Summary consumer gets the cart
+// @Description the books in the cart with their prices now, the books no longer on sale are not available
+// @Tags cart module
+// @Produce json
+// @Security TokenAuth
+// @Success 200 {object} model.Response
+// @Router /cart/get [get]
+func GetCart(ctx context.Context, c *app.RequestContext) {
+	items, totalPrice, err := client.GetCart(ctx, currentUserId(ctx, c))
+	if err != nil {
+		model.SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+	model.SendResponse(c, errno.Success, map[string]interface{}{
+		"items":       items,
+		"total_price": totalPrice,
+	})
+}
+
+// Checkout godoc
+// @Summary consumer orders the books in the cart
+// @Description one order is created for all the books in the cart, they are taken out of the cart when the order is done
+// @Tags cart module
+// @Accept json
+// @Produce json
+// @Param checkoutReq body model.CheckoutReq true "request param to order the cart"
+// @Security TokenAuth
+// @Success 200 {object} model.Response
+// @Router /cart/checkout [post]
+func Checkout(ctx context.Context, c *app.RequestContext) {
+	var checkoutReq model.CheckoutReq
+	if err := c.BindAndValidate(&checkoutReq); err != nil {
+		model.SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+
+	fromCart := true
+	req := &order.CreateOrderReq{
+		UserId:   currentUserId(ctx, c),
+		Address:  checkoutReq.Address,
+		FromCart: &fromCart,
+	}
+	if checkoutReq.RequestToken != "" {
+		req.RequestToken = &checkoutReq.RequestToken
+	}
+	orderId, err := client.CreateOrder(ctx, req)
+	if err != nil {
+		model.SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+	model.SendResponse(c, errno.Success, map[string]interface{}{
+		"order_id": strconv.FormatInt(orderId, 10),
+	})
+}
+
+func currentUserId(ctx context.Context, c *app.RequestContext) int64 {
+	claims := jwt.ExtractClaims(ctx, c)
+	return int64(claims[conf.IdentityKey].(float64))
+}
+
+// parseBook an empty sku id is a product without skus
+func parseBook(productId, skuId string) (int64, *int64, error) {
+	pid, err := strconv.ParseInt(productId, 10, 64)
+	if err != nil {
+		return 0, nil, err
+	}
+	if skuId == "" {
+		return pid, nil, nil
+	}
+	sid, err := strconv.ParseInt(skuId, 10, 64)
+	if err != nil {
+		return 0, nil, err
+	}
+	return pid, &sid, nil
+}
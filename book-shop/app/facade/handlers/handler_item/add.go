@@ -43,6 +43,18 @@ func AddProduct(ctx context.Context, c *app.RequestContext) {
 		return
 	}
 
+	skus, err := convertSkuRequests(addReq.Skus)
+	if err != nil {
+		model.SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+	categoryIds, err := parseIds(addReq.CategoryIds)
+	if err != nil {
+		model.SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+
+	operator := shopOperator(ctx, c)
 	req := &item.AddReq{
 		Name:        addReq.Name,
 		Pic:         addReq.Pic,
@@ -51,9 +63,14 @@ func AddProduct(ctx context.Context, c *app.RequestContext) {
 			Isbn:     addReq.ISBN,
 			SpuName:  addReq.SpuName,
 			SpuPrice: addReq.SpuPrice,
+			Author:   addReq.Author,
 		},
-		Price: addReq.Price,
-		Stock: addReq.Stock,
+		Price:        addReq.Price,
+		Stock:        addReq.Stock,
+		OperatorName: &operator,
+		Skus:         skus,
+		CategoryIds:  categoryIds,
+		Tags:         addReq.Tags,
 	}
 	pid, err := client.AddProduct(ctx, req)
 	if err != nil {
@@ -0,0 +1,179 @@
+// Copyright 2023 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package handler_item
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/cloudwego/biz-demo/book-shop/app/facade/infras/client"
+	"github.com/cloudwego/biz-demo/book-shop/app/facade/model"
+	"github.com/cloudwego/biz-demo/book-shop/kitex_gen/cwg/bookshop/item"
+	"github.com/cloudwego/biz-demo/book-shop/pkg/errno"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+// CreateCategory godoc
+// @Summary create a category
+// @Description create a category under parent_id, or a top level one without it. The names of the children of a category are unique.
+// @Tags category module
+// @Accept json
+// @Produce json
+// @Param createCategoryReq body model.CreateCategoryReq true "request param of creating category"
+// @Security TokenAuth
+// @Success 200 {object} model.Response
+// @Router /item2b/category/create [post]
+func CreateCategory(ctx context.Context, c *app.RequestContext) {
+	var createReq model.CreateCategoryReq
+	if err := c.BindAndValidate(&createReq); err != nil {
+		model.SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+
+	parentId, err := parseOptionalId(createReq.ParentId)
+	if err != nil {
+		model.SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+	categoryId, err := client.CreateCategory(ctx, &item.CreateCategoryReq{
+		ParentId:  parentId,
+		Name:      createReq.Name,
+		SortOrder: createReq.SortOrder,
+	})
+	if err != nil {
+		model.SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+	model.SendResponse(c, errno.Success, map[string]interface{}{
+		"category_id": strconv.FormatInt(categoryId, 10),
+	})
+}
+
+// UpdateCategory godoc
+// @Summary update a category
+// @Description rename, reorder or move a category, a moved category takes its children along
+// @Tags category module
+// @Accept json
+// @Produce json
+// @Param updateCategoryReq body model.UpdateCategoryReq true "request param of updating category"
+// @Security TokenAuth
+// @Success 200 {object} model.Response
+// @Router /item2b/category/update [post]
+func UpdateCategory(ctx context.Context, c *app.RequestContext) {
+	var updateReq model.UpdateCategoryReq
+	if err := c.BindAndValidate(&updateReq); err != nil {
+		model.SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+
+	categoryId, err := strconv.ParseInt(updateReq.CategoryId, 10, 64)
+	if err != nil {
+		model.SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+	parentId, err := parseOptionalId(updateReq.ParentId)
+	if err != nil {
+		model.SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+	err = client.UpdateCategory(ctx, &item.UpdateCategoryReq{
+		CategoryId: categoryId,
+		Name:       updateReq.Name,
+		ParentId:   parentId,
+		SortOrder:  updateReq.SortOrder,
+	})
+	if err != nil {
+		model.SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+	model.SendResponse(c, errno.Success, nil)
+}
+
+// DeleteCategory godoc
+// @Summary delete a category
+// @Description delete a category which has neither children nor products
+// @Tags category module
+// @Accept json
+// @Produce json
+// @Param deleteCategoryReq body model.DeleteCategoryReq true "request param of deleting category"
+// @Security TokenAuth
+// @Success 200 {object} model.Response
+// @Router /item2b/category/delete [post]
+func DeleteCategory(ctx context.Context, c *app.RequestContext) {
+	var deleteReq model.DeleteCategoryReq
+	if err := c.BindAndValidate(&deleteReq); err != nil {
+		model.SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+
+	categoryId, err := strconv.ParseInt(deleteReq.CategoryId, 10, 64)
+	if err != nil {
+		model.SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+	if err := client.DeleteCategory(ctx, categoryId); err != nil {
+		model.SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+	model.SendResponse(c, errno.Success, nil)
+}
+
+// GetCategoryTree godoc
+// @Summary get the category tree
+// @Description get the top level categories with their children, every level in sort order
+// @Tags category module
+// @Produce json
+// @Security TokenAuth
+// @Success 200 {object} model.Response
+// @Router /item2b/category/tree [get]
+// @Router /item2c/category/tree [get]
+func GetCategoryTree(ctx context.Context, c *app.RequestContext) {
+	categories, err := client.GetCategoryTree(ctx)
+	if err != nil {
+		model.SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+	model.SendResponse(c, errno.Success, map[string]interface{}{
+		"categories": categories,
+	})
+}
+
+// parseIds the ids are passed as strings, nil is kept nil
+func parseIds(ids []string) ([]int64, error) {
+	if ids == nil {
+		return nil, nil
+	}
+	ret := make([]int64, 0, len(ids))
+	for _, id := range ids {
+		v, err := strconv.ParseInt(id, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, v)
+	}
+	return ret, nil
+}
+
+func parseOptionalId(id *string) (*int64, error) {
+	if id == nil {
+		return nil, nil
+	}
+	v, err := strconv.ParseInt(*id, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
@@ -48,7 +48,7 @@ func DelProduct(ctx context.Context, c *app.RequestContext) {
 		return
 	}
 
-	err = client.OperateProduct(ctx, pid, "del")
+	err = client.OperateProduct(ctx, pid, "del", shopOperator(ctx, c))
 	if err != nil {
 		model.SendResponse(c, errno.ConvertErr(err), nil)
 		return
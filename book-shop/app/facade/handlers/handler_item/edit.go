@@ -49,13 +49,16 @@ func EditProduct(ctx context.Context, c *app.RequestContext) {
 		return
 	}
 
+	operator := shopOperator(ctx, c)
 	req := &item.EditReq{
-		ProductId:   pid,
-		Name:        editReq.Name,
-		Pic:         editReq.Pic,
-		Description: editReq.Description,
-		Price:       editReq.Price,
-		Stock:       editReq.Stock,
+		ProductId:    pid,
+		Name:         editReq.Name,
+		Pic:          editReq.Pic,
+		Description:  editReq.Description,
+		Price:        editReq.Price,
+		Stock:        editReq.Stock,
+		OperatorName: &operator,
+		Version:      editReq.Version,
 	}
 	property := &item.BookProperty{}
 	if editReq.SpuPrice != nil {
@@ -67,9 +70,23 @@ func EditProduct(ctx context.Context, c *app.RequestContext) {
 	if editReq.ISBN != nil {
 		property.Isbn = *editReq.ISBN
 	}
-	if editReq.SpuPrice != nil || editReq.SpuName != nil || editReq.ISBN != nil {
+	if editReq.Author != nil {
+		property.Author = *editReq.Author
+	}
+	if editReq.SpuPrice != nil || editReq.SpuName != nil || editReq.ISBN != nil || editReq.Author != nil {
 		req.Property = property
 	}
+	if editReq.Skus != nil {
+		if req.Skus, err = convertSkuRequests(editReq.Skus); err != nil {
+			model.SendResponse(c, errno.ConvertErr(err), nil)
+			return
+		}
+	}
+	if req.CategoryIds, err = parseIds(editReq.CategoryIds); err != nil {
+		model.SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+	req.Tags = editReq.Tags
 	err = client.EditProduct(ctx, req)
 	if err != nil {
 		model.SendResponse(c, errno.ConvertErr(err), nil)
@@ -0,0 +1,110 @@
+// Copyright 2023 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package handler_item
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/cloudwego/biz-demo/book-shop/app/facade/infras/client"
+	"github.com/cloudwego/biz-demo/book-shop/app/facade/model"
+	"github.com/cloudwego/biz-demo/book-shop/kitex_gen/cwg/bookshop/item"
+	"github.com/cloudwego/biz-demo/book-shop/pkg/errno"
+	"github.com/cloudwego/biz-demo/book-shop/pkg/productio"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+// ExportProducts godoc
+// @Summary export products to a file
+// @Description stream all the products matching the filters of the list api as CSV or JSON Lines, the file can be imported again
+// @Tags product module
+// @Produce text/csv
+// @Produce application/x-ndjson
+// @Param format query string false "csv or jsonl, csv by default"
+// @Param name query string false "name"
+// @Param spu_name query string false "spu_name"
+// @Param status query int false "status"
+// @Security TokenAuth
+// @Success 200 {file} file
+// @Router /item2b/export [get]
+func ExportProducts(ctx context.Context, c *app.RequestContext) {
+	var exportReq model.ExportProductReq
+	if err := c.BindAndValidate(&exportReq); err != nil {
+		model.SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+	format := exportReq.Format
+	if format == "" {
+		format = productio.FormatCSV
+	}
+	if !productio.ValidFormat(format) {
+		model.SendResponse(c, errno.ParamErr.WithMessage(productio.ErrUnknownFormat.Error()), nil)
+		return
+	}
+
+	limit := int32(exportPageSize)
+	listReq := &item.ListReq{
+		Name:    exportReq.Name,
+		SpuName: exportReq.SpuName,
+		Status:  (*item.Status)(exportReq.Status),
+		Limit:   &limit,
+	}
+	// the first page is read before responding so that an error is still returned as json
+	firstPage, err := client.ListProduct(ctx, listReq)
+	if err != nil {
+		model.SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+
+	// the rows are encoded while the body is sent, a closed connection closes the pipe and stops the encoding
+	pr, pw := io.Pipe()
+	go func() {
+		pw.CloseWithError(writeProducts(ctx, format, pw, listReq, firstPage))
+	}()
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=products.%s", format))
+	c.SetContentType(productio.ContentType(format))
+	c.SetBodyStream(pr, -1)
+}
+
+// exportPageSize products read from the item service at a time
+const exportPageSize = 100
+
+// writeProducts write the first page and then read and write the following pages until all are exported
+func writeProducts(ctx context.Context, format string, w io.Writer, listReq *item.ListReq, page *item.ListResp) error {
+	writer, err := productio.NewWriter(format, w)
+	if err != nil {
+		return err
+	}
+	var offset int32
+	for {
+		for _, p := range page.Products {
+			if err := writer.Write(productio.NewRecord(p)); err != nil {
+				return err
+			}
+		}
+		offset += int32(len(page.Products))
+		if len(page.Products) < exportPageSize || int64(offset) >= page.Total {
+			break
+		}
+		nextOffset := offset
+		listReq.Offset = &nextOffset
+		if page, err = client.ListProduct(ctx, listReq); err != nil {
+			return err
+		}
+	}
+	return writer.Flush()
+}
@@ -0,0 +1,85 @@
+// Copyright 2023 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package handler_item
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/cloudwego/biz-demo/book-shop/app/facade/infras/client"
+	"github.com/cloudwego/biz-demo/book-shop/app/facade/model"
+	"github.com/cloudwego/biz-demo/book-shop/kitex_gen/cwg/bookshop/item"
+	"github.com/cloudwego/biz-demo/book-shop/pkg/errno"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+// GetProductHistory godoc
+// @Summary get change history of a product
+// @Description get change history of a product, newest first
+// @Tags product module
+// @Accept json
+// @Produce json
+// @Param product_id query int true "product_id"
+// @Param cursor query int false "next_cursor of the previous page"
+// @Param page_size query int false "page size, 20 by default"
+// @Security TokenAuth
+// @Success 200 {object} model.Response
+// @Router /item2b/history [get]
+func GetProductHistory(ctx context.Context, c *app.RequestContext) {
+	productIdStr := c.Query("product_id")
+	if productIdStr == "" {
+		model.SendResponse(c, errno.ConvertErr(errors.New("未传入product_id")), nil)
+		return
+	}
+
+	productId, err := strconv.ParseInt(productIdStr, 10, 64)
+	if err != nil {
+		model.SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+
+	req := &item.GetProductHistoryReq{ProductId: productId}
+	if cursorStr := c.Query("cursor"); cursorStr != "" {
+		cursor, err := strconv.ParseInt(cursorStr, 10, 64)
+		if err != nil {
+			model.SendResponse(c, errno.ConvertErr(err), nil)
+			return
+		}
+		req.Cursor = &cursor
+	}
+	if pageSizeStr := c.Query("page_size"); pageSizeStr != "" {
+		pageSize, err := strconv.ParseInt(pageSizeStr, 10, 32)
+		if err != nil {
+			model.SendResponse(c, errno.ConvertErr(err), nil)
+			return
+		}
+		ps := int32(pageSize)
+		req.PageSize = &ps
+	}
+
+	resp, err := client.GetProductHistory(ctx, req)
+	if err != nil {
+		model.SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+
+	model.SendResponse(c, errno.Success, map[string]interface{}{
+		"histories":   resp.Histories,
+		"next_cursor": resp.NextCursor,
+		"has_more":    resp.HasMore,
+	})
+}
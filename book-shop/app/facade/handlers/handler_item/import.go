@@ -0,0 +1,151 @@
+// Copyright 2023 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package handler_item
+
+import (
+	"context"
+	"errors"
+	"io"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/cloudwego/biz-demo/book-shop/app/facade/infras/client"
+	"github.com/cloudwego/biz-demo/book-shop/app/facade/model"
+	"github.com/cloudwego/biz-demo/book-shop/kitex_gen/cwg/bookshop/item"
+	"github.com/cloudwego/biz-demo/book-shop/pkg/errno"
+	"github.com/cloudwego/biz-demo/book-shop/pkg/productio"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+// importMaxFileSize the largest file accepted by the import
+const importMaxFileSize = 8 << 20
+
+// ImportProducts godoc
+// @Summary import products from a file
+// @Description import products from a CSV or JSON Lines file in background, the returned job_id is used to query the result.
+// @Description The columns are those of the export, product_id and status are ignored.
+// @Tags product module
+// @Accept multipart/form-data
+// @Produce json
+// @Param file formData file true "csv or jsonl file"
+// @Param format formData string false "csv or jsonl, taken from the file extension by default"
+// @Security TokenAuth
+// @Success 200 {object} model.Response
+// @Router /item2b/import [post]
+func ImportProducts(ctx context.Context, c *app.RequestContext) {
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		model.SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+	if fileHeader.Size > importMaxFileSize {
+		model.SendResponse(c, errno.ParamErr.WithMessage("file is too large"), nil)
+		return
+	}
+	format := c.PostForm("format")
+	if format == "" {
+		format = importFormatOfFile(fileHeader.Filename)
+	}
+	if !productio.ValidFormat(format) {
+		model.SendResponse(c, errno.ParamErr.WithMessage(productio.ErrUnknownFormat.Error()), nil)
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		model.SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+	defer file.Close()
+	content, err := io.ReadAll(file)
+	if err != nil {
+		model.SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+
+	operator := shopOperator(ctx, c)
+	jobId, err := client.ImportProducts(ctx, &item.ImportProductsReq{
+		Format:       format,
+		Content:      content,
+		OperatorName: &operator,
+	})
+	if err != nil {
+		model.SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+	model.SendResponse(c, errno.Success, map[string]interface{}{
+		"job_id": strconv.FormatInt(jobId, 10),
+	})
+}
+
+// GetImportJob godoc
+// @Summary get an import job
+// @Description get the progress of an import job with the errors of the failed rows
+// @Tags product module
+// @Accept json
+// @Produce json
+// @Param job_id query int true "job_id"
+// @Security TokenAuth
+// @Success 200 {object} model.Response
+// @Router /item2b/import/job [get]
+func GetImportJob(ctx context.Context, c *app.RequestContext) {
+	jobIdStr := c.Query("job_id")
+	if jobIdStr == "" {
+		model.SendResponse(c, errno.ConvertErr(errors.New("未传入job_id")), nil)
+		return
+	}
+
+	jobId, err := strconv.ParseInt(jobIdStr, 10, 64)
+	if err != nil {
+		model.SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+
+	job, err := client.GetImportJob(ctx, jobId)
+	if err != nil {
+		model.SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+
+	productIds := make([]string, 0, len(job.ProductIds))
+	for _, productId := range job.ProductIds {
+		productIds = append(productIds, strconv.FormatInt(productId, 10))
+	}
+	model.SendResponse(c, errno.Success, map[string]interface{}{
+		"job_id":       strconv.FormatInt(job.JobId, 10),
+		"format":       job.Format,
+		"status":       job.Status,
+		"total_rows":   job.TotalRows,
+		"success_rows": job.SuccessRows,
+		"failed_rows":  job.FailedRows,
+		"row_errors":   job.RowErrors,
+		"product_ids":  productIds,
+		"err_msg":      job.ErrMsg,
+		"create_time":  job.CreateTime,
+		"update_time":  job.UpdateTime,
+	})
+}
+
+func importFormatOfFile(filename string) string {
+	switch strings.ToLower(filepath.Ext(filename)) {
+	case ".csv":
+		return productio.FormatCSV
+	case ".jsonl", ".ndjson":
+		return productio.FormatJSONL
+	}
+	return ""
+}
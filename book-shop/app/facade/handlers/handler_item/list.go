@@ -27,7 +27,7 @@ import (
 
 // ListProduct godoc
 // @Summary get product list
-// @Description get product list
+// @Description get a page of the products matching the filters and the total count of them
 // @Tags product module
 // @Accept json
 // @Produce json
@@ -42,17 +42,35 @@ func ListProduct(ctx context.Context, c *app.RequestContext) {
 		return
 	}
 
+	categoryId, err := parseOptionalId(listReq.CategoryId)
+	if err != nil {
+		model.SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
 	req := &item.ListReq{
-		Name:    listReq.Name,
-		SpuName: listReq.SpuName,
-		Status:  (*item.Status)(listReq.Status),
+		Name:       listReq.Name,
+		SpuName:    listReq.SpuName,
+		Status:     (*item.Status)(listReq.Status),
+		MinPrice:   listReq.MinPrice,
+		MaxPrice:   listReq.MaxPrice,
+		MinStock:   listReq.MinStock,
+		MaxStock:   listReq.MaxStock,
+		SortField:  (*item.ListSortField)(listReq.SortField),
+		SortDesc:   listReq.SortDesc,
+		Limit:      listReq.Limit,
+		Offset:     listReq.Offset,
+		CategoryId: categoryId,
+		Tag:        listReq.Tag,
 	}
 
-	products, err := client.ListProduct(ctx, req)
+	resp, err := client.ListProduct(ctx, req)
 	if err != nil {
 		model.SendResponse(c, errno.ConvertErr(err), nil)
 		return
 	}
 
-	model.SendResponse(c, errno.Success, products)
+	model.SendResponse(c, errno.Success, map[string]interface{}{
+		"products": resp.Products,
+		"total":    resp.Total,
+	})
 }
@@ -0,0 +1,93 @@
+// Copyright 2023 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package handler_item
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/cloudwego/biz-demo/book-shop/app/facade/infras/client"
+	"github.com/cloudwego/biz-demo/book-shop/app/facade/model"
+	"github.com/cloudwego/biz-demo/book-shop/kitex_gen/cwg/bookshop/item"
+	"github.com/cloudwego/biz-demo/book-shop/pkg/errno"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+// ListLowStock godoc
+// @Summary list products low on stock
+// @Description list the products whose available stock is not above their low stock threshold, lowest stock first
+// @Tags product module
+// @Produce json
+// @Param limit query int false "page size, 20 by default"
+// @Param offset query int false "offset"
+// @Security TokenAuth
+// @Success 200 {object} model.Response
+// @Router /item2b/low-stock [get]
+func ListLowStock(ctx context.Context, c *app.RequestContext) {
+	var listReq model.ListLowStockReq
+	if err := c.BindAndValidate(&listReq); err != nil {
+		model.SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+
+	resp, err := client.ListLowStock(ctx, &item.ListLowStockReq{
+		Limit:  listReq.Limit,
+		Offset: listReq.Offset,
+	})
+	if err != nil {
+		model.SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+
+	model.SendResponse(c, errno.Success, map[string]interface{}{
+		"products": resp.Products,
+		"total":    resp.Total,
+	})
+}
+
+// SetLowStockThreshold godoc
+// @Summary set the low stock threshold of a product
+// @Description set the low stock threshold of a product, the default threshold applies again when threshold is not passed
+// @Tags product module
+// @Accept json
+// @Produce json
+// @Param setLowStockThresholdReq body model.SetLowStockThresholdReq true "request param of setting low stock threshold"
+// @Security TokenAuth
+// @Success 200 {object} model.Response
+// @Router /item2b/low-stock/threshold [post]
+func SetLowStockThreshold(ctx context.Context, c *app.RequestContext) {
+	var setReq model.SetLowStockThresholdReq
+	if err := c.BindAndValidate(&setReq); err != nil {
+		model.SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+
+	pid, err := strconv.ParseInt(setReq.ProductId, 10, 64)
+	if err != nil {
+		model.SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+
+	err = client.SetLowStockThreshold(ctx, &item.SetLowStockThresholdReq{
+		ProductId: pid,
+		Threshold: setReq.Threshold,
+	})
+	if err != nil {
+		model.SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+	model.SendResponse(c, errno.Success, nil)
+}
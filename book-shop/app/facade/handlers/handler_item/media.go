@@ -0,0 +1,152 @@
+// Copyright 2023 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package handler_item
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strconv"
+
+	"github.com/cloudwego/biz-demo/book-shop/app/facade/infras/client"
+	"github.com/cloudwego/biz-demo/book-shop/app/facade/model"
+	"github.com/cloudwego/biz-demo/book-shop/kitex_gen/cwg/bookshop/item"
+	"github.com/cloudwego/biz-demo/book-shop/pkg/errno"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+// mediaMaxFileSize the largest image accepted by the upload
+const mediaMaxFileSize = 5 << 20
+
+// UploadProductMedia godoc
+// @Summary upload a product image
+// @Description upload a jpeg, png or gif image to the gallery of a product, a thumbnail is generated for it.
+// @Description The first image of a gallery is its primary image, which is returned as the pic of the product.
+// @Tags product module
+// @Accept multipart/form-data
+// @Produce json
+// @Param product_id formData string true "product_id"
+// @Param file formData file true "image file"
+// @Param is_primary formData bool false "make it the primary image"
+// @Security TokenAuth
+// @Success 200 {object} model.Response
+// @Router /item2b/media/upload [post]
+func UploadProductMedia(ctx context.Context, c *app.RequestContext) {
+	productIdStr := c.PostForm("product_id")
+	if productIdStr == "" {
+		model.SendResponse(c, errno.ConvertErr(errors.New("未传入product_id")), nil)
+		return
+	}
+	pid, err := strconv.ParseInt(productIdStr, 10, 64)
+	if err != nil {
+		model.SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+	isPrimary := false
+	if isPrimaryStr := c.PostForm("is_primary"); isPrimaryStr != "" {
+		if isPrimary, err = strconv.ParseBool(isPrimaryStr); err != nil {
+			model.SendResponse(c, errno.ConvertErr(err), nil)
+			return
+		}
+	}
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		model.SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+	if fileHeader.Size > mediaMaxFileSize {
+		model.SendResponse(c, errno.ParamErr.WithMessage("file is too large"), nil)
+		return
+	}
+	file, err := fileHeader.Open()
+	if err != nil {
+		model.SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+	defer file.Close()
+	content, err := io.ReadAll(file)
+	if err != nil {
+		model.SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+
+	image, err := client.UploadProductMedia(ctx, &item.UploadProductMediaReq{
+		ProductId: pid,
+		Content:   content,
+		IsPrimary: &isPrimary,
+	})
+	if err != nil {
+		model.SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+	model.SendResponse(c, errno.Success, map[string]interface{}{
+		"image": image,
+	})
+}
+
+// SaveProductGallery godoc
+// @Summary save the gallery of a product
+// @Description order the gallery of a product and choose its primary image, the images which are not passed are deleted
+// @Tags product module
+// @Accept json
+// @Produce json
+// @Param saveProductGalleryReq body model.SaveProductGalleryReq true "request param of saving product gallery"
+// @Security TokenAuth
+// @Success 200 {object} model.Response
+// @Router /item2b/media/gallery [post]
+func SaveProductGallery(ctx context.Context, c *app.RequestContext) {
+	var saveReq model.SaveProductGalleryReq
+	if err := c.BindAndValidate(&saveReq); err != nil {
+		model.SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+
+	pid, err := strconv.ParseInt(saveReq.ProductId, 10, 64)
+	if err != nil {
+		model.SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+	req := &item.SaveProductGalleryReq{
+		ProductId: pid,
+		MediaIds:  make([]int64, 0, len(saveReq.MediaIds)),
+	}
+	for _, mediaIdStr := range saveReq.MediaIds {
+		mediaId, err := strconv.ParseInt(mediaIdStr, 10, 64)
+		if err != nil {
+			model.SendResponse(c, errno.ConvertErr(err), nil)
+			return
+		}
+		req.MediaIds = append(req.MediaIds, mediaId)
+	}
+	if saveReq.PrimaryMediaId != nil {
+		primaryMediaId, err := strconv.ParseInt(*saveReq.PrimaryMediaId, 10, 64)
+		if err != nil {
+			model.SendResponse(c, errno.ConvertErr(err), nil)
+			return
+		}
+		req.PrimaryMediaId = &primaryMediaId
+	}
+
+	gallery, err := client.SaveProductGallery(ctx, req)
+	if err != nil {
+		model.SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+	model.SendResponse(c, errno.Success, map[string]interface{}{
+		"gallery": gallery,
+	})
+}
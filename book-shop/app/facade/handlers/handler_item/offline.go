@@ -48,7 +48,7 @@ func OfflineProduct(ctx context.Context, c *app.RequestContext) {
 		return
 	}
 
-	err = client.OperateProduct(ctx, pid, "offline")
+	err = client.OperateProduct(ctx, pid, "offline", shopOperator(ctx, c))
 	if err != nil {
 		model.SendResponse(c, errno.ConvertErr(err), nil)
 		return
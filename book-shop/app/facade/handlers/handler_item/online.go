@@ -48,7 +48,7 @@ func OnlineProduct(ctx context.Context, c *app.RequestContext) {
 		return
 	}
 
-	err = client.OperateProduct(ctx, pid, "online")
+	err = client.OperateProduct(ctx, pid, "online", shopOperator(ctx, c))
 	if err != nil {
 		model.SendResponse(c, errno.ConvertErr(err), nil)
 		return
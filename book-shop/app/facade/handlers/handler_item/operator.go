@@ -0,0 +1,31 @@
+// Copyright 2023 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package handler_item
+
+import (
+	"context"
+
+	"github.com/cloudwego/biz-demo/book-shop/pkg/conf"
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/hertz-contrib/jwt"
+)
+
+// shopOperator the shop login name carried by the token, empty for tokens issued without it
+func shopOperator(ctx context.Context, c *app.RequestContext) string {
+	claims := jwt.ExtractClaims(ctx, c)
+	operator, _ := claims[conf.IdentityKey].(string)
+	return operator
+}
@@ -0,0 +1,63 @@
+// Copyright 2023 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package handler_item
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/cloudwego/biz-demo/book-shop/app/facade/infras/client"
+	"github.com/cloudwego/biz-demo/book-shop/app/facade/model"
+	"github.com/cloudwego/biz-demo/book-shop/kitex_gen/cwg/bookshop/item"
+	"github.com/cloudwego/biz-demo/book-shop/pkg/errno"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+// ReviewProduct godoc
+// @Summary review an edited product
+// @Description approve a product pending review to put it online, or reject it to take it offline
+// @Tags product module
+// @Accept json
+// @Produce json
+// @Param reviewProductReq body model.ReviewProductReq true "request param of reviewing product"
+// @Security TokenAuth
+// @Success 200 {object} model.Response
+// @Router /item2b/review [post]
+func ReviewProduct(ctx context.Context, c *app.RequestContext) {
+	var reviewReq model.ReviewProductReq
+	if err := c.BindAndValidate(&reviewReq); err != nil {
+		model.SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+
+	pid, err := strconv.ParseInt(reviewReq.ProductId, 10, 64)
+	if err != nil {
+		model.SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+
+	operator := shopOperator(ctx, c)
+	err = client.ReviewProduct(ctx, &item.ReviewReq{
+		ProductId:    pid,
+		Approved:     reviewReq.Approved,
+		OperatorName: &operator,
+	})
+	if err != nil {
+		model.SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+	model.SendResponse(c, errno.Success, nil)
+}
@@ -42,16 +42,37 @@ func SearchProduct(ctx context.Context, c *app.RequestContext) {
 		return
 	}
 
+	categoryId, err := parseOptionalId(searchReq.CategoryId)
+	if err != nil {
+		model.SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
 	req := &item.SearchReq{
 		Name:        searchReq.Name,
 		Description: searchReq.Description,
 		SpuName:     searchReq.SpuName,
+		MinPrice:    searchReq.MinPrice,
+		MaxPrice:    searchReq.MaxPrice,
+		SortField:   (*item.SearchSortField)(searchReq.SortField),
+		SortDesc:    searchReq.SortDesc,
+		PageSize:    searchReq.PageSize,
+		Cursor:      searchReq.Cursor,
+		CategoryId:  categoryId,
+		Tag:         searchReq.Tag,
 	}
-	products, err := client.SearchProduct(ctx, req)
+	resp, err := client.SearchProduct(ctx, req)
 	if err != nil {
 		model.SendResponse(c, errno.ConvertErr(err), nil)
 		return
 	}
 
-	model.SendResponse(c, errno.Success, products)
+	model.SendResponse(c, errno.Success, map[string]interface{}{
+		"products":        resp.Products,
+		"next_cursor":     resp.NextCursor,
+		"has_more":        resp.HasMore,
+		"total":           resp.Total,
+		"price_buckets":   resp.PriceBuckets,
+		"spu_name_facets": resp.SpuNameFacets,
+		"category_facets": resp.CategoryFacets,
+	})
 }
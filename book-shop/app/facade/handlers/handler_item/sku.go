@@ -0,0 +1,49 @@
+// Copyright 2023 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package handler_item
+
+import (
+	"strconv"
+
+	"github.com/cloudwego/biz-demo/book-shop/app/facade/model"
+	"github.com/cloudwego/biz-demo/book-shop/kitex_gen/cwg/bookshop/item"
+)
+
+// convertSkuRequests an empty sku_id is sent as 0, which the item service treats as a new sku
+func convertSkuRequests(skus []*model.SkuRequest) ([]*item.Sku, error) {
+	if skus == nil {
+		return nil, nil
+	}
+	ret := make([]*item.Sku, 0, len(skus))
+	for _, sku := range skus {
+		var skuId int64
+		if sku.SkuId != "" {
+			var err error
+			if skuId, err = strconv.ParseInt(sku.SkuId, 10, 64); err != nil {
+				return nil, err
+			}
+		}
+		ret = append(ret, &item.Sku{
+			SkuId:      skuId,
+			Edition:    sku.Edition,
+			Attributes: sku.Attributes,
+			Isbn:       sku.ISBN,
+			Price:      sku.Price,
+			Stock:      sku.Stock,
+		})
+	}
+	return ret, nil
+}
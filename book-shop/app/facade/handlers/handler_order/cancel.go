@@ -21,8 +21,10 @@ import (
 
 	"github.com/cloudwego/biz-demo/book-shop/app/facade/infras/client"
 	"github.com/cloudwego/biz-demo/book-shop/app/facade/model"
+	"github.com/cloudwego/biz-demo/book-shop/pkg/conf"
 	"github.com/cloudwego/biz-demo/book-shop/pkg/errno"
 	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/hertz-contrib/jwt"
 )
 
 // CancelOrder godoc
@@ -48,7 +50,10 @@ func CancelOrder(ctx context.Context, c *app.RequestContext) {
 		return
 	}
 
-	err = client.CancelOrder(ctx, orderId)
+	claims := jwt.ExtractClaims(ctx, c)
+	userID := int64(claims[conf.IdentityKey].(float64))
+
+	err = client.CancelOrder(ctx, orderId, userID)
 	if err != nil {
 		model.SendResponse(c, errno.ConvertErr(err), nil)
 		return
@@ -0,0 +1,62 @@
+// Copyright 2023 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package handler_order
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/cloudwego/biz-demo/book-shop/app/facade/infras/client"
+	"github.com/cloudwego/biz-demo/book-shop/app/facade/model"
+	"github.com/cloudwego/biz-demo/book-shop/pkg/conf"
+	"github.com/cloudwego/biz-demo/book-shop/pkg/errno"
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/hertz-contrib/jwt"
+)
+
+// ConfirmReceipt godoc
+// @Summary consumer confirms receipt
+// @Description the shipped or delivered order is completed
+// @Tags order module
+// @Accept json
+// @Produce json
+// @Param confirmReceiptReq body model.ConfirmReceiptReq true "request param to confirm the receipt of one order"
+// @Security TokenAuth
+// @Success 200 {object} model.Response
+// @Router /order/confirm [post]
+func ConfirmReceipt(ctx context.Context, c *app.RequestContext) {
+	var confirmReceiptReq model.ConfirmReceiptReq
+	if err := c.BindAndValidate(&confirmReceiptReq); err != nil {
+		model.SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+
+	orderId, err := strconv.ParseInt(confirmReceiptReq.OrderId, 10, 64)
+	if err != nil {
+		model.SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+
+	claims := jwt.ExtractClaims(ctx, c)
+	userID := int64(claims[conf.IdentityKey].(float64))
+
+	err = client.ConfirmReceipt(ctx, orderId, userID)
+	if err != nil {
+		model.SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+	model.SendResponse(c, errno.Success, nil)
+}
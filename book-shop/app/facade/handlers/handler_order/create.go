@@ -30,7 +30,7 @@ import (
 
 // CreateOrder godoc
 // @Summary consumer creates order
-// @Description consumer creates order
+// @Description consumer creates order of a single book, or of several books with lines, a resubmit with the same request_token returns the order already created
 // @Tags order module
 // @Accept json
 // @Produce json
@@ -48,22 +48,64 @@ func CreateOrder(ctx context.Context, c *app.RequestContext) {
 	claims := jwt.ExtractClaims(ctx, c)
 	userID := int64(claims[conf.IdentityKey].(float64))
 
-	pid, err := strconv.ParseInt(createReq.ProductId, 10, 64)
+	req := &order.CreateOrderReq{
+		UserId:  userID,
+		Address: createReq.Address,
+	}
+	if createReq.RequestToken != "" {
+		req.RequestToken = &createReq.RequestToken
+	}
+	if len(createReq.Lines) > 0 {
+		for _, line := range createReq.Lines {
+			lineReq, err := convertOrderLine(line)
+			if err != nil {
+				model.SendResponse(c, errno.ConvertErr(err), nil)
+				return
+			}
+			req.Lines = append(req.Lines, lineReq)
+		}
+	} else {
+		pid, err := strconv.ParseInt(createReq.ProductId, 10, 64)
+		if err != nil {
+			model.SendResponse(c, errno.ConvertErr(err), nil)
+			return
+		}
+		req.ProductId = &pid
+		req.StockNum = &createReq.StockNum
+	}
+	orderId, err := client.CreateOrder(ctx, req)
 	if err != nil {
 		model.SendResponse(c, errno.ConvertErr(err), nil)
 		return
 	}
+	model.SendResponse(c, errno.Success, map[string]interface{}{
+		"order_id": strconv.FormatInt(orderId, 10),
+	})
+}
 
-	req := &order.CreateOrderReq{
-		UserId:    userID,
-		Address:   createReq.Address,
+func convertOrderLine(line *model.OrderLineReq) (*order.OrderLineReq, error) {
+	pid, err := strconv.ParseInt(line.ProductId, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	ret := &order.OrderLineReq{
 		ProductId: pid,
-		StockNum:  createReq.StockNum,
+		StockNum:  line.StockNum,
+	}
+	if ret.SkuId, err = parseSkuId(line.SkuId); err != nil {
+		return nil, err
 	}
-	err = client.CreateOrder(ctx, req)
+	return ret, nil
+}
+
+// parseSkuId an empty sku id is a product without skus
+func parseSkuId(skuId string) (*int64, error) {
+	if skuId == "" {
+		return nil, nil
+	}
+	v, err := strconv.ParseInt(skuId, 10, 64)
 	if err != nil {
-		model.SendResponse(c, errno.ConvertErr(err), nil)
-		return
+		return nil, err
 	}
-	model.SendResponse(c, errno.Success, nil)
+	return &v, nil
 }
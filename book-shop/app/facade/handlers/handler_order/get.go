@@ -22,8 +22,10 @@ import (
 
 	"github.com/cloudwego/biz-demo/book-shop/app/facade/infras/client"
 	"github.com/cloudwego/biz-demo/book-shop/app/facade/model"
+	"github.com/cloudwego/biz-demo/book-shop/pkg/conf"
 	"github.com/cloudwego/biz-demo/book-shop/pkg/errno"
 	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/hertz-contrib/jwt"
 )
 
 // GetOrder godoc
@@ -49,7 +51,10 @@ func GetOrder(ctx context.Context, c *app.RequestContext) {
 		return
 	}
 
-	order, err := client.GetOrderById(ctx, orderId)
+	claims := jwt.ExtractClaims(ctx, c)
+	userID := int64(claims[conf.IdentityKey].(float64))
+
+	order, err := client.GetOrderById(ctx, orderId, userID)
 	if err != nil {
 		model.SendResponse(c, errno.ConvertErr(err), nil)
 		return
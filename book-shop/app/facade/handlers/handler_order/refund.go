@@ -0,0 +1,62 @@
+// Copyright 2023 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package handler_order
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/cloudwego/biz-demo/book-shop/app/facade/infras/client"
+	"github.com/cloudwego/biz-demo/book-shop/app/facade/model"
+	"github.com/cloudwego/biz-demo/book-shop/pkg/conf"
+	"github.com/cloudwego/biz-demo/book-shop/pkg/errno"
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/hertz-contrib/jwt"
+)
+
+// RefundOrder godoc
+// @Summary consumer refunds order
+// @Description the paid order which is not shipped is refunded and its stock is given back, a shipped order is refunded by the shop
+// @Tags order module
+// @Accept json
+// @Produce json
+// @Param refundOrderReq body model.RefundOrderReq true "request param to refund one order"
+// @Security TokenAuth
+// @Success 200 {object} model.Response
+// @Router /order/refund [post]
+func RefundOrder(ctx context.Context, c *app.RequestContext) {
+	var refundOrderReq model.RefundOrderReq
+	if err := c.BindAndValidate(&refundOrderReq); err != nil {
+		model.SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+
+	orderId, err := strconv.ParseInt(refundOrderReq.OrderId, 10, 64)
+	if err != nil {
+		model.SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+
+	claims := jwt.ExtractClaims(ctx, c)
+	userID := int64(claims[conf.IdentityKey].(float64))
+
+	err = client.RefundOrder(ctx, orderId, userID)
+	if err != nil {
+		model.SendResponse(c, errno.ConvertErr(err), nil)
+		return
+	}
+	model.SendResponse(c, errno.Success, nil)
+}
@@ -71,9 +71,9 @@ func EditProduct(ctx context.Context, req *item.EditReq) error {
 	return nil
 }
 
-func OperateProduct(ctx context.Context, productId int64, operate string) error {
+func OperateProduct(ctx context.Context, productId int64, operate, operator string) error {
 	if operate == "del" {
-		resp, err := itemClient.Delete(ctx, &item.DeleteReq{ProductId: productId})
+		resp, err := itemClient.Delete(ctx, &item.DeleteReq{ProductId: productId, OperatorName: &operator})
 		if err != nil {
 			return err
 		}
@@ -81,7 +81,7 @@ func OperateProduct(ctx context.Context, productId int64, operate string) error
 			return errno.NewErrNo(int64(resp.BaseResp.StatusCode), resp.BaseResp.StatusMessage)
 		}
 	} else if operate == "offline" {
-		resp, err := itemClient.Offline(ctx, &item.OfflineReq{ProductId: productId})
+		resp, err := itemClient.Offline(ctx, &item.OfflineReq{ProductId: productId, OperatorName: &operator})
 		if err != nil {
 			return err
 		}
@@ -89,7 +89,7 @@ func OperateProduct(ctx context.Context, productId int64, operate string) error
 			return errno.NewErrNo(int64(resp.BaseResp.StatusCode), resp.BaseResp.StatusMessage)
 		}
 	} else if operate == "online" {
-		resp, err := itemClient.Online(ctx, &item.OnlineReq{ProductId: productId})
+		resp, err := itemClient.Online(ctx, &item.OnlineReq{ProductId: productId, OperatorName: &operator})
 		if err != nil {
 			return err
 		}
@@ -101,6 +101,28 @@ func OperateProduct(ctx context.Context, productId int64, operate string) error
 	return nil
 }
 
+func ReviewProduct(ctx context.Context, req *item.ReviewReq) error {
+	resp, err := itemClient.Review(ctx, req)
+	if err != nil {
+		return err
+	}
+	if resp.BaseResp.StatusCode != 0 {
+		return errno.NewErrNo(int64(resp.BaseResp.StatusCode), resp.BaseResp.StatusMessage)
+	}
+	return nil
+}
+
+func GetLegalOperations(ctx context.Context, productId int64) ([]*item.StateOperation, error) {
+	resp, err := itemClient.GetLegalOperations(ctx, &item.GetLegalOperationsReq{ProductId: productId})
+	if err != nil {
+		return nil, err
+	}
+	if resp.BaseResp.StatusCode != 0 {
+		return nil, errno.NewErrNo(int64(resp.BaseResp.StatusCode), resp.BaseResp.StatusMessage)
+	}
+	return resp.Operations, nil
+}
+
 func GetProduct(ctx context.Context, productId int64) (*item.Product, error) {
 	resp, err := itemClient.Get(ctx, &item.GetReq{ProductId: productId})
 	if err != nil {
@@ -112,6 +134,39 @@ func GetProduct(ctx context.Context, productId int64) (*item.Product, error) {
 	return resp.Product, nil
 }
 
+func GetProductHistory(ctx context.Context, req *item.GetProductHistoryReq) (*item.GetProductHistoryResp, error) {
+	resp, err := itemClient.GetProductHistory(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.BaseResp.StatusCode != 0 {
+		return nil, errno.NewErrNo(int64(resp.BaseResp.StatusCode), resp.BaseResp.StatusMessage)
+	}
+	return resp, nil
+}
+
+func ImportProducts(ctx context.Context, req *item.ImportProductsReq) (int64, error) {
+	resp, err := itemClient.ImportProducts(ctx, req)
+	if err != nil {
+		return 0, err
+	}
+	if resp.BaseResp.StatusCode != 0 {
+		return 0, errno.NewErrNo(int64(resp.BaseResp.StatusCode), resp.BaseResp.StatusMessage)
+	}
+	return resp.JobId, nil
+}
+
+func GetImportJob(ctx context.Context, jobId int64) (*item.ImportJob, error) {
+	resp, err := itemClient.GetImportJob(ctx, &item.GetImportJobReq{JobId: jobId})
+	if err != nil {
+		return nil, err
+	}
+	if resp.BaseResp.StatusCode != 0 {
+		return nil, errno.NewErrNo(int64(resp.BaseResp.StatusCode), resp.BaseResp.StatusMessage)
+	}
+	return resp.Job, nil
+}
+
 func MGetProducts2C(ctx context.Context, productIds []int64) (map[int64]*item.Product, error) {
 	resp, err := itemClient.MGet2C(ctx, &item.MGet2CReq{
 		ProductIds: productIds,
@@ -125,7 +180,7 @@ func MGetProducts2C(ctx context.Context, productIds []int64) (map[int64]*item.Pr
 	return resp.ProductMap, nil
 }
 
-func SearchProduct(ctx context.Context, req *item.SearchReq) ([]*item.Product, error) {
+func SearchProduct(ctx context.Context, req *item.SearchReq) (*item.SearchResp, error) {
 	resp, err := itemClient.Search(ctx, req)
 	if err != nil {
 		return nil, err
@@ -133,10 +188,10 @@ func SearchProduct(ctx context.Context, req *item.SearchReq) ([]*item.Product, e
 	if resp.BaseResp.StatusCode != 0 {
 		return nil, errno.NewErrNo(int64(resp.BaseResp.StatusCode), resp.BaseResp.StatusMessage)
 	}
-	return resp.Products, nil
+	return resp, nil
 }
 
-func ListProduct(ctx context.Context, req *item.ListReq) ([]*item.Product, error) {
+func ListProduct(ctx context.Context, req *item.ListReq) (*item.ListResp, error) {
 	resp, err := itemClient.List(ctx, req)
 	if err != nil {
 		return nil, err
@@ -144,5 +199,93 @@ func ListProduct(ctx context.Context, req *item.ListReq) ([]*item.Product, error
 	if resp.BaseResp.StatusCode != 0 {
 		return nil, errno.NewErrNo(int64(resp.BaseResp.StatusCode), resp.BaseResp.StatusMessage)
 	}
-	return resp.Products, nil
+	return resp, nil
+}
+
+func ListLowStock(ctx context.Context, req *item.ListLowStockReq) (*item.ListLowStockResp, error) {
+	resp, err := itemClient.ListLowStock(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.BaseResp.StatusCode != 0 {
+		return nil, errno.NewErrNo(int64(resp.BaseResp.StatusCode), resp.BaseResp.StatusMessage)
+	}
+	return resp, nil
+}
+
+func SetLowStockThreshold(ctx context.Context, req *item.SetLowStockThresholdReq) error {
+	resp, err := itemClient.SetLowStockThreshold(ctx, req)
+	if err != nil {
+		return err
+	}
+	if resp.BaseResp.StatusCode != 0 {
+		return errno.NewErrNo(int64(resp.BaseResp.StatusCode), resp.BaseResp.StatusMessage)
+	}
+	return nil
+}
+
+func UploadProductMedia(ctx context.Context, req *item.UploadProductMediaReq) (*item.ProductImage, error) {
+	resp, err := itemClient.UploadProductMedia(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.BaseResp.StatusCode != 0 {
+		return nil, errno.NewErrNo(int64(resp.BaseResp.StatusCode), resp.BaseResp.StatusMessage)
+	}
+	return resp.Image, nil
+}
+
+func SaveProductGallery(ctx context.Context, req *item.SaveProductGalleryReq) ([]*item.ProductImage, error) {
+	resp, err := itemClient.SaveProductGallery(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.BaseResp.StatusCode != 0 {
+		return nil, errno.NewErrNo(int64(resp.BaseResp.StatusCode), resp.BaseResp.StatusMessage)
+	}
+	return resp.Gallery, nil
+}
+
+func CreateCategory(ctx context.Context, req *item.CreateCategoryReq) (int64, error) {
+	resp, err := itemClient.CreateCategory(ctx, req)
+	if err != nil {
+		return 0, err
+	}
+	if resp.BaseResp.StatusCode != 0 {
+		return 0, errno.NewErrNo(int64(resp.BaseResp.StatusCode), resp.BaseResp.StatusMessage)
+	}
+	return resp.CategoryId, nil
+}
+
+func UpdateCategory(ctx context.Context, req *item.UpdateCategoryReq) error {
+	resp, err := itemClient.UpdateCategory(ctx, req)
+	if err != nil {
+		return err
+	}
+	if resp.BaseResp.StatusCode != 0 {
+		return errno.NewErrNo(int64(resp.BaseResp.StatusCode), resp.BaseResp.StatusMessage)
+	}
+	return nil
+}
+
+func DeleteCategory(ctx context.Context, categoryId int64) error {
+	resp, err := itemClient.DeleteCategory(ctx, &item.DeleteCategoryReq{CategoryId: categoryId})
+	if err != nil {
+		return err
+	}
+	if resp.BaseResp.StatusCode != 0 {
+		return errno.NewErrNo(int64(resp.BaseResp.StatusCode), resp.BaseResp.StatusMessage)
+	}
+	return nil
+}
+
+func GetCategoryTree(ctx context.Context) ([]*item.Category, error) {
+	resp, err := itemClient.GetCategoryTree(ctx, &item.GetCategoryTreeReq{})
+	if err != nil {
+		return nil, err
+	}
+	if resp.BaseResp.StatusCode != 0 {
+		return nil, errno.NewErrNo(int64(resp.BaseResp.StatusCode), resp.BaseResp.StatusMessage)
+	}
+	return resp.Categories, nil
 }
@@ -49,8 +49,63 @@ func initOrderRpc() {
 	orderClient = c
 }
 
-func CreateOrder(ctx context.Context, req *order.CreateOrderReq) error {
+func CreateOrder(ctx context.Context, req *order.CreateOrderReq) (int64, error) {
 	resp, err := orderClient.CreateOrder(ctx, req)
+	if err != nil {
+		return 0, err
+	}
+	if resp.BaseResp.StatusCode != 0 {
+		return 0, errno.NewErrNo(int64(resp.BaseResp.StatusCode), resp.BaseResp.StatusMessage)
+	}
+	return resp.OrderId, nil
+}
+
+func CancelOrder(ctx context.Context, orderId, userId int64) error {
+	resp, err := orderClient.CancelOrder(ctx, &order.CancelOrderReq{OrderId: orderId, UserId: userId})
+	if err != nil {
+		return err
+	}
+	if resp.BaseResp.StatusCode != 0 {
+		return errno.NewErrNo(int64(resp.BaseResp.StatusCode), resp.BaseResp.StatusMessage)
+	}
+	return nil
+}
+
+func PayOrder(ctx context.Context, orderId, userId int64) error {
+	resp, err := orderClient.PayOrder(ctx, &order.PayOrderReq{OrderId: orderId, UserId: userId})
+	if err != nil {
+		return err
+	}
+	if resp.BaseResp.StatusCode != 0 {
+		return errno.NewErrNo(int64(resp.BaseResp.StatusCode), resp.BaseResp.StatusMessage)
+	}
+	return nil
+}
+
+func ShipOrder(ctx context.Context, orderId int64) error {
+	resp, err := orderClient.ShipOrder(ctx, &order.ShipOrderReq{OrderId: orderId})
+	if err != nil {
+		return err
+	}
+	if resp.BaseResp.StatusCode != 0 {
+		return errno.NewErrNo(int64(resp.BaseResp.StatusCode), resp.BaseResp.StatusMessage)
+	}
+	return nil
+}
+
+func DeliverOrder(ctx context.Context, orderId int64) error {
+	resp, err := orderClient.DeliverOrder(ctx, &order.DeliverOrderReq{OrderId: orderId})
+	if err != nil {
+		return err
+	}
+	if resp.BaseResp.StatusCode != 0 {
+		return errno.NewErrNo(int64(resp.BaseResp.StatusCode), resp.BaseResp.StatusMessage)
+	}
+	return nil
+}
+
+func ConfirmReceipt(ctx context.Context, orderId, userId int64) error {
+	resp, err := orderClient.ConfirmReceipt(ctx, &order.ConfirmReceiptReq{OrderId: orderId, UserId: userId})
 	if err != nil {
 		return err
 	}
@@ -60,8 +115,8 @@ func CreateOrder(ctx context.Context, req *order.CreateOrderReq) error {
 	return nil
 }
 
-func CancelOrder(ctx context.Context, orderId int64) error {
-	resp, err := orderClient.CancelOrder(ctx, &order.CancelOrderReq{OrderId: orderId})
+func RefundOrder(ctx context.Context, orderId, userId int64) error {
+	resp, err := orderClient.RefundOrder(ctx, &order.RefundOrderReq{OrderId: orderId, UserId: userId})
 	if err != nil {
 		return err
 	}
@@ -71,9 +126,21 @@ func CancelOrder(ctx context.Context, orderId int64) error {
 	return nil
 }
 
-func GetOrderById(ctx context.Context, orderId int64) (*order.OrderItem, error) {
+func ShopRefundOrder(ctx context.Context, orderId int64) error {
+	resp, err := orderClient.ShopRefundOrder(ctx, &order.ShopRefundOrderReq{OrderId: orderId})
+	if err != nil {
+		return err
+	}
+	if resp.BaseResp.StatusCode != 0 {
+		return errno.NewErrNo(int64(resp.BaseResp.StatusCode), resp.BaseResp.StatusMessage)
+	}
+	return nil
+}
+
+func GetOrderById(ctx context.Context, orderId, userId int64) (*order.OrderItem, error) {
 	resp, err := orderClient.GetOrderById(ctx, &order.GetOrderByIdReq{
 		OrderId: orderId,
+		UserId:  userId,
 	})
 	if err != nil {
 		return nil, err
@@ -97,3 +164,47 @@ func ListOrder(ctx context.Context, userId int64, status *int64) ([]*order.Order
 	}
 	return resp.Orders, nil
 }
+
+func AddCartItem(ctx context.Context, req *order.AddCartItemReq) error {
+	resp, err := orderClient.AddCartItem(ctx, req)
+	if err != nil {
+		return err
+	}
+	if resp.BaseResp.StatusCode != 0 {
+		return errno.NewErrNo(int64(resp.BaseResp.StatusCode), resp.BaseResp.StatusMessage)
+	}
+	return nil
+}
+
+func UpdateCartItem(ctx context.Context, req *order.UpdateCartItemReq) error {
+	resp, err := orderClient.UpdateCartItem(ctx, req)
+	if err != nil {
+		return err
+	}
+	if resp.BaseResp.StatusCode != 0 {
+		return errno.NewErrNo(int64(resp.BaseResp.StatusCode), resp.BaseResp.StatusMessage)
+	}
+	return nil
+}
+
+func RemoveCartItem(ctx context.Context, req *order.RemoveCartItemReq) error {
+	resp, err := orderClient.RemoveCartItem(ctx, req)
+	if err != nil {
+		return err
+	}
+	if resp.BaseResp.StatusCode != 0 {
+		return errno.NewErrNo(int64(resp.BaseResp.StatusCode), resp.BaseResp.StatusMessage)
+	}
+	return nil
+}
+
+func GetCart(ctx context.Context, userId int64) ([]*order.CartItem, int64, error) {
+	resp, err := orderClient.GetCart(ctx, &order.GetCartReq{UserId: userId})
+	if err != nil {
+		return nil, 0, err
+	}
+	if resp.BaseResp.StatusCode != 0 {
+		return nil, 0, errno.NewErrNo(int64(resp.BaseResp.StatusCode), resp.BaseResp.StatusMessage)
+	}
+	return resp.Items, resp.TotalPrice, nil
+}
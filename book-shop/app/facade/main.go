@@ -19,6 +19,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/cloudwego/biz-demo/book-shop/app/facade/handlers/handler_cart"
 	"github.com/cloudwego/biz-demo/book-shop/app/facade/handlers/handler_item"
 	"github.com/cloudwego/biz-demo/book-shop/app/facade/handlers/handler_order"
 	"github.com/cloudwego/biz-demo/book-shop/app/facade/handlers/handler_user"
@@ -73,7 +74,8 @@ func Init() {
 		Timeout:    time.Hour,
 		MaxRefresh: time.Hour,
 		PayloadFunc: func(data interface{}) jwt.MapClaims {
-			if v, ok := data.(int64); ok {
+			// the shop login name, recorded as the operator of product changes
+			if v, ok := data.(string); ok {
 				return jwt.MapClaims{
 					conf.IdentityKey: v,
 				}
@@ -117,7 +119,8 @@ func Init() {
 // @schemes http
 func main() {
 	Init()
-	h := server.Default(server.WithHostPorts(conf.FacadeServiceAddress))
+	// the body of an import or an image upload is larger than the default limit
+	h := server.Default(server.WithHostPorts(conf.FacadeServiceAddress), server.WithMaxRequestBodySize(16<<20))
 	h.Use(gzip.Gzip(gzip.DefaultCompression))
 	pprof.Register(h)
 
@@ -140,12 +143,33 @@ func main() {
 	item2BGroup.POST("/online", handler_item.OnlineProduct)
 	item2BGroup.GET("/get", handler_item.GetProduct)
 	item2BGroup.POST("/list", handler_item.ListProduct)
+	item2BGroup.GET("/history", handler_item.GetProductHistory)
+	item2BGroup.POST("/review", handler_item.ReviewProduct)
+	item2BGroup.GET("/operations", handler_item.GetLegalOperations)
+	item2BGroup.POST("/import", handler_item.ImportProducts)
+	item2BGroup.GET("/import/job", handler_item.GetImportJob)
+	item2BGroup.GET("/export", handler_item.ExportProducts)
+	item2BGroup.GET("/low-stock", handler_item.ListLowStock)
+	item2BGroup.POST("/low-stock/threshold", handler_item.SetLowStockThreshold)
+	item2BGroup.POST("/media/upload", handler_item.UploadProductMedia)
+	item2BGroup.POST("/media/gallery", handler_item.SaveProductGallery)
+	item2BGroup.POST("/category/create", handler_item.CreateCategory)
+	item2BGroup.POST("/category/update", handler_item.UpdateCategory)
+	item2BGroup.POST("/category/delete", handler_item.DeleteCategory)
+	item2BGroup.GET("/category/tree", handler_item.GetCategoryTree)
+
+	// product images kept by the local blob store
+	h.StaticFS(conf.BlobURLPath, &app.FS{
+		Root:        conf.BlobLocalDir,
+		PathRewrite: app.NewPathSlashesStripper(1),
+	})
 
 	// item-2c service
 	item2CGroup := h.Group("/item2c")
 	item2CGroup.Use(model.UserAuthMiddleware.MiddlewareFunc())
 	item2CGroup.GET("/mget", handler_item.MGetProduct2C)
 	item2CGroup.POST("/search", handler_item.SearchProduct)
+	item2CGroup.GET("/category/tree", handler_item.GetCategoryTree)
 
 	// order service
 	orderGroup := h.Group("/order")
@@ -154,6 +178,25 @@ func main() {
 	orderGroup.POST("/cancel", handler_order.CancelOrder)
 	orderGroup.POST("/list", handler_order.ListOrder)
 	orderGroup.GET("/get", handler_order.GetOrder)
+	orderGroup.POST("/pay", handler_order.PayOrder)
+	orderGroup.POST("/confirm", handler_order.ConfirmReceipt)
+	orderGroup.POST("/refund", handler_order.RefundOrder)
+
+	// order-2b service
+	order2BGroup := h.Group("/order2b")
+	order2BGroup.Use(model.ShopAuthMiddleware.MiddlewareFunc())
+	order2BGroup.POST("/ship", handler_order.ShipOrder)
+	order2BGroup.POST("/deliver", handler_order.DeliverOrder)
+	order2BGroup.POST("/refund", handler_order.ShopRefundOrder)
+
+	// cart, kept by the order service
+	cartGroup := h.Group("/cart")
+	cartGroup.Use(model.UserAuthMiddleware.MiddlewareFunc())
+	cartGroup.POST("/add", handler_cart.AddCartItem)
+	cartGroup.POST("/update", handler_cart.UpdateCartItem)
+	cartGroup.POST("/remove", handler_cart.RemoveCartItem)
+	cartGroup.GET("/get", handler_cart.GetCart)
+	cartGroup.POST("/checkout", handler_cart.Checkout)
 
 	url := swagger.URL("http://localhost:8080/swagger/doc.json") // The url pointing to API definition
 	h.GET("/swagger/*any", swagger.WrapHandler(swaggerFiles.Handler, url))
@@ -57,26 +57,49 @@ type LoginResponse struct {
 }
 
 type AddProductRequest struct {
-	Name        string `json:"name"`
-	Pic         string `json:"pic"`
-	Description string `json:"description"`
-	ISBN        string `json:"isbn"`
-	SpuName     string `json:"spu_name"`
-	SpuPrice    int64  `json:"spu_price"`
-	Price       int64  `json:"price"`
-	Stock       int64  `json:"stock"`
+	Name        string        `json:"name"` // with an isbn, the empty name, spu_name, author and description are prefilled
+	Pic         string        `json:"pic"`
+	Description string        `json:"description"`
+	ISBN        string        `json:"isbn"` // ISBN-10 or ISBN-13, saved as ISBN-13
+	SpuName     string        `json:"spu_name"`
+	Author      string        `json:"author"`
+	SpuPrice    int64         `json:"spu_price"`
+	Price       int64         `json:"price"`
+	Stock       int64         `json:"stock"`
+	Skus        []*SkuRequest `json:"skus"` // price and stock are summed up from the skus when given
+	CategoryIds []string      `json:"category_ids"`
+	Tags        []string      `json:"tags"`
+}
+
+type SkuRequest struct {
+	SkuId      string            `json:"sku_id"` // empty for a new sku
+	Edition    string            `json:"edition"`
+	Attributes map[string]string `json:"attributes"`
+	ISBN       string            `json:"isbn"`
+	Price      int64             `json:"price"`
+	Stock      int64             `json:"stock"`
 }
 
 type EditProductRequest struct {
-	ProductId   string  `json:"product_id"`
-	Name        *string `json:"name"`
-	Pic         *string `json:"pic"`
-	Description *string `json:"description"`
-	ISBN        *string `json:"isbn"`
-	SpuName     *string `json:"spu_name"`
-	SpuPrice    *int64  `json:"spu_price"`
-	Price       *int64  `json:"price"`
-	Stock       *int64  `json:"stock"`
+	ProductId   string        `json:"product_id"`
+	Name        *string       `json:"name"`
+	Pic         *string       `json:"pic"`
+	Description *string       `json:"description"`
+	ISBN        *string       `json:"isbn"`
+	SpuName     *string       `json:"spu_name"`
+	Author      *string       `json:"author"`
+	SpuPrice    *int64        `json:"spu_price"`
+	Price       *int64        `json:"price"`
+	Stock       *int64        `json:"stock"`
+	Version     int64         `json:"version"`      // version of the product when it was read
+	Skus        []*SkuRequest `json:"skus"`         // all the skus of the product, the missing ones are removed
+	CategoryIds []string      `json:"category_ids"` // all the categories of the product, kept when not passed
+	Tags        []string      `json:"tags"`         // all the tags of the product, kept when not passed
+}
+
+type ReviewProductReq struct {
+	ProductId string `json:"product_id"`
+	Approved  bool   `json:"approved"` // true to approve and put online, false to reject and take offline
 }
 
 type OperateProductReq struct {
@@ -87,17 +110,84 @@ type SearchProductReq struct {
 	Name        *string `json:"name"`
 	Description *string `json:"description"`
 	SpuName     *string `json:"spu_name"`
+	MinPrice    *int64  `json:"min_price"`
+	MaxPrice    *int64  `json:"max_price"`
+	SortField   *int64  `json:"sort_field"` // 0: relevance, 1: price, 2: stock
+	SortDesc    *bool   `json:"sort_desc"`
+	PageSize    *int32  `json:"page_size"`
+	Cursor      *string `json:"cursor"`      // next_cursor of the last page
+	CategoryId  *string `json:"category_id"` // the products of its descendants are matched as well
+	Tag         *string `json:"tag"`
 }
 
 type ListProductReq struct {
-	Name    *string `json:"name"`
-	SpuName *string `json:"spu_name"`
-	Status  *int64  `json:"status"`
+	Name       *string `json:"name"` // fuzzy match
+	SpuName    *string `json:"spu_name"`
+	Status     *int64  `json:"status"`
+	MinPrice   *int64  `json:"min_price"`
+	MaxPrice   *int64  `json:"max_price"`
+	MinStock   *int64  `json:"min_stock"`
+	MaxStock   *int64  `json:"max_stock"`
+	SortField  *int64  `json:"sort_field"` // 0: create time, 1: price, 2: stock
+	SortDesc   *bool   `json:"sort_desc"`
+	Limit      *int32  `json:"limit"` // 20 by default, 100 at most
+	Offset     *int32  `json:"offset"`
+	CategoryId *string `json:"category_id"` // the products of its descendants are matched as well
+	Tag        *string `json:"tag"`
+}
+
+type ListLowStockReq struct {
+	Limit  *int32 `query:"limit"` // 20 by default, 100 at most
+	Offset *int32 `query:"offset"`
+}
+
+type SetLowStockThresholdReq struct {
+	ProductId string `json:"product_id"`
+	Threshold *int64 `json:"threshold"` // the default threshold applies again when it is not passed
+}
+
+type SaveProductGalleryReq struct {
+	ProductId      string   `json:"product_id"`
+	MediaIds       []string `json:"media_ids"`        // all the images in order, the missing ones are removed
+	PrimaryMediaId *string  `json:"primary_media_id"` // the first image by default
+}
+
+type CreateCategoryReq struct {
+	ParentId  *string `json:"parent_id"` // a top level category when it is not passed
+	Name      string  `json:"name"`
+	SortOrder *int32  `json:"sort_order"`
+}
+
+type UpdateCategoryReq struct {
+	CategoryId string  `json:"category_id"`
+	Name       *string `json:"name"`
+	ParentId   *string `json:"parent_id"` // "0" moves it to the top level
+	SortOrder  *int32  `json:"sort_order"`
+}
+
+type DeleteCategoryReq struct {
+	CategoryId string `json:"category_id"`
+}
+
+type ExportProductReq struct {
+	Format  string  `query:"format"` // csv or jsonl
+	Name    *string `query:"name"`
+	SpuName *string `query:"spu_name"`
+	Status  *int64  `query:"status"`
 }
 
 type CreateOrderReq struct {
-	Address   string `json:"address"`
+	Address   string          `json:"address"`
+	ProductId string          `json:"product_id"` // a single book, ignored when lines are given
+	StockNum  int64           `json:"stock_num"`
+	Lines     []*OrderLineReq `json:"lines"` // several books in one order
+	// RequestToken generated by the client for each order, a resubmit with it returns the order already created
+	RequestToken string `json:"request_token"`
+}
+
+type OrderLineReq struct {
 	ProductId string `json:"product_id"`
+	SkuId     string `json:"sku_id"` // required for a product with skus
 	StockNum  int64  `json:"stock_num"`
 }
 
@@ -105,6 +195,46 @@ type CancelOrderReq struct {
 	OrderId string `json:"order_id"`
 }
 
+type PayOrderReq struct {
+	OrderId string `json:"order_id"`
+}
+
+type ShipOrderReq struct {
+	OrderId string `json:"order_id"`
+}
+
+type DeliverOrderReq struct {
+	OrderId string `json:"order_id"`
+}
+
+type ConfirmReceiptReq struct {
+	OrderId string `json:"order_id"`
+}
+
+type RefundOrderReq struct {
+	OrderId string `json:"order_id"`
+}
+
+type ShopRefundOrderReq struct {
+	OrderId string `json:"order_id"`
+}
+
 type ListOrderReq struct {
 	Status *int64 `json:"status"`
 }
+
+type CartItemReq struct {
+	ProductId string `json:"product_id"`
+	SkuId     string `json:"sku_id"`    // required for a product with skus
+	StockNum  int64  `json:"stock_num"` // added to the stock num in the cart, or the new one on update
+}
+
+type RemoveCartItemReq struct {
+	ProductId string `json:"product_id"`
+	SkuId     string `json:"sku_id"`
+}
+
+type CheckoutReq struct {
+	Address      string `json:"address"`
+	RequestToken string `json:"request_token"` // the same as the one of CreateOrderReq
+}
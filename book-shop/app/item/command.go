@@ -0,0 +1,62 @@
+// Copyright 2023 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+
+	"github.com/cloudwego/biz-demo/book-shop/app/item/infras/reindex"
+	"github.com/cloudwego/biz-demo/book-shop/app/item/infras/repository"
+)
+
+// runCommand runs the admin sub commands of the item service
+//
+//	reindex          rebuild the product index under a new version and switch the alias
+//	verify [-repair] diff t_product against the product index, rewrite the drifted docs with -repair
+func runCommand(name string, args []string) error {
+	ctx := context.Background()
+	switch name {
+	case "reindex":
+		repository.Init()
+		index, err := reindex.Rebuild(ctx, repository.DB)
+		if err != nil {
+			return err
+		}
+		log.Printf("reindex finished, current index: %s", index)
+		return nil
+	case "verify":
+		fs := flag.NewFlagSet(name, flag.ExitOnError)
+		repair := fs.Bool("repair", false, "rewrite the drifted docs from MySQL")
+		if err := fs.Parse(args); err != nil {
+			return err
+		}
+		repository.Init()
+		report, err := reindex.Verify(ctx, repository.DB, *repair)
+		if err != nil {
+			return err
+		}
+		for _, d := range report.Drifts {
+			log.Printf("product_id=%d drift=%s fields=%v", d.ProductId, d.Type, d.Fields)
+		}
+		log.Printf("verify finished, checked=%d drifted=%d repaired=%d", report.Checked, len(report.Drifts), report.Repaired)
+		return nil
+	default:
+		return fmt.Errorf("unknown command: %s", name)
+	}
+}
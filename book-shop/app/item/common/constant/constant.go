@@ -15,18 +15,23 @@
 
 package constant
 
+import "time"
+
 type ProductStatus = int64
 
 const (
 	ProductStatusOnline  ProductStatus = 0
 	ProductStatusOffline ProductStatus = 1
 	ProductStatusDelete  ProductStatus = 2
+	// ProductStatusPendingReview an edit of an online product waits for approval
+	ProductStatusPendingReview ProductStatus = 3
 )
 
 var ProductStatusDescMap = map[ProductStatus]string{
-	ProductStatusOnline:  "上架",
-	ProductStatusOffline: "下架",
-	ProductStatusDelete:  "删除",
+	ProductStatusOnline:        "上架",
+	ProductStatusOffline:       "下架",
+	ProductStatusDelete:        "删除",
+	ProductStatusPendingReview: "待审核",
 }
 
 type StateOperationType = int64
@@ -37,4 +42,157 @@ const (
 	StateOperationTypeDel     StateOperationType = 3
 	StateOperationTypeOffline StateOperationType = 4
 	StateOperationTypeOnline  StateOperationType = 5
+	StateOperationTypeApprove StateOperationType = 6
+	StateOperationTypeReject  StateOperationType = 7
+)
+
+type ProductScheduleStatus = int64
+
+const (
+	ProductScheduleStatusPending   ProductScheduleStatus = 1
+	ProductScheduleStatusRunning   ProductScheduleStatus = 2
+	ProductScheduleStatusDone      ProductScheduleStatus = 3
+	ProductScheduleStatusCancelled ProductScheduleStatus = 4
+	ProductScheduleStatusFailed    ProductScheduleStatus = 5
+)
+
+// ProductScheduleRunningTimeout a running schedule is picked up again after it, e.g. the instance crashed
+const ProductScheduleRunningTimeout = time.Minute
+
+type ProductImportJobStatus = int64
+
+const (
+	ProductImportJobStatusPending ProductImportJobStatus = 1
+	ProductImportJobStatusRunning ProductImportJobStatus = 2
+	ProductImportJobStatusDone    ProductImportJobStatus = 3
+	ProductImportJobStatusFailed  ProductImportJobStatus = 4
+)
+
+const (
+	// ProductImportMaxRows rows of one import file
+	ProductImportMaxRows = 10000
+	// ProductImportMaxRowErrors the row errors kept in a job, the failed rows are all counted
+	ProductImportMaxRowErrors = 1000
+	// ProductImportRunningTimeout a running job without progress is picked up again after it
+	ProductImportRunningTimeout = time.Minute
+)
+
+type ProductPriceKind = int64
+
+const (
+	// ProductPriceKindList a change of the list price, applied to the product when it takes effect
+	ProductPriceKindList ProductPriceKind = 1
+	// ProductPriceKindPromotion a promotional price within a time window, the list price is kept
+	ProductPriceKindPromotion ProductPriceKind = 2
+)
+
+type ProductPriceStatus = int64
+
+const (
+	ProductPriceStatusPending ProductPriceStatus = 1
+	// ProductPriceStatusRunning a scheduled list price change is being applied
+	ProductPriceStatusRunning ProductPriceStatus = 2
+	ProductPriceStatusApplied ProductPriceStatus = 3
+	// ProductPriceStatusActive a promotion has started, the search index has its price
+	ProductPriceStatusActive ProductPriceStatus = 4
+	// ProductPriceStatusEnded a promotion has ended, the search index has the list price again
+	ProductPriceStatusEnded  ProductPriceStatus = 5
+	ProductPriceStatusFailed ProductPriceStatus = 6
+)
+
+const (
+	// ProductPriceRunningTimeout a running list price change is picked up again after it
+	ProductPriceRunningTimeout      = time.Minute
+	ProductPriceHistoryDefaultLimit = 20
+	ProductPriceHistoryMaxLimit     = 100
+)
+
+const (
+	// ProductMediaMaxSize largest image accepted for upload
+	ProductMediaMaxSize = 5 << 20
+	// ProductMediaMaxCount images in the gallery of a product at most
+	ProductMediaMaxCount = 20
+	// ProductMediaThumbnailSize the thumbnails fit in a box of this size
+	ProductMediaThumbnailSize = 240
+)
+
+const (
+	// ProductCategoryMaxDepth levels of the category tree at most
+	ProductCategoryMaxDepth = 4
+	// ProductCategoryMaxCount categories of a product at most
+	ProductCategoryMaxCount = 10
+	ProductTagMaxCount      = 20
+	// ProductTagMaxLen length of t_product_tag.tag in runes
+	ProductTagMaxLen = 32
+)
+
+type SearchSortField = int64
+
+const (
+	SearchSortFieldRelevance SearchSortField = 0
+	SearchSortFieldPrice     SearchSortField = 1
+	SearchSortFieldStock     SearchSortField = 2
+)
+
+const (
+	SearchDefaultPageSize   = 20
+	SearchMaxPageSize       = 100
+	SearchSpuNameFacetSize  = 20
+	SearchCategoryFacetSize = 50
+)
+
+type ProductListSortField = int64
+
+const (
+	ProductListSortFieldCreateTime ProductListSortField = 0
+	ProductListSortFieldPrice      ProductListSortField = 1
+	ProductListSortFieldStock      ProductListSortField = 2
+)
+
+const (
+	ProductListDefaultLimit = 20
+	ProductListMaxLimit     = 100
+)
+
+const (
+	ProductHistoryDefaultPageSize = 20
+	ProductHistoryMaxPageSize     = 100
+)
+
+// SearchPriceRangeBounds bounds of the price buckets returned by search
+var SearchPriceRangeBounds = []int64{1000, 3000, 5000, 10000}
+
+type StockOpType = int64
+
+const (
+	StockOpTypeIncr StockOpType = 1
+	StockOpTypeDecr StockOpType = 2
+	// StockOpTypeBatchIncr and StockOpTypeBatchDecr the batch operations, which keep a single key for all the lines
+	StockOpTypeBatchIncr StockOpType = 3
+	StockOpTypeBatchDecr StockOpType = 4
+)
+
+// StockIdempotencyKeyMaxLen length of t_stock_idempotency_key.idempotency_key
+const StockIdempotencyKeyMaxLen = 128
+
+const (
+	// LowStockDefaultThreshold a product is low on stock when its available stock is not above the threshold,
+	// this one applies to the products without their own
+	LowStockDefaultThreshold = 10
+	// LowStockAlertBufferSize alerts waiting for the notifier, more are dropped
+	LowStockAlertBufferSize = 1024
+	LowStockNotifyTimeout   = 3 * time.Second
+)
+
+type StockReservationStatus = int64
+
+const (
+	StockReservationStatusReserved  StockReservationStatus = 1
+	StockReservationStatusConfirmed StockReservationStatus = 2
+	StockReservationStatusReleased  StockReservationStatus = 3
+)
+
+const (
+	StockReservationDefaultTTL = 15 * time.Minute
+	StockReservationMaxTTL     = 24 * time.Hour
 )
@@ -16,8 +16,15 @@
 package converter
 
 import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/cloudwego/biz-demo/book-shop/app/item/common/constant"
 	"github.com/cloudwego/biz-demo/book-shop/app/item/common/entity"
 	"github.com/cloudwego/biz-demo/book-shop/kitex_gen/cwg/bookshop/item"
+	"github.com/cloudwego/biz-demo/book-shop/pkg/errno"
+	"github.com/cloudwego/biz-demo/book-shop/pkg/isbn"
 	"github.com/cloudwego/biz-demo/book-shop/pkg/utils"
 )
 
@@ -30,19 +37,30 @@ func ConvertDTO2Entity(dto *item.Product) *entity.ProductEntity {
 		Price:       dto.Price,
 		Stock:       dto.Stock,
 		Status:      dto.Stock,
+		Version:     dto.Version,
 	}
 	if dto.Property != nil {
 		ret.Property = &entity.PropertyEntity{
 			ISBN:     dto.Property.Isbn,
 			SpuName:  dto.Property.SpuName,
 			SpuPrice: dto.Property.SpuPrice,
+			Author:   dto.Property.Author,
 		}
 	}
+	for _, sku := range dto.Skus {
+		ret.Skus = append(ret.Skus, convertSkuDTO2Entity(sku))
+	}
+	ret.CategoryIds = dto.CategoryIds
+	ret.Tags = dto.Tags
 
 	return ret
 }
 
+// ConvertAddReq2Entity the request is validated first, the product import goes through it as well
 func ConvertAddReq2Entity(req *item.AddReq) (*entity.ProductEntity, error) {
+	if err := validateAddReq(req); err != nil {
+		return nil, err
+	}
 	pid, err := utils.GenerateID()
 	if err != nil {
 		return nil, err
@@ -63,10 +81,28 @@ func ConvertAddReq2Entity(req *item.AddReq) (*entity.ProductEntity, error) {
 			ISBN:     req.Property.Isbn,
 			SpuName:  req.Property.SpuName,
 			SpuPrice: req.Property.SpuPrice,
+			Author:   req.Property.Author,
 		}
 	}
+	for _, sku := range req.Skus {
+		skuEntity := convertSkuDTO2Entity(sku)
+		if skuEntity.SkuId, err = utils.GenerateID(); err != nil {
+			return nil, err
+		}
+		ret.Skus = append(ret.Skus, skuEntity)
+	}
+	ret.SummarizeSkus()
+	if err := normalizeISBNs(ret, nil); err != nil {
+		return nil, err
+	}
+	if ret.CategoryIds, err = normalizeCategoryIds(req.CategoryIds); err != nil {
+		return nil, err
+	}
+	if ret.Tags, err = normalizeTags(req.Tags); err != nil {
+		return nil, err
+	}
 
-	return ret, err
+	return ret, nil
 }
 
 func ConvertEditReq2Entity(originEntity *entity.ProductEntity, req *item.EditReq) (*entity.ProductEntity, error) {
@@ -94,7 +130,200 @@ func ConvertEditReq2Entity(originEntity *entity.ProductEntity, req *item.EditReq
 			ISBN:     req.Property.Isbn,
 			SpuName:  req.Property.SpuName,
 			SpuPrice: req.Property.SpuPrice,
+			Author:   req.Property.Author,
+		}
+	}
+	// the skus are replaced as a whole, a sku without id is a new one
+	if req.Skus != nil {
+		targetEntity.Skus = make([]*entity.SkuEntity, 0, len(req.Skus))
+		for _, sku := range req.Skus {
+			skuEntity := convertSkuDTO2Entity(sku)
+			if skuEntity.SkuId == 0 {
+				if skuEntity.SkuId, err = utils.GenerateID(); err != nil {
+					return nil, err
+				}
+			} else if originEntity.GetSku(skuEntity.SkuId) == nil {
+				return nil, errno.ParamErr.WithMessage(fmt.Sprintf("sku %d does not belong to the product", skuEntity.SkuId))
+			}
+			targetEntity.Skus = append(targetEntity.Skus, skuEntity)
+		}
+	}
+	targetEntity.SummarizeSkus()
+	if err := normalizeISBNs(targetEntity, originEntity); err != nil {
+		return nil, err
+	}
+	// the categories and the tags are replaced as a whole as well
+	if req.CategoryIds != nil {
+		if targetEntity.CategoryIds, err = normalizeCategoryIds(req.CategoryIds); err != nil {
+			return nil, err
+		}
+	}
+	if req.Tags != nil {
+		if targetEntity.Tags, err = normalizeTags(req.Tags); err != nil {
+			return nil, err
 		}
 	}
 	return targetEntity, nil
 }
+
+// normalizeISBNs turns the isbns of the product and of its skus into ISBN-13, the skus are editions
+// of the book and can not share an isbn. On an edit the isbns origin already has are kept as they are,
+// so that a product saved before the validation can still be edited.
+func normalizeISBNs(product, origin *entity.ProductEntity) error {
+	stored := make(map[string]bool)
+	if origin != nil {
+		if origin.Property != nil {
+			stored[origin.Property.ISBN] = true
+		}
+		for _, sku := range origin.Skus {
+			stored[sku.ISBN] = true
+		}
+	}
+	normalize := func(s string) (string, error) {
+		if stored[s] {
+			return s, nil
+		}
+		return isbn.Normalize(s)
+	}
+	if product.Property != nil {
+		normalized, err := normalize(product.Property.ISBN)
+		if err != nil {
+			return errno.ParamErr.WithMessage(fmt.Sprintf("invalid isbn %q", product.Property.ISBN))
+		}
+		product.Property.ISBN = normalized
+	}
+	seen := make(map[string]bool, len(product.Skus))
+	for _, sku := range product.Skus {
+		normalized, err := normalize(sku.ISBN)
+		if err != nil {
+			return errno.ParamErr.WithMessage(fmt.Sprintf("invalid isbn %q of sku %s", sku.ISBN, sku.Edition))
+		}
+		if normalized != "" && seen[normalized] {
+			return errno.ParamErr.WithMessage(fmt.Sprintf("isbn %s is given to more than one sku", normalized))
+		}
+		seen[normalized] = true
+		sku.ISBN = normalized
+	}
+	return nil
+}
+
+// normalizeCategoryIds drops the repeated ids, the existence of the categories is checked when saved
+func normalizeCategoryIds(categoryIds []int64) ([]int64, error) {
+	ret := make([]int64, 0, len(categoryIds))
+	seen := make(map[int64]bool, len(categoryIds))
+	for _, categoryId := range categoryIds {
+		if categoryId <= 0 {
+			return nil, errno.ParamErr.WithMessage(fmt.Sprintf("invalid category %d", categoryId))
+		}
+		if !seen[categoryId] {
+			seen[categoryId] = true
+			ret = append(ret, categoryId)
+		}
+	}
+	if len(ret) > constant.ProductCategoryMaxCount {
+		return nil, errno.ParamErr.WithMessage(fmt.Sprintf("a product has %d categories at most", constant.ProductCategoryMaxCount))
+	}
+	return ret, nil
+}
+
+// normalizeTags trims the tags and drops the empty and the repeated ones
+func normalizeTags(tags []string) ([]string, error) {
+	ret := make([]string, 0, len(tags))
+	seen := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		tag = strings.TrimSpace(tag)
+		if tag == "" || seen[tag] {
+			continue
+		}
+		if utf8.RuneCountInString(tag) > constant.ProductTagMaxLen {
+			return nil, errno.ParamErr.WithMessage(fmt.Sprintf("a tag has %d characters at most", constant.ProductTagMaxLen))
+		}
+		seen[tag] = true
+		ret = append(ret, tag)
+	}
+	if len(ret) > constant.ProductTagMaxCount {
+		return nil, errno.ParamErr.WithMessage(fmt.Sprintf("a product has %d tags at most", constant.ProductTagMaxCount))
+	}
+	return ret, nil
+}
+
+// validateAddReq the name can be left out with an isbn, it is then prefilled from the book metadata
+func validateAddReq(req *item.AddReq) error {
+	if req.Property == nil {
+		return errno.ParamErr.WithMessage("property is required")
+	}
+	if strings.TrimSpace(req.Name) == "" && strings.TrimSpace(req.Property.Isbn) == "" {
+		return errno.ParamErr.WithMessage("name is required")
+	}
+	if req.Price < 0 || req.Stock < 0 || req.Property.SpuPrice < 0 {
+		return errno.ParamErr.WithMessage("price and stock can not be negative")
+	}
+	for _, sku := range req.Skus {
+		if strings.TrimSpace(sku.Edition) == "" {
+			return errno.ParamErr.WithMessage("sku edition is required")
+		}
+		if sku.Price < 0 || sku.Stock < 0 {
+			return errno.ParamErr.WithMessage("sku price and stock can not be negative")
+		}
+	}
+	return nil
+}
+
+func convertSkuDTO2Entity(dto *item.Sku) *entity.SkuEntity {
+	return &entity.SkuEntity{
+		SkuId:      dto.SkuId,
+		Edition:    dto.Edition,
+		Attributes: dto.Attributes,
+		ISBN:       dto.Isbn,
+		Price:      dto.Price,
+		Stock:      dto.Stock,
+	}
+}
+
+func ConvertSearchReq2Query(req *item.SearchReq) *entity.ProductSearchQuery {
+	ret := &entity.ProductSearchQuery{
+		Name:        req.Name,
+		Description: req.Description,
+		SpuName:     req.SpuName,
+		MinPrice:    req.MinPrice,
+		MaxPrice:    req.MaxPrice,
+		SortField:   int64(req.GetSortField()),
+		SortDesc:    req.GetSortDesc(),
+		PageSize:    int(req.GetPageSize()),
+		Cursor:      req.GetCursor(),
+		CategoryId:  req.CategoryId,
+		Tag:         req.Tag,
+	}
+	return ret
+}
+
+func ConvertListReq2Query(req *item.ListReq) *entity.ProductListQuery {
+	ret := &entity.ProductListQuery{
+		Name:       req.Name,
+		SpuName:    req.SpuName,
+		Status:     (*int64)(req.Status),
+		MinPrice:   req.MinPrice,
+		MaxPrice:   req.MaxPrice,
+		MinStock:   req.MinStock,
+		MaxStock:   req.MaxStock,
+		SortField:  int64(req.GetSortField()),
+		SortDesc:   req.GetSortDesc(),
+		Limit:      int(req.GetLimit()),
+		Offset:     int(req.GetOffset()),
+		CategoryId: req.CategoryId,
+		Tag:        req.Tag,
+	}
+	return ret
+}
+
+func ConvertStockLines2Entity(lines []*item.StockLine) []*entity.StockLineEntity {
+	ret := make([]*entity.StockLineEntity, 0, len(lines))
+	for _, line := range lines {
+		ret = append(ret, &entity.StockLineEntity{
+			ProductId: line.ProductId,
+			SkuId:     line.GetSkuId(),
+			StockNum:  line.StockNum,
+		})
+	}
+	return ret
+}
@@ -24,18 +24,192 @@ func ConvertEntity2DTO(e *entity.ProductEntity) *item.Product {
 	ret := &item.Product{
 		ProductId:   e.ProductId,
 		Name:        e.Name,
-		Pic:         e.Pic,
+		Pic:         e.PrimaryPic(),
 		Description: e.Description,
 		Price:       e.Price,
 		Stock:       e.Stock,
 		Status:      item.Status(e.Status),
+		Version:     e.Version,
+		PromoPrice:  e.GetPromoPrice(0),
+		Gallery:     ConvertMediaEntities2DTO(e.Gallery),
+		CategoryIds: e.CategoryIds,
+		Tags:        e.Tags,
 	}
 	if e.Property != nil {
 		ret.Property = &item.BookProperty{
 			Isbn:     e.Property.ISBN,
 			SpuName:  e.Property.SpuName,
 			SpuPrice: e.Property.SpuPrice,
+			Author:   e.Property.Author,
 		}
 	}
+	for _, sku := range e.Skus {
+		ret.Skus = append(ret.Skus, &item.Sku{
+			SkuId:      sku.SkuId,
+			Edition:    sku.Edition,
+			Attributes: sku.Attributes,
+			Isbn:       sku.ISBN,
+			Price:      sku.Price,
+			Stock:      sku.Stock,
+			PromoPrice: e.GetPromoPrice(sku.SkuId),
+		})
+	}
+	return ret
+}
+
+func ConvertMediaEntity2DTO(e *entity.ProductMediaEntity) *item.ProductImage {
+	return &item.ProductImage{
+		MediaId:      e.MediaId,
+		Url:          e.URL,
+		ThumbnailUrl: e.ThumbnailURL,
+		Width:        e.Width,
+		Height:       e.Height,
+		IsPrimary:    e.IsPrimary,
+		SortOrder:    e.SortOrder,
+	}
+}
+
+func ConvertMediaEntities2DTO(es []*entity.ProductMediaEntity) []*item.ProductImage {
+	ret := make([]*item.ProductImage, 0, len(es))
+	for _, e := range es {
+		ret = append(ret, ConvertMediaEntity2DTO(e))
+	}
+	return ret
+}
+
+// ConvertCategoryTree2DTO the children are converted along
+func ConvertCategoryTree2DTO(es []*entity.CategoryEntity) []*item.Category {
+	ret := make([]*item.Category, 0, len(es))
+	for _, e := range es {
+		ret = append(ret, &item.Category{
+			CategoryId: e.CategoryId,
+			ParentId:   e.ParentId,
+			Name:       e.Name,
+			SortOrder:  e.SortOrder,
+			Children:   ConvertCategoryTree2DTO(e.Children),
+		})
+	}
+	return ret
+}
+
+func ConvertSearchResult2Resp(result *entity.ProductSearchResult, resp *item.SearchResp) {
+	products := make([]*item.Product, 0)
+	for _, e := range result.Products {
+		products = append(products, ConvertEntity2DTO(e))
+	}
+	priceBuckets := make([]*item.PriceBucket, 0)
+	for _, b := range result.PriceBuckets {
+		priceBuckets = append(priceBuckets, &item.PriceBucket{
+			MinPrice: b.MinPrice,
+			MaxPrice: b.MaxPrice,
+			Count:    b.Count,
+		})
+	}
+	spuNameFacets := make([]*item.FacetBucket, 0)
+	for _, b := range result.SpuNameFacets {
+		spuNameFacets = append(spuNameFacets, &item.FacetBucket{
+			Key:   b.Key,
+			Count: b.Count,
+		})
+	}
+	categoryFacets := make([]*item.CategoryBucket, 0)
+	for _, b := range result.CategoryFacets {
+		categoryFacets = append(categoryFacets, &item.CategoryBucket{
+			CategoryId: b.CategoryId,
+			Name:       b.Name,
+			Path:       b.Path,
+			Count:      b.Count,
+		})
+	}
+	resp.Products = products
+	resp.NextCursor = result.NextCursor
+	resp.HasMore = result.HasMore
+	resp.Total = result.Total
+	resp.PriceBuckets = priceBuckets
+	resp.SpuNameFacets = spuNameFacets
+	resp.CategoryFacets = categoryFacets
+}
+
+func ConvertStockShortages2DTO(shortages []*entity.StockShortageEntity) []*item.StockShortage {
+	ret := make([]*item.StockShortage, 0, len(shortages))
+	for _, shortage := range shortages {
+		ret = append(ret, &item.StockShortage{
+			ProductId:    shortage.ProductId,
+			SkuId:        shortage.SkuId,
+			StockNum:     shortage.StockNum,
+			AvailableNum: shortage.AvailableNum,
+		})
+	}
+	return ret
+}
+
+func ConvertHistoryEntity2DTO(e *entity.ProductHistoryEntity) *item.ProductHistory {
+	ret := &item.ProductHistory{
+		Id:            e.Id,
+		ProductId:     e.ProductId,
+		OperatorName:  e.Operator,
+		OperationType: e.OperationType,
+		Changes:       make([]*item.FieldChange, 0, len(e.Changes)),
+		CreateTime:    e.CreateTime.Unix(),
+	}
+	for _, change := range e.Changes {
+		ret.Changes = append(ret.Changes, &item.FieldChange{
+			Field:  change.Field,
+			Before: change.Before,
+			After:  change.After,
+		})
+	}
+	return ret
+}
+
+func ConvertImportJobEntity2DTO(e *entity.ProductImportJobEntity) *item.ImportJob {
+	ret := &item.ImportJob{
+		JobId:       e.JobId,
+		Format:      e.Format,
+		Status:      e.Status,
+		TotalRows:   int32(e.TotalRows),
+		SuccessRows: int32(e.SuccessRows),
+		FailedRows:  int32(e.FailedRows),
+		RowErrors:   make([]*item.ImportRowError, 0, len(e.RowErrors)),
+		ProductIds:  e.ProductIds,
+		ErrMsg:      e.ErrMsg,
+		CreateTime:  e.CreateTime.Unix(),
+		UpdateTime:  e.UpdateTime.Unix(),
+	}
+	for _, rowErr := range e.RowErrors {
+		ret.RowErrors = append(ret.RowErrors, &item.ImportRowError{
+			Row:     int32(rowErr.Row),
+			Message: rowErr.Message,
+		})
+	}
+	return ret
+}
+
+func ConvertLowStockProductEntity2DTO(e *entity.LowStockProductEntity) *item.LowStockProduct {
+	return &item.LowStockProduct{
+		Product:        ConvertEntity2DTO(e.Product),
+		AvailableStock: e.AvailableStock,
+		Threshold:      e.Threshold,
+	}
+}
+
+func ConvertPriceEntity2DTO(e *entity.ProductPriceEntity) *item.PriceChange {
+	ret := &item.PriceChange{
+		PriceId:       e.PriceId,
+		ProductId:     e.ProductId,
+		SkuId:         e.SkuId,
+		Kind:          e.Kind,
+		Price:         e.Price,
+		OriginPrice:   e.OriginPrice,
+		EffectiveFrom: e.EffectiveFrom.Unix(),
+		Status:        e.Status,
+		OperatorName:  e.Operator,
+		ErrMsg:        e.ErrMsg,
+		CreateTime:    e.CreateTime.Unix(),
+	}
+	if e.EffectiveTo != nil {
+		effectiveTo := e.EffectiveTo.Unix()
+		ret.EffectiveTo = &effectiveTo
+	}
 	return ret
 }
@@ -0,0 +1,60 @@
+// Copyright 2023 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package entity
+
+import (
+	"strconv"
+	"strings"
+)
+
+// CategoryEntity a node of the category tree
+type CategoryEntity struct {
+	CategoryId int64
+	ParentId   int64
+	Name       string
+	SortOrder  int32
+	// Path the ids from the root down to the category itself joined by slashes, such as 1/5/9
+	Path     string
+	Children []*CategoryEntity
+}
+
+// JoinCategoryPath the path of a child of the category with parentPath, an empty parentPath is the root
+func JoinCategoryPath(parentPath string, categoryId int64) string {
+	if parentPath == "" {
+		return strconv.FormatInt(categoryId, 10)
+	}
+	return parentPath + "/" + strconv.FormatInt(categoryId, 10)
+}
+
+// ParseCategoryPath the ids of the path from the root down, a malformed segment is skipped
+func ParseCategoryPath(path string) []int64 {
+	ret := make([]int64, 0)
+	for _, segment := range strings.Split(path, "/") {
+		if id, err := strconv.ParseInt(segment, 10, 64); err == nil {
+			ret = append(ret, id)
+		}
+	}
+	return ret
+}
+
+// CategoryFacetEntity the number of hits under a category, its descendants included
+type CategoryFacetEntity struct {
+	CategoryId int64
+	// Name and Path are filled in from the category tree, Path is made of the names from the root down
+	Name  string
+	Path  string
+	Count int64
+}
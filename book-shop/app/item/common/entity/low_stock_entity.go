@@ -0,0 +1,42 @@
+// Copyright 2023 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package entity
+
+import "time"
+
+// StockChangeEntity the available stock of a product before and after a committed stock mutation,
+// the available stock is the total of the skus and excludes the reserved stock
+type StockChangeEntity struct {
+	ProductId int64
+	Name      string
+	BeforeNum int64
+	AfterNum  int64
+}
+
+// LowStockAlertEntity the available stock of a product has dropped to its threshold or below
+type LowStockAlertEntity struct {
+	ProductId      int64     `json:"product_id"`
+	Name           string    `json:"name"`
+	AvailableStock int64     `json:"available_stock"`
+	Threshold      int64     `json:"threshold"`
+	AlertTime      time.Time `json:"alert_time"`
+}
+
+type LowStockProductEntity struct {
+	Product        *ProductEntity
+	AvailableStock int64
+	Threshold      int64
+}
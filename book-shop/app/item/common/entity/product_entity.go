@@ -26,16 +26,157 @@ type ProductEntity struct {
 	Price       int64
 	Stock       int64
 	Status      int64
+	Version     int64
+	Skus        []*SkuEntity
+	// PromoPrices the promotional prices in effect keyed by sku id, 0 for a product without skus,
+	// they are loaded with the product and never saved with it
+	PromoPrices map[int64]int64
+	// Gallery the images of the product in order, loaded with the product and changed on their own
+	Gallery []*ProductMediaEntity
+	// CategoryIds and Tags are replaced as a whole when saved
+	CategoryIds []int64
+	Tags        []string
+	// CategoryPaths the paths of the categories of the product, loaded with the product and never saved
+	CategoryPaths []string
 }
 
 func (entity *ProductEntity) Clone() (*ProductEntity, error) {
 	ret := &ProductEntity{}
-	err := copier.Copy(ret, entity)
-	return ret, err
+	if err := copier.Copy(ret, entity); err != nil {
+		return nil, err
+	}
+	// the skus are copied by hand, so that nil attributes stay nil
+	if entity.Skus != nil {
+		ret.Skus = make([]*SkuEntity, 0, len(entity.Skus))
+		for _, sku := range entity.Skus {
+			ret.Skus = append(ret.Skus, sku.Clone())
+		}
+	}
+	return ret, nil
+}
+
+// PrimaryPic the url of the primary image of the gallery, the pic of a product without gallery
+func (entity *ProductEntity) PrimaryPic() string {
+	for _, media := range entity.Gallery {
+		if media.IsPrimary {
+			return media.URL
+		}
+	}
+	return entity.Pic
+}
+
+// CategoryAncestorIds the categories of the product with all their ancestors, which it is browsed under
+func (entity *ProductEntity) CategoryAncestorIds() []int64 {
+	ret := make([]int64, 0)
+	seen := make(map[int64]bool)
+	for _, path := range entity.CategoryPaths {
+		for _, id := range ParseCategoryPath(path) {
+			if !seen[id] {
+				seen[id] = true
+				ret = append(ret, id)
+			}
+		}
+	}
+	return ret
+}
+
+// SummarizeSkus sets the price to the lowest sku price and the stock to the sum of the sku stock,
+// a product without skus keeps its own price and stock
+func (entity *ProductEntity) SummarizeSkus() {
+	if len(entity.Skus) == 0 {
+		return
+	}
+	entity.Price = entity.Skus[0].Price
+	entity.Stock = 0
+	for _, sku := range entity.Skus {
+		if sku.Price < entity.Price {
+			entity.Price = sku.Price
+		}
+		entity.Stock += sku.Stock
+	}
+}
+
+// GetSku returns nil if the product has no sku with the id
+func (entity *ProductEntity) GetSku(skuId int64) *SkuEntity {
+	for _, sku := range entity.Skus {
+		if sku.SkuId == skuId {
+			return sku
+		}
+	}
+	return nil
+}
+
+// GetPromoPrice the promotional price in effect of the sku, or of the product when skuId is 0.
+// A product with skus is on promotion when any sku is, its promotional price is then the lowest
+// price to pay among the skus. nil is returned without a promotion.
+func (entity *ProductEntity) GetPromoPrice(skuId int64) *int64 {
+	if skuId != 0 || len(entity.Skus) == 0 {
+		if price, ok := entity.PromoPrices[skuId]; ok {
+			return &price
+		}
+		return nil
+	}
+	for _, sku := range entity.Skus {
+		if _, ok := entity.PromoPrices[sku.SkuId]; ok {
+			price := entity.EffectivePrice(0)
+			return &price
+		}
+	}
+	return nil
+}
+
+// EffectivePrice the price to pay now for the sku, or for the product when skuId is 0,
+// which is the promotional price if there is one and the list price otherwise
+func (entity *ProductEntity) EffectivePrice(skuId int64) int64 {
+	if skuId != 0 {
+		sku := entity.GetSku(skuId)
+		if sku == nil {
+			return 0
+		}
+		if price, ok := entity.PromoPrices[skuId]; ok {
+			return price
+		}
+		return sku.Price
+	}
+	if len(entity.Skus) == 0 {
+		if price, ok := entity.PromoPrices[0]; ok {
+			return price
+		}
+		return entity.Price
+	}
+	ret := entity.EffectivePrice(entity.Skus[0].SkuId)
+	for _, sku := range entity.Skus[1:] {
+		if price := entity.EffectivePrice(sku.SkuId); price < ret {
+			ret = price
+		}
+	}
+	return ret
 }
 
 type PropertyEntity struct {
-	ISBN     string
+	ISBN     string // ISBN-13, or empty
 	SpuName  string
 	SpuPrice int64
+	Author   string
+}
+
+// SkuEntity an edition of the product with its own price and stock
+type SkuEntity struct {
+	SkuId      int64
+	Edition    string
+	Attributes map[string]string
+	ISBN       string
+	Price      int64
+	Stock      int64
+}
+
+func (sku *SkuEntity) Clone() *SkuEntity {
+	ret := *sku
+	if sku.Attributes != nil {
+		ret.Attributes = make(map[string]string, len(sku.Attributes))
+		for k, v := range sku.Attributes {
+			ret.Attributes[k] = v
+		}
+	}
+	return &ret
 }
@@ -0,0 +1,46 @@
+// Copyright 2023 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package entity
+
+import "time"
+
+// ProductOperationEntity who changes a product and how, recorded in the product history
+type ProductOperationEntity struct {
+	Operator      string
+	OperationType int64
+	// PriceId the running scheduled list price change which the operation applies, it is marked
+	// applied in place of recording a new price history row
+	PriceId int64
+	// ScheduleId the running state schedule which the operation applies, it is marked done in the same transaction
+	ScheduleId int64
+	// ImportJob the import job whose row adds the product, its progress is saved in the same transaction
+	ImportJob *ProductImportJobEntity
+}
+
+type ProductHistoryEntity struct {
+	Id            int64
+	ProductId     int64
+	Operator      string
+	OperationType int64
+	Changes       []*FieldChangeEntity
+	CreateTime    time.Time
+}
+
+type FieldChangeEntity struct {
+	Field  string `json:"field"`
+	Before string `json:"before"`
+	After  string `json:"after"`
+}
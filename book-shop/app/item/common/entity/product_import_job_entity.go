@@ -0,0 +1,42 @@
+// Copyright 2023 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package entity
+
+import "time"
+
+// ProductImportJobEntity an asynchronous import of a product file, the rows before NextRow have been processed
+type ProductImportJobEntity struct {
+	JobId       int64
+	Format      string
+	Content     []byte
+	Operator    string
+	Status      int64
+	TotalRows   int
+	NextRow     int
+	SuccessRows int
+	FailedRows  int
+	RowErrors   []*ImportRowErrorEntity
+	ProductIds  []int64
+	ErrMsg      string
+	ClaimToken  int64 // set by the instance running the job, its saves are refused once another one took it over
+	CreateTime  time.Time
+	UpdateTime  time.Time
+}
+
+type ImportRowErrorEntity struct {
+	Row     int    `json:"row"`
+	Message string `json:"message"`
+}
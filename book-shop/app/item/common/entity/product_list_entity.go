@@ -0,0 +1,41 @@
+// Copyright 2023 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package entity
+
+// ProductListQuery 2B list condition, nil fields are not filtered,
+// Name is matched by LIKE and the others are exact or inclusive ranges
+type ProductListQuery struct {
+	Name      *string
+	SpuName   *string
+	Status    *int64
+	MinPrice  *int64
+	MaxPrice  *int64
+	MinStock  *int64
+	MaxStock  *int64
+	SortField int64
+	SortDesc  bool
+	Limit     int
+	Offset    int
+	// CategoryId matches the products under the category, its descendants included
+	CategoryId *int64
+	Tag        *string
+}
+
+type ProductListResult struct {
+	Products []*ProductEntity
+	// Total count of the products matching the filters, regardless of Limit and Offset
+	Total int64
+}
@@ -0,0 +1,30 @@
+// Copyright 2023 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package entity
+
+// ProductMediaEntity an image in the gallery of a product
+type ProductMediaEntity struct {
+	MediaId      int64
+	ProductId    int64
+	BlobKey      string
+	ThumbnailKey string
+	URL          string
+	ThumbnailURL string
+	Width        int32
+	Height       int32
+	SortOrder    int32
+	IsPrimary    bool
+}
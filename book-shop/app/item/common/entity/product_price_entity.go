@@ -0,0 +1,35 @@
+// Copyright 2023 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package entity
+
+import "time"
+
+// ProductPriceEntity a list price change or a promotion, SkuId is 0 for a product without skus
+type ProductPriceEntity struct {
+	PriceId       int64
+	ProductId     int64
+	SkuId         int64
+	Kind          int64
+	Price         int64
+	OriginPrice   int64
+	EffectiveFrom time.Time
+	// EffectiveTo the end of a promotion, nil for a list price change
+	EffectiveTo *time.Time
+	Status      int64
+	Operator    string
+	ErrMsg      string
+	CreateTime  time.Time
+}
@@ -0,0 +1,88 @@
+// Copyright 2023 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package entity
+
+import (
+	"encoding/base64"
+
+	"github.com/bytedance/sonic"
+)
+
+// ProductSearchQuery 2C search condition, only online products are searched
+type ProductSearchQuery struct {
+	Name        *string
+	Description *string
+	SpuName     *string
+	MinPrice    *int64
+	MaxPrice    *int64
+	SortField   int64
+	SortDesc    bool
+	PageSize    int
+	Cursor      string
+	// CategoryId matches the products under the category, its descendants included
+	CategoryId *int64
+	Tag        *string
+}
+
+type ProductSearchResult struct {
+	Products       []*ProductEntity
+	NextCursor     string
+	HasMore        bool
+	Total          int64
+	PriceBuckets   []*PriceBucketEntity
+	SpuNameFacets  []*FacetBucketEntity
+	CategoryFacets []*CategoryFacetEntity
+}
+
+// PriceBucketEntity price range [MinPrice, MaxPrice), nil means unbounded
+type PriceBucketEntity struct {
+	MinPrice *int64
+	MaxPrice *int64
+	Count    int64
+}
+
+type FacetBucketEntity struct {
+	Key   string
+	Count int64
+}
+
+// SearchCursor the sort values of the last product in a page,
+// Score is used when sorted by relevance, Value when sorted by a field.
+type SearchCursor struct {
+	Score     float64 `json:"score,omitempty"`
+	Value     int64   `json:"value,omitempty"`
+	ProductId int64   `json:"product_id"`
+}
+
+func (c *SearchCursor) Encode() (string, error) {
+	b, err := sonic.Marshal(c)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func DecodeSearchCursor(cursor string) (*SearchCursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, err
+	}
+	ret := &SearchCursor{}
+	if err := sonic.Unmarshal(b, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
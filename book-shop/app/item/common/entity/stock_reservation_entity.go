@@ -0,0 +1,29 @@
+// Copyright 2023 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package entity
+
+import "time"
+
+// StockReservationEntity a hold of stock which is either confirmed or
+// released before ExpireAt, otherwise it is released by the sweeper
+type StockReservationEntity struct {
+	ReservationId int64
+	ProductId     int64
+	SkuId         int64
+	StockNum      int64
+	Status        int64
+	ExpireAt      time.Time
+}
@@ -0,0 +1,46 @@
+// Copyright 2023 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package po
+
+import (
+	"github.com/cloudwego/biz-demo/book-shop/pkg/conf"
+	"gorm.io/gorm"
+)
+
+// ProductCategory a node of the category tree, Path is made of the ids from the root down to it
+type ProductCategory struct {
+	gorm.Model
+	CategoryId int64  `json:"category_id"`
+	ParentId   int64  `json:"parent_id"`
+	Name       string `json:"name"`
+	SortOrder  int32  `json:"sort_order"`
+	Path       string `json:"path"`
+}
+
+func (p *ProductCategory) TableName() string {
+	return conf.ProductCategoryTableName
+}
+
+// ProductCategoryRel a product is put in a category, the rows of a product are replaced as a whole
+type ProductCategoryRel struct {
+	gorm.Model
+	ProductId  int64 `json:"product_id"`
+	CategoryId int64 `json:"category_id"`
+}
+
+func (p *ProductCategoryRel) TableName() string {
+	return conf.ProductCategoryRelTableName
+}
@@ -0,0 +1,44 @@
+// Copyright 2023 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package po
+
+import (
+	"github.com/cloudwego/biz-demo/book-shop/pkg/conf"
+	"gorm.io/gorm"
+)
+
+// ProductImportJob an uploaded product file and the progress of importing it,
+// the rows before NextRow have been processed
+type ProductImportJob struct {
+	gorm.Model
+	JobId       int64  `json:"job_id"`
+	Format      string `json:"format"`
+	Content     []byte `json:"content"`
+	Operator    string `json:"operator"`
+	Status      int64  `json:"status"`
+	TotalRows   int    `json:"total_rows"`
+	NextRow     int    `json:"next_row"`
+	SuccessRows int    `json:"success_rows"`
+	FailedRows  int    `json:"failed_rows"`
+	RowErrors   string `json:"row_errors"`  // json of []*entity.ImportRowErrorEntity
+	ProductIds  string `json:"product_ids"` // json of the imported product ids
+	ErrMsg      string `json:"err_msg"`
+	ClaimToken  int64  `json:"claim_token"` // the run which holds the job
+}
+
+func (p *ProductImportJob) TableName() string {
+	return conf.ProductImportJobTableName
+}
@@ -0,0 +1,41 @@
+// Copyright 2023 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package po
+
+import (
+	"github.com/cloudwego/biz-demo/book-shop/pkg/conf"
+	"gorm.io/gorm"
+)
+
+// ProductMedia an image in the gallery of a product, the files are kept by the blob store.
+// A removed image is soft deleted until its files are collected.
+type ProductMedia struct {
+	gorm.Model
+	MediaId      int64  `json:"media_id"`
+	ProductId    int64  `json:"product_id"`
+	BlobKey      string `json:"blob_key"`
+	ThumbnailKey string `json:"thumbnail_key"`
+	Url          string `json:"url"`
+	ThumbnailUrl string `json:"thumbnail_url"`
+	Width        int32  `json:"width"`
+	Height       int32  `json:"height"`
+	SortOrder    int32  `json:"sort_order"`
+	IsPrimary    bool   `json:"is_primary"`
+}
+
+func (p *ProductMedia) TableName() string {
+	return conf.ProductMediaTableName
+}
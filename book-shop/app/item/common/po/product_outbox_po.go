@@ -0,0 +1,52 @@
+// Copyright 2023 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package po
+
+import (
+	"time"
+
+	"github.com/cloudwego/biz-demo/book-shop/pkg/conf"
+	"gorm.io/gorm"
+)
+
+// ProductOutbox is a pending change of t_product that has to be synced to ES.
+// It is written in the same transaction as the product row.
+type ProductOutbox struct {
+	gorm.Model
+	ProductId   int64     `json:"product_id"`
+	Payload     string    `json:"payload"`
+	RetryTimes  int64     `json:"retry_times"`
+	NextRetryAt time.Time `json:"next_retry_at"`
+	LastError   string    `json:"last_error"`
+}
+
+func (p *ProductOutbox) TableName() string {
+	return conf.ProductOutboxTableName
+}
+
+// ProductOutboxDeadLetter keeps the outbox events which ran out of retries.
+type ProductOutboxDeadLetter struct {
+	gorm.Model
+	OutboxId   int64  `json:"outbox_id"`
+	ProductId  int64  `json:"product_id"`
+	Payload    string `json:"payload"`
+	RetryTimes int64  `json:"retry_times"`
+	LastError  string `json:"last_error"`
+}
+
+func (p *ProductOutboxDeadLetter) TableName() string {
+	return conf.ProductOutboxDeadLetterTableName
+}
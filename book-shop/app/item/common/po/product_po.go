@@ -22,16 +22,19 @@ import (
 
 type Product struct {
 	gorm.Model
-	ProductId   int64  `json:"product_id"`
-	Name        string `json:"name"`
-	Pic         string `json:"pic"`
-	Description string `json:"description"`
-	ISBN        string `json:"isbn"`
-	SpuName     string `json:"spu_name"`
-	SpuPrice    int64  `json:"spu_price"`
-	Price       int64  `json:"price"`
-	Stock       int64  `json:"stock"`
-	Status      int64  `json:"status"`
+	ProductId     int64  `json:"product_id"`
+	Name          string `json:"name"`
+	Pic           string `json:"pic"`
+	Description   string `json:"description"`
+	ISBN          string `json:"isbn"`
+	SpuName       string `json:"spu_name"`
+	SpuPrice      int64  `json:"spu_price"`
+	Author        string `json:"author"`
+	Price         int64  `json:"price"`
+	Stock         int64  `json:"stock"`
+	ReservedStock int64  `json:"reserved_stock"` // reserved and not confirmed yet
+	Status        int64  `json:"status"`
+	Version       int64  `json:"version"` // optimistic lock, bumped by every edit and stock change
 }
 
 func (p *Product) TableName() string {
@@ -0,0 +1,44 @@
+// Copyright 2023 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package po
+
+import (
+	"time"
+
+	"github.com/cloudwego/biz-demo/book-shop/pkg/conf"
+	"gorm.io/gorm"
+)
+
+// ProductPrice a list price change or a promotion of a product or of one of its skus,
+// the applied list price changes make up the price history
+type ProductPrice struct {
+	gorm.Model
+	PriceId       int64      `json:"price_id"`
+	ProductId     int64      `json:"product_id"`
+	SkuId         int64      `json:"sku_id"`
+	Kind          int64      `json:"kind"`
+	Price         int64      `json:"price"`
+	OriginPrice   int64      `json:"origin_price"`
+	EffectiveFrom time.Time  `json:"effective_from"`
+	EffectiveTo   *time.Time `json:"effective_to"`
+	Status        int64      `json:"status"`
+	Operator      string     `json:"operator"`
+	ErrMsg        string     `json:"err_msg"`
+}
+
+func (p *ProductPrice) TableName() string {
+	return conf.ProductPriceTableName
+}
@@ -0,0 +1,39 @@
+// Copyright 2023 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package po
+
+import (
+	"github.com/cloudwego/biz-demo/book-shop/pkg/conf"
+	"gorm.io/gorm"
+)
+
+// ProductSku an edition of a product, the product row keeps the lowest sku price
+// and the sum of the sku stock so that the product level reads stay unchanged
+type ProductSku struct {
+	gorm.Model
+	SkuId         int64  `json:"sku_id"`
+	ProductId     int64  `json:"product_id"`
+	Edition       string `json:"edition"`
+	Attributes    string `json:"attributes"` // json of the edition attributes
+	ISBN          string `json:"isbn"`
+	Price         int64  `json:"price"`
+	Stock         int64  `json:"stock"`
+	ReservedStock int64  `json:"reserved_stock"` // reserved and not confirmed yet
+}
+
+func (p *ProductSku) TableName() string {
+	return conf.ProductSkuTableName
+}
@@ -0,0 +1,39 @@
+// Copyright 2023 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package po
+
+import (
+	"time"
+
+	"github.com/cloudwego/biz-demo/book-shop/pkg/conf"
+	"gorm.io/gorm"
+)
+
+// ProductStateSchedule a state operation of a product which is applied at ExecuteAt
+type ProductStateSchedule struct {
+	gorm.Model
+	ScheduleId    int64     `json:"schedule_id"`
+	ProductId     int64     `json:"product_id"`
+	OperationType int64     `json:"operation_type"`
+	ExecuteAt     time.Time `json:"execute_at"`
+	Status        int64     `json:"status"`
+	Operator      string    `json:"operator"`
+	ErrMsg        string    `json:"err_msg"`
+}
+
+func (p *ProductStateSchedule) TableName() string {
+	return conf.ProductStateScheduleTableName
+}
@@ -0,0 +1,32 @@
+// Copyright 2023 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package po
+
+import (
+	"github.com/cloudwego/biz-demo/book-shop/pkg/conf"
+	"gorm.io/gorm"
+)
+
+// ProductStockThreshold the low stock threshold of a product, the default one applies without a row
+type ProductStockThreshold struct {
+	gorm.Model
+	ProductId int64 `json:"product_id"`
+	Threshold int64 `json:"threshold"`
+}
+
+func (p *ProductStockThreshold) TableName() string {
+	return conf.ProductStockThresholdTableName
+}
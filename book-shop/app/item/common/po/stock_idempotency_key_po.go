@@ -0,0 +1,39 @@
+// Copyright 2023 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package po
+
+import (
+	"github.com/cloudwego/biz-demo/book-shop/pkg/conf"
+	"gorm.io/gorm"
+)
+
+// StockIdempotencyKey an applied stock operation, written in the same transaction as the stock update.
+// ErrMsg keeps the business failure of the first call so that a replay gets the same result.
+// A batch operation has no product, it is told by the digest of its lines instead.
+type StockIdempotencyKey struct {
+	gorm.Model
+	IdempotencyKey string `json:"idempotency_key"`
+	ProductId      int64  `json:"product_id"`
+	SkuId          int64  `json:"sku_id"`
+	StockNum       int64  `json:"stock_num"`
+	OpType         int64  `json:"op_type"`
+	ErrMsg         string `json:"err_msg"`
+	LinesDigest    string `json:"lines_digest"`
+}
+
+func (p *StockIdempotencyKey) TableName() string {
+	return conf.StockIdempotencyKeyTableName
+}
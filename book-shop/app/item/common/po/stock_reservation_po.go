@@ -0,0 +1,37 @@
+// Copyright 2023 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package po
+
+import (
+	"time"
+
+	"github.com/cloudwego/biz-demo/book-shop/pkg/conf"
+	"gorm.io/gorm"
+)
+
+type StockReservation struct {
+	gorm.Model
+	ReservationId int64     `json:"reservation_id"`
+	ProductId     int64     `json:"product_id"`
+	SkuId         int64     `json:"sku_id"` // 0 for a product without skus
+	StockNum      int64     `json:"stock_num"`
+	Status        int64     `json:"status"`
+	ExpireAt      time.Time `json:"expire_at"`
+}
+
+func (p *StockReservation) TableName() string {
+	return conf.StockReservationTableName
+}
@@ -23,5 +23,5 @@ import (
 
 type Product2CRepository interface {
 	MGetProducts2C(ctx context.Context, productIds []int64) ([]*entity.ProductEntity, error)
-	SearchProducts(ctx context.Context, name, description, spuName *string) ([]*entity.ProductEntity, error)
+	SearchProducts(ctx context.Context, query *entity.ProductSearchQuery) (*entity.ProductSearchResult, error)
 }
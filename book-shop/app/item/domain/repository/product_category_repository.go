@@ -0,0 +1,37 @@
+// Copyright 2023 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package repository
+
+import (
+	"context"
+
+	"github.com/cloudwego/biz-demo/book-shop/app/item/common/entity"
+)
+
+type ProductCategoryRepository interface {
+	// CreateCategory the parent must exist and the name must be unique among its children, the path of category is set
+	CreateCategory(ctx context.Context, category *entity.CategoryEntity) error
+
+	// UpdateCategory nil fields are kept. A category moved to another parent takes its subtree along,
+	// the products under the subtree are put into the outbox to get their new category paths.
+	UpdateCategory(ctx context.Context, categoryId int64, name *string, parentId *int64, sortOrder *int32) error
+
+	// DeleteCategory a category with children or products can not be deleted
+	DeleteCategory(ctx context.Context, categoryId int64) error
+
+	// ListCategories all the categories in sort order
+	ListCategories(ctx context.Context) ([]*entity.CategoryEntity, error)
+}
@@ -0,0 +1,47 @@
+// Copyright 2023 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/cloudwego/biz-demo/book-shop/app/item/common/entity"
+)
+
+// ErrImportJobTakenOver the job was claimed again by another run, the run holding the old claim has to stop
+var ErrImportJobTakenOver = errors.New("导入任务已被其他实例接管")
+
+type ProductImportRepository interface {
+	CreateImportJob(ctx context.Context, job *entity.ProductImportJobEntity) error
+
+	// GetImportJob the content of the job is not loaded
+	GetImportJob(ctx context.Context, jobId int64) (*entity.ProductImportJobEntity, error)
+
+	// ListRunnableImportJobIds pending jobs, and running ones which have made no progress within the timeout
+	ListRunnableImportJobIds(ctx context.Context, now time.Time, limit int) ([]int64, error)
+
+	// ClaimImportJob marks a runnable job running under claimToken and returns it with the content,
+	// nil if another instance got it first
+	ClaimImportJob(ctx context.Context, jobId, claimToken int64, now time.Time) (*entity.ProductImportJobEntity, error)
+
+	// SaveImportProgress saves the counters of a running job, which also keeps it from timing out.
+	// It and FinishImportJob return ErrImportJobTakenOver when job.ClaimToken no longer holds the job.
+	SaveImportProgress(ctx context.Context, job *entity.ProductImportJobEntity) error
+
+	FinishImportJob(ctx context.Context, job *entity.ProductImportJobEntity) error
+}
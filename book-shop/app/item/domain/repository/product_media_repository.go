@@ -0,0 +1,38 @@
+// Copyright 2023 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package repository
+
+import (
+	"context"
+
+	"github.com/cloudwego/biz-demo/book-shop/app/item/common/entity"
+)
+
+type ProductMediaRepository interface {
+	// AddMedia appends the image to the gallery of its product, the sort order and the primary flag of media are set.
+	// It is the primary image when primary is true or when the gallery has none, a full gallery is an error.
+	AddMedia(ctx context.Context, media *entity.ProductMediaEntity, primary bool) error
+
+	// SaveGallery orders the gallery of the product as mediaIds with the primary image given, the images left out
+	// are removed and their files collected later. The saved gallery is returned.
+	SaveGallery(ctx context.Context, productId int64, mediaIds []int64, primaryMediaId int64) ([]*entity.ProductMediaEntity, error)
+
+	// ListGarbageMedia removed images and the images of deleted products, whose files are to be deleted
+	ListGarbageMedia(ctx context.Context, limit int) ([]*entity.ProductMediaEntity, error)
+
+	// PurgeMedia drops the image for good once its files are deleted
+	PurgeMedia(ctx context.Context, mediaId int64) error
+}
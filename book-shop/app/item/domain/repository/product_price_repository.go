@@ -0,0 +1,47 @@
+// Copyright 2023 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/cloudwego/biz-demo/book-shop/app/item/common/entity"
+)
+
+type ProductPriceRepository interface {
+	// CreatePriceChange a promotion overlapping a pending or active one of the same sku is rejected
+	CreatePriceChange(ctx context.Context, price *entity.ProductPriceEntity) error
+
+	// ListPriceHistory the price changes and promotions of a product, newest first
+	ListPriceHistory(ctx context.Context, productId int64, limit int) ([]*entity.ProductPriceEntity, error)
+
+	// ListDueListPriceChanges pending list price changes whose time has come, and running ones which have timed out
+	ListDueListPriceChanges(ctx context.Context, now time.Time, limit int) ([]*entity.ProductPriceEntity, error)
+
+	// ClaimListPriceChange marks a due list price change running, false if another instance got it first
+	ClaimListPriceChange(ctx context.Context, priceId int64, now time.Time) (bool, error)
+
+	// FailListPriceChange a running list price change can not be applied
+	FailListPriceChange(ctx context.Context, priceId int64, errMsg string) error
+
+	// ListDuePromotions pending promotions which have to start and active ones which have to end
+	ListDuePromotions(ctx context.Context, now time.Time, limit int) ([]*entity.ProductPriceEntity, error)
+
+	// SyncPromotion moves the promotion from one status to the other and puts the product into the outbox,
+	// so that the search index gets the price in effect. Nothing is done if the status has been moved already.
+	SyncPromotion(ctx context.Context, priceId int64, fromStatus, toStatus int64) error
+}
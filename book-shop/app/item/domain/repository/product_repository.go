@@ -22,11 +22,15 @@ import (
 )
 
 type ProductRepository interface {
-	AddProduct(ctx context.Context, product *entity.ProductEntity) error
+	AddProduct(ctx context.Context, product *entity.ProductEntity, operation *entity.ProductOperationEntity) error
 
-	UpdateProduct(ctx context.Context, origin, target *entity.ProductEntity) error
+	UpdateProduct(ctx context.Context, origin, target *entity.ProductEntity, operation *entity.ProductOperationEntity) error
 
 	GetProductById(ctx context.Context, productId int64) (*entity.ProductEntity, error)
 
-	ListProducts(ctx context.Context, filterParam map[string]interface{}) ([]*entity.ProductEntity, error)
+	// ListProducts a page of the products matching the query and the total count of them
+	ListProducts(ctx context.Context, query *entity.ProductListQuery) (*entity.ProductListResult, error)
+
+	// ListProductHistory newest first, only records with id < cursor are returned when cursor > 0
+	ListProductHistory(ctx context.Context, productId, cursor int64, limit int) ([]*entity.ProductHistoryEntity, error)
 }
@@ -0,0 +1,41 @@
+// Copyright 2023 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/cloudwego/biz-demo/book-shop/app/item/common/entity"
+)
+
+type ProductScheduleRepository interface {
+	CreateSchedule(ctx context.Context, schedule *entity.ProductScheduleEntity) error
+
+	// CancelSchedule only a pending schedule can be cancelled
+	CancelSchedule(ctx context.Context, scheduleId int64) error
+
+	// CancelProductSchedules cancel all the pending schedules of a product
+	CancelProductSchedules(ctx context.Context, productId int64) error
+
+	// ListDueSchedules pending schedules whose time has come, and running ones which have timed out
+	ListDueSchedules(ctx context.Context, now time.Time, limit int) ([]*entity.ProductScheduleEntity, error)
+
+	// ClaimSchedule marks a due schedule running, false if another instance got it first
+	ClaimSchedule(ctx context.Context, scheduleId int64, now time.Time) (bool, error)
+
+	FinishSchedule(ctx context.Context, scheduleId int64, status int64, errMsg string) error
+}
@@ -15,10 +15,19 @@
 
 package repository
 
+import "sync/atomic"
+
 type RepositoryRegistry struct {
 	productRepository   ProductRepository
 	stockRepository     StockRepository
 	product2CRepository Product2CRepository
+	scheduleRepository  ProductScheduleRepository
+	importRepository    ProductImportRepository
+	priceRepository     ProductPriceRepository
+	mediaRepository     ProductMediaRepository
+	categoryRepository  ProductCategoryRepository
+	// stockChangeListener holds a StockChangeListener, it is read by every stock operation while it may be reset
+	stockChangeListener atomic.Value
 }
 
 var inst = &RepositoryRegistry{}
@@ -50,3 +59,52 @@ func (r *RepositoryRegistry) GetStockRepository() StockRepository {
 func (r *RepositoryRegistry) SetStockRepository(stockRepositoryIns StockRepository) {
 	r.stockRepository = stockRepositoryIns
 }
+
+func (r *RepositoryRegistry) GetProductScheduleRepository() ProductScheduleRepository {
+	return r.scheduleRepository
+}
+
+func (r *RepositoryRegistry) SetProductScheduleRepository(scheduleRepositoryIns ProductScheduleRepository) {
+	r.scheduleRepository = scheduleRepositoryIns
+}
+
+func (r *RepositoryRegistry) GetProductImportRepository() ProductImportRepository {
+	return r.importRepository
+}
+
+func (r *RepositoryRegistry) SetProductImportRepository(importRepositoryIns ProductImportRepository) {
+	r.importRepository = importRepositoryIns
+}
+
+func (r *RepositoryRegistry) GetProductPriceRepository() ProductPriceRepository {
+	return r.priceRepository
+}
+
+func (r *RepositoryRegistry) SetProductPriceRepository(priceRepositoryIns ProductPriceRepository) {
+	r.priceRepository = priceRepositoryIns
+}
+
+func (r *RepositoryRegistry) GetProductMediaRepository() ProductMediaRepository {
+	return r.mediaRepository
+}
+
+func (r *RepositoryRegistry) SetProductMediaRepository(mediaRepositoryIns ProductMediaRepository) {
+	r.mediaRepository = mediaRepositoryIns
+}
+
+func (r *RepositoryRegistry) GetProductCategoryRepository() ProductCategoryRepository {
+	return r.categoryRepository
+}
+
+func (r *RepositoryRegistry) SetProductCategoryRepository(categoryRepositoryIns ProductCategoryRepository) {
+	r.categoryRepository = categoryRepositoryIns
+}
+
+func (r *RepositoryRegistry) GetStockChangeListener() StockChangeListener {
+	listener, _ := r.stockChangeListener.Load().(StockChangeListener)
+	return listener
+}
+
+func (r *RepositoryRegistry) SetStockChangeListener(listener StockChangeListener) {
+	r.stockChangeListener.Store(listener)
+}
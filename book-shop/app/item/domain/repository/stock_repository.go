@@ -17,9 +17,33 @@ package repository
 
 import (
 	"context"
+	"time"
+
+	"github.com/cloudwego/biz-demo/book-shop/app/item/common/entity"
 )
 
 type StockRepository interface {
-	IncrStock(ctx context.Context, productId, stockNum int64) error // 增加库存
-	DecrStock(ctx context.Context, productId, stockNum int64) error // 减少库存
+	// IncrStock and DecrStock skuId is 0 for a product without skus
+	IncrStock(ctx context.Context, productId, skuId, stockNum int64, idempotencyKey string) error
+	DecrStock(ctx context.Context, productId, skuId, stockNum int64, idempotencyKey string) error
+
+	// BatchIncrStock and BatchDecrStock apply every line or none, the shortages are returned when nothing is applied.
+	// An empty idempotencyKey disables the replay check, a replayed shortage has no shortage lines.
+	BatchIncrStock(ctx context.Context, lines []*entity.StockLineEntity, idempotencyKey string) error
+	BatchDecrStock(ctx context.Context, lines []*entity.StockLineEntity, idempotencyKey string) ([]*entity.StockShortageEntity, error)
+
+	ReserveStock(ctx context.Context, reservation *entity.StockReservationEntity) error     // hold stock for a caller
+	ConfirmReservation(ctx context.Context, reservationId int64) error                      // take the held stock
+	ReleaseReservation(ctx context.Context, reservationId int64) error                      // give the held stock back
+	ListExpiredReservations(ctx context.Context, now time.Time, limit int) ([]int64, error) // the expired holds not released yet
+
+	SetLowStockThreshold(ctx context.Context, productId, threshold int64) error // set the low stock threshold
+	ResetLowStockThreshold(ctx context.Context, productId int64) error          // back to the default threshold
+	// MGetLowStockThresholds the thresholds set for the products, a product using the default one has no entry
+	MGetLowStockThresholds(ctx context.Context, productIds []int64) (map[int64]int64, error)
+	// ListLowStockProducts the products whose available stock is not above their threshold, lowest stock first
+	ListLowStockProducts(ctx context.Context, defaultThreshold int64, limit, offset int) ([]*entity.LowStockProductEntity, int64, error)
 }
+
+// StockChangeListener is called after a stock mutation is committed, it must not block the caller for long
+type StockChangeListener func(ctx context.Context, changes []*entity.StockChangeEntity)
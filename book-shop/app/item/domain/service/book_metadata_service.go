@@ -0,0 +1,73 @@
+// Copyright 2023 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package service
+
+import (
+	"context"
+
+	"github.com/cloudwego/biz-demo/book-shop/app/item/common/entity"
+	"github.com/cloudwego/kitex/pkg/klog"
+)
+
+// BookMetadataProvider looks a book up by its ISBN-13, nil is returned without error for an unknown book
+type BookMetadataProvider interface {
+	Lookup(ctx context.Context, isbn string) (*entity.BookMetadataEntity, error)
+}
+
+// BookMetadataService prefills the new products from the metadata of their isbn
+type BookMetadataService struct {
+	provider BookMetadataProvider
+}
+
+var bookMetadataService BookMetadataService
+
+func GetBookMetadataServiceInstance() *BookMetadataService {
+	return &bookMetadataService
+}
+
+// SetProvider called once at startup, nothing is prefilled without a provider
+func (s *BookMetadataService) SetProvider(provider BookMetadataProvider) {
+	s.provider = provider
+}
+
+// Prefill fills the spu_name, the author and the description which the product leaves empty,
+// the name defaults to the title as well. The given values are never overwritten, and a failed
+// lookup leaves the product as it is, the product is then checked as if there was no metadata.
+func (s *BookMetadataService) Prefill(ctx context.Context, product *entity.ProductEntity) {
+	if s.provider == nil || product.Property == nil || product.Property.ISBN == "" {
+		return
+	}
+	metadata, err := s.provider.Lookup(ctx, product.Property.ISBN)
+	if err != nil {
+		klog.CtxWarnf(ctx, "lookup book metadata of isbn %s failed: %v", product.Property.ISBN, err)
+		return
+	}
+	if metadata == nil {
+		return
+	}
+	if product.Property.SpuName == "" {
+		product.Property.SpuName = metadata.Title
+	}
+	if product.Property.Author == "" {
+		product.Property.Author = metadata.Author
+	}
+	if product.Description == "" {
+		product.Description = metadata.Description
+	}
+	if product.Name == "" {
+		product.Name = metadata.Title
+	}
+}
@@ -0,0 +1,149 @@
+// Copyright 2023 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/cloudwego/biz-demo/book-shop/app/item/common/constant"
+	"github.com/cloudwego/biz-demo/book-shop/app/item/common/entity"
+	"github.com/cloudwego/biz-demo/book-shop/app/item/domain/repository"
+	"github.com/cloudwego/biz-demo/book-shop/pkg/errno"
+	"github.com/cloudwego/kitex/pkg/klog"
+)
+
+// LowStockNotifier sends a low stock alert out, e.g. to the log or to a webhook
+type LowStockNotifier interface {
+	Notify(ctx context.Context, alert *entity.LowStockAlertEntity) error
+}
+
+// LowStockService checks the committed stock changes against the thresholds, an alert is sent
+// when the available stock of a product drops to its threshold. The alerts are sent in background
+// so that a slow notifier does not hold up the stock operations.
+type LowStockService struct {
+	notifier LowStockNotifier
+	alertCh  chan *entity.LowStockAlertEntity
+	stopCh   chan struct{}
+}
+
+var lowStockService LowStockService
+
+func GetLowStockServiceInstance() *LowStockService {
+	return &lowStockService
+}
+
+// Start listen to the stock changes and send the alerts with the notifier, called once at startup
+func (s *LowStockService) Start(notifier LowStockNotifier) {
+	s.notifier = notifier
+	s.alertCh = make(chan *entity.LowStockAlertEntity, constant.LowStockAlertBufferSize)
+	s.stopCh = make(chan struct{})
+	repository.GetRegistry().SetStockChangeListener(s.Evaluate)
+	go s.loop()
+}
+
+// Stop listening, the alerts not sent yet are dropped
+func (s *LowStockService) Stop() {
+	repository.GetRegistry().SetStockChangeListener(nil)
+	close(s.stopCh)
+}
+
+func (s *LowStockService) loop() {
+	for {
+		select {
+		case <-s.stopCh:
+			return
+		case alert := <-s.alertCh:
+			ctx, cancel := context.WithTimeout(context.Background(), constant.LowStockNotifyTimeout)
+			if err := s.notifier.Notify(ctx, alert); err != nil {
+				klog.CtxErrorf(ctx, "notify low stock of product %d err: %v", alert.ProductId, err)
+			}
+			cancel()
+		}
+	}
+}
+
+// Evaluate queue an alert for every product whose available stock crosses its threshold,
+// a product staying below the threshold is not alerted again until it is refilled
+func (s *LowStockService) Evaluate(ctx context.Context, changes []*entity.StockChangeEntity) {
+	decreased := make([]*entity.StockChangeEntity, 0, len(changes))
+	productIds := make([]int64, 0, len(changes))
+	for _, change := range changes {
+		if change.AfterNum < change.BeforeNum {
+			decreased = append(decreased, change)
+			productIds = append(productIds, change.ProductId)
+		}
+	}
+	if len(decreased) == 0 {
+		return
+	}
+	thresholds, err := repository.GetRegistry().GetStockRepository().MGetLowStockThresholds(ctx, productIds)
+	if err != nil {
+		klog.CtxErrorf(ctx, "get low stock thresholds of products %v err: %v", productIds, err)
+		return
+	}
+	now := time.Now()
+	for _, change := range decreased {
+		threshold, ok := thresholds[change.ProductId]
+		if !ok {
+			threshold = constant.LowStockDefaultThreshold
+		}
+		if change.BeforeNum <= threshold || change.AfterNum > threshold {
+			continue
+		}
+		alert := &entity.LowStockAlertEntity{
+			ProductId:      change.ProductId,
+			Name:           change.Name,
+			AvailableStock: change.AfterNum,
+			Threshold:      threshold,
+			AlertTime:      now,
+		}
+		select {
+		case s.alertCh <- alert:
+		default:
+			klog.CtxWarnf(ctx, "low stock alert of product %d is dropped, too many alerts are waiting", change.ProductId)
+		}
+	}
+}
+
+// SetThreshold a nil threshold resets the product to the default one
+func (s *LowStockService) SetThreshold(ctx context.Context, productId int64, threshold *int64) error {
+	if threshold != nil && *threshold < 0 {
+		return errno.ParamErr.WithMessage("threshold can not be negative")
+	}
+	if _, err := repository.GetRegistry().GetProductRepository().GetProductById(ctx, productId); err != nil {
+		return err
+	}
+	stockRepo := repository.GetRegistry().GetStockRepository()
+	if threshold == nil {
+		return stockRepo.ResetLowStockThreshold(ctx, productId)
+	}
+	return stockRepo.SetLowStockThreshold(ctx, productId, *threshold)
+}
+
+// ListLowStockProducts a page of the products at or below their threshold, lowest available stock first
+func (s *LowStockService) ListLowStockProducts(ctx context.Context, limit, offset int) ([]*entity.LowStockProductEntity, int64, error) {
+	if limit <= 0 {
+		limit = constant.ProductListDefaultLimit
+	}
+	if limit > constant.ProductListMaxLimit {
+		limit = constant.ProductListMaxLimit
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	return repository.GetRegistry().GetStockRepository().ListLowStockProducts(ctx, constant.LowStockDefaultThreshold, limit, offset)
+}
@@ -0,0 +1,147 @@
+// Copyright 2023 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package service
+
+import (
+	"context"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/cloudwego/biz-demo/book-shop/app/item/common/entity"
+	"github.com/cloudwego/biz-demo/book-shop/app/item/domain/repository"
+	"github.com/cloudwego/biz-demo/book-shop/pkg/errno"
+	"github.com/cloudwego/biz-demo/book-shop/pkg/utils"
+)
+
+// categoryNameMaxLen length of t_product_category.name in runes
+const categoryNameMaxLen = 64
+
+// ProductCategoryService the category tree, the products are put into categories when they are added or edited
+type ProductCategoryService struct{}
+
+var productCategoryService ProductCategoryService
+
+func GetProductCategoryServiceInstance() *ProductCategoryService {
+	return &productCategoryService
+}
+
+func (s *ProductCategoryService) CreateCategory(ctx context.Context, parentId int64, name string, sortOrder int32) (int64, error) {
+	name, err := normalizeCategoryName(name)
+	if err != nil {
+		return 0, err
+	}
+	categoryId, err := utils.GenerateID()
+	if err != nil {
+		return 0, err
+	}
+	err = repository.GetRegistry().GetProductCategoryRepository().CreateCategory(ctx, &entity.CategoryEntity{
+		CategoryId: categoryId,
+		ParentId:   parentId,
+		Name:       name,
+		SortOrder:  sortOrder,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return categoryId, nil
+}
+
+// UpdateCategory nil fields are kept, a parentId of 0 makes it a top level category
+func (s *ProductCategoryService) UpdateCategory(ctx context.Context, categoryId int64, name *string, parentId *int64,
+	sortOrder *int32,
+) error {
+	if name != nil {
+		normalized, err := normalizeCategoryName(*name)
+		if err != nil {
+			return err
+		}
+		name = &normalized
+	}
+	return repository.GetRegistry().GetProductCategoryRepository().UpdateCategory(ctx, categoryId, name, parentId, sortOrder)
+}
+
+func (s *ProductCategoryService) DeleteCategory(ctx context.Context, categoryId int64) error {
+	return repository.GetRegistry().GetProductCategoryRepository().DeleteCategory(ctx, categoryId)
+}
+
+// GetCategoryTree the top level categories with their children, every level in sort order
+func (s *ProductCategoryService) GetCategoryTree(ctx context.Context) ([]*entity.CategoryEntity, error) {
+	categories, err := repository.GetRegistry().GetProductCategoryRepository().ListCategories(ctx)
+	if err != nil {
+		return nil, err
+	}
+	categoryMap := make(map[int64]*entity.CategoryEntity, len(categories))
+	for _, category := range categories {
+		category.Children = make([]*entity.CategoryEntity, 0)
+		categoryMap[category.CategoryId] = category
+	}
+	roots := make([]*entity.CategoryEntity, 0)
+	for _, category := range categories {
+		if parent, ok := categoryMap[category.ParentId]; ok {
+			parent.Children = append(parent.Children, category)
+		} else {
+			roots = append(roots, category)
+		}
+	}
+	return roots, nil
+}
+
+// FillCategoryFacets sets the names and the name paths of the facets, the facets of deleted categories are dropped
+func (s *ProductCategoryService) FillCategoryFacets(ctx context.Context, facets []*entity.CategoryFacetEntity) ([]*entity.CategoryFacetEntity, error) {
+	if len(facets) == 0 {
+		return facets, nil
+	}
+	categories, err := repository.GetRegistry().GetProductCategoryRepository().ListCategories(ctx)
+	if err != nil {
+		return nil, err
+	}
+	categoryMap := make(map[int64]*entity.CategoryEntity, len(categories))
+	for _, category := range categories {
+		categoryMap[category.CategoryId] = category
+	}
+	ret := make([]*entity.CategoryFacetEntity, 0, len(facets))
+	for _, facet := range facets {
+		category, ok := categoryMap[facet.CategoryId]
+		if !ok {
+			continue
+		}
+		names := make([]string, 0)
+		for _, id := range entity.ParseCategoryPath(category.Path) {
+			if ancestor, ok := categoryMap[id]; ok {
+				names = append(names, ancestor.Name)
+			}
+		}
+		facet.Name = category.Name
+		facet.Path = strings.Join(names, "/")
+		ret = append(ret, facet)
+	}
+	return ret, nil
+}
+
+// normalizeCategoryName the names make up the paths of the facets, so a name can not have a slash
+func normalizeCategoryName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", errno.ParamErr.WithMessage("name is required")
+	}
+	if strings.Contains(name, "/") {
+		return "", errno.ParamErr.WithMessage("name can not have a slash")
+	}
+	if utf8.RuneCountInString(name) > categoryNameMaxLen {
+		return "", errno.ParamErr.WithMessage("name is too long")
+	}
+	return name, nil
+}
@@ -0,0 +1,145 @@
+// Copyright 2023 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+
+	"github.com/cloudwego/biz-demo/book-shop/app/item/common/constant"
+	"github.com/cloudwego/biz-demo/book-shop/app/item/common/converter"
+	"github.com/cloudwego/biz-demo/book-shop/app/item/common/entity"
+	"github.com/cloudwego/biz-demo/book-shop/app/item/domain/repository"
+	"github.com/cloudwego/biz-demo/book-shop/pkg/errno"
+	"github.com/cloudwego/biz-demo/book-shop/pkg/productio"
+	"github.com/cloudwego/biz-demo/book-shop/pkg/utils"
+)
+
+// ProductImportService imports product files in background jobs, a row which fails does not stop the others
+type ProductImportService struct{}
+
+var productImportService ProductImportService
+
+func GetProductImportServiceInstance() *ProductImportService {
+	return &productImportService
+}
+
+// SubmitImport checks the file and creates a pending job, the rows are imported by ProductImportWorker
+func (s *ProductImportService) SubmitImport(ctx context.Context, format string, content []byte, operator string) (int64, error) {
+	if !productio.ValidFormat(format) {
+		return 0, errno.ParamErr.WithMessage(productio.ErrUnknownFormat.Error())
+	}
+	totalRows, err := productio.Count(format, content)
+	if err != nil {
+		return 0, errno.ParamErr.WithMessage(err.Error())
+	}
+	if totalRows == 0 {
+		return 0, errno.ParamErr.WithMessage("the file has no rows")
+	}
+	if totalRows > constant.ProductImportMaxRows {
+		return 0, errno.ParamErr.WithMessage("the file has too many rows")
+	}
+	jobId, err := utils.GenerateID()
+	if err != nil {
+		return 0, err
+	}
+	err = repository.GetRegistry().GetProductImportRepository().CreateImportJob(ctx, &entity.ProductImportJobEntity{
+		JobId:     jobId,
+		Format:    format,
+		Content:   content,
+		Operator:  operator,
+		Status:    constant.ProductImportJobStatusPending,
+		TotalRows: totalRows,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return jobId, nil
+}
+
+func (s *ProductImportService) GetImportJob(ctx context.Context, jobId int64) (*entity.ProductImportJobEntity, error) {
+	return repository.GetRegistry().GetProductImportRepository().GetImportJob(ctx, jobId)
+}
+
+// RunImportJob imports the rows from job.NextRow on. The progress of a row is saved in the transaction
+// adding its product, so a job taken over after a crash goes on from the last added row without adding
+// it twice. It returns repository.ErrImportJobTakenOver once another run holds the job.
+func (s *ProductImportService) RunImportJob(ctx context.Context, job *entity.ProductImportJobEntity) error {
+	reader, err := productio.NewReader(job.Format, bytes.NewReader(job.Content))
+	if err != nil {
+		return err
+	}
+	importRepo := repository.GetRegistry().GetProductImportRepository()
+	for row := 1; ; row++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		var rowErr *productio.RowError
+		if err != nil && !errors.As(err, &rowErr) {
+			return err
+		}
+		if row <= job.NextRow {
+			continue
+		}
+		if rowErr == nil {
+			err = s.importRecord(ctx, job, row, record)
+			if err == nil {
+				continue
+			}
+			if errors.Is(err, repository.ErrImportJobTakenOver) {
+				return err
+			}
+		} else {
+			err = rowErr.Err
+		}
+		// a failed row adds nothing, its progress is saved alone
+		addImportRowError(job, row, err)
+		job.NextRow = row
+		if err := importRepo.SaveImportProgress(ctx, job); err != nil {
+			return err
+		}
+	}
+}
+
+// importRecord adds the record the same way as the add api, job counts the row as added
+// only when the product is added
+func (s *ProductImportService) importRecord(ctx context.Context, job *entity.ProductImportJobEntity,
+	row int, record *productio.Record,
+) error {
+	product, err := converter.ConvertAddReq2Entity(record.ToAddReq(job.Operator))
+	if err != nil {
+		return err
+	}
+	progress := *job
+	job.NextRow = row
+	job.SuccessRows++
+	job.ProductIds = append(job.ProductIds, product.ProductId)
+	if err := GetProductUpdateServiceInstance().AddImportedProduct(ctx, product, job); err != nil {
+		*job = progress
+		return err
+	}
+	return nil
+}
+
+func addImportRowError(job *entity.ProductImportJobEntity, row int, err error) {
+	job.FailedRows++
+	if len(job.RowErrors) < constant.ProductImportMaxRowErrors {
+		job.RowErrors = append(job.RowErrors, &entity.ImportRowErrorEntity{Row: row, Message: errno.ConvertErr(err).ErrMsg})
+	}
+}
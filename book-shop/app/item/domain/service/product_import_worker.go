@@ -0,0 +1,82 @@
+// Copyright 2023 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/cloudwego/biz-demo/book-shop/app/item/common/constant"
+	"github.com/cloudwego/biz-demo/book-shop/app/item/domain/repository"
+	"github.com/cloudwego/biz-demo/book-shop/pkg/utils"
+	"github.com/cloudwego/kitex/pkg/klog"
+)
+
+const (
+	productImportInterval  = 3 * time.Second
+	productImportBatchSize = 10
+)
+
+// ProductImportWorker runs the submitted import jobs, a job is claimed with a token of the run before it runs
+// so that several item instances can run the worker. A run stops once its token no longer holds the job.
+type ProductImportWorker struct {
+	*utils.PeriodicRunner
+}
+
+func NewProductImportWorker() *ProductImportWorker {
+	w := &ProductImportWorker{}
+	w.PeriodicRunner = utils.NewPeriodicRunner(productImportInterval, w.runJobs)
+	return w
+}
+
+func (w *ProductImportWorker) runJobs(ctx context.Context) {
+	importRepo := repository.GetRegistry().GetProductImportRepository()
+	now := time.Now()
+	jobIds, err := importRepo.ListRunnableImportJobIds(ctx, now, productImportBatchSize)
+	if err != nil {
+		klog.CtxErrorf(ctx, "list runnable product import jobs err: %v", err)
+		return
+	}
+	for _, jobId := range jobIds {
+		claimToken, err := utils.GenerateID()
+		if err != nil {
+			klog.CtxErrorf(ctx, "generate claim token of product import job %d err: %v", jobId, err)
+			return
+		}
+		job, err := importRepo.ClaimImportJob(ctx, jobId, claimToken, now)
+		if err != nil {
+			klog.CtxErrorf(ctx, "claim product import job %d err: %v", jobId, err)
+			continue
+		}
+		if job == nil {
+			continue
+		}
+		job.Status = constant.ProductImportJobStatusDone
+		err = GetProductImportServiceInstance().RunImportJob(ctx, job)
+		if errors.Is(err, repository.ErrImportJobTakenOver) {
+			klog.CtxWarnf(ctx, "product import job %d is taken over by another run", jobId)
+			continue
+		}
+		if err != nil {
+			klog.CtxErrorf(ctx, "run product import job %d err: %v", jobId, err)
+			job.Status, job.ErrMsg = constant.ProductImportJobStatusFailed, err.Error()
+		}
+		if err := importRepo.FinishImportJob(ctx, job); err != nil {
+			klog.CtxErrorf(ctx, "finish product import job %d err: %v", jobId, err)
+		}
+	}
+}
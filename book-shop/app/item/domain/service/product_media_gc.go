@@ -0,0 +1,59 @@
+// Copyright 2023 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/cloudwego/biz-demo/book-shop/app/item/domain/repository"
+	"github.com/cloudwego/biz-demo/book-shop/pkg/utils"
+	"github.com/cloudwego/kitex/pkg/klog"
+)
+
+const (
+	mediaGCInterval  = time.Minute
+	mediaGCBatchSize = 100
+)
+
+// ProductMediaGC deletes the files of the removed images and of the images of deleted products,
+// the rows are dropped after their files, so that a failed deletion is retried in the next round
+type ProductMediaGC struct {
+	*utils.PeriodicRunner
+}
+
+func NewProductMediaGC() *ProductMediaGC {
+	g := &ProductMediaGC{}
+	g.PeriodicRunner = utils.NewPeriodicRunner(mediaGCInterval, g.collect)
+	return g
+}
+
+func (g *ProductMediaGC) collect(ctx context.Context) {
+	mediaRepo := repository.GetRegistry().GetProductMediaRepository()
+	garbage, err := mediaRepo.ListGarbageMedia(ctx, mediaGCBatchSize)
+	if err != nil {
+		klog.CtxErrorf(ctx, "list garbage product images err: %v", err)
+		return
+	}
+	for _, media := range garbage {
+		if !GetProductMediaServiceInstance().deleteFiles(ctx, media) {
+			continue
+		}
+		if err := mediaRepo.PurgeMedia(ctx, media.MediaId); err != nil {
+			klog.CtxErrorf(ctx, "purge product image %d err: %v", media.MediaId, err)
+		}
+	}
+}
@@ -0,0 +1,117 @@
+// Copyright 2023 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/cloudwego/biz-demo/book-shop/app/item/common/constant"
+	"github.com/cloudwego/biz-demo/book-shop/app/item/common/entity"
+	"github.com/cloudwego/biz-demo/book-shop/app/item/domain/repository"
+	"github.com/cloudwego/biz-demo/book-shop/pkg/blobstore"
+	"github.com/cloudwego/biz-demo/book-shop/pkg/errno"
+	"github.com/cloudwego/biz-demo/book-shop/pkg/imaging"
+	"github.com/cloudwego/biz-demo/book-shop/pkg/utils"
+	"github.com/cloudwego/kitex/pkg/klog"
+)
+
+// ProductMediaService the image galleries of the products, the files are kept by the blob store
+type ProductMediaService struct{}
+
+var productMediaService ProductMediaService
+
+func GetProductMediaServiceInstance() *ProductMediaService {
+	return &productMediaService
+}
+
+// UploadMedia stores the image with its thumbnail and appends it to the gallery of the product
+func (s *ProductMediaService) UploadMedia(ctx context.Context, productId int64, content []byte, primary bool) (*entity.ProductMediaEntity, error) {
+	if len(content) == 0 {
+		return nil, errno.ParamErr.WithMessage("content is required")
+	}
+	if len(content) > constant.ProductMediaMaxSize {
+		return nil, errno.ParamErr.WithMessage(fmt.Sprintf("the image is larger than %d bytes", constant.ProductMediaMaxSize))
+	}
+	// checked before the files are written, the repository checks again with the product locked
+	product, err := repository.GetRegistry().GetProductRepository().GetProductById(ctx, productId)
+	if err != nil {
+		return nil, err
+	}
+	if product.Status == constant.ProductStatusDelete {
+		return nil, errors.New("商品已删除")
+	}
+	img, format, err := imaging.Decode(content)
+	if err != nil {
+		return nil, errno.ParamErr.WithMessage(err.Error())
+	}
+	thumbnail, thumbnailFormat, err := imaging.Encode(imaging.Thumbnail(img, constant.ProductMediaThumbnailSize), format)
+	if err != nil {
+		return nil, err
+	}
+	mediaId, err := utils.GenerateID()
+	if err != nil {
+		return nil, err
+	}
+
+	store := blobstore.GetStore()
+	bounds := img.Bounds()
+	media := &entity.ProductMediaEntity{
+		MediaId:      mediaId,
+		ProductId:    productId,
+		BlobKey:      fmt.Sprintf("product/%d/%d.%s", productId, mediaId, imaging.Ext(format)),
+		ThumbnailKey: fmt.Sprintf("product/%d/%d_thumb.%s", productId, mediaId, imaging.Ext(thumbnailFormat)),
+		Width:        int32(bounds.Dx()),
+		Height:       int32(bounds.Dy()),
+	}
+	media.URL = store.URL(media.BlobKey)
+	media.ThumbnailURL = store.URL(media.ThumbnailKey)
+	// the original is kept as uploaded
+	if err := store.Put(ctx, media.BlobKey, content); err != nil {
+		return nil, err
+	}
+	if err := store.Put(ctx, media.ThumbnailKey, thumbnail); err != nil {
+		s.deleteFiles(ctx, media)
+		return nil, err
+	}
+	if err := repository.GetRegistry().GetProductMediaRepository().AddMedia(ctx, media, primary); err != nil {
+		// no row refers to the files, the gc would never find them
+		s.deleteFiles(ctx, media)
+		return nil, err
+	}
+	return media, nil
+}
+
+// SaveGallery orders the gallery as mediaIds, the first image is the primary one when primaryMediaId is 0.
+// The images left out are removed, their files are deleted by the ProductMediaGC.
+func (s *ProductMediaService) SaveGallery(ctx context.Context, productId int64, mediaIds []int64,
+	primaryMediaId int64,
+) ([]*entity.ProductMediaEntity, error) {
+	return repository.GetRegistry().GetProductMediaRepository().SaveGallery(ctx, productId, mediaIds, primaryMediaId)
+}
+
+// deleteFiles deletes the files of the image, false if any of them is left
+func (s *ProductMediaService) deleteFiles(ctx context.Context, media *entity.ProductMediaEntity) bool {
+	ok := true
+	for _, key := range []string{media.BlobKey, media.ThumbnailKey} {
+		if err := blobstore.GetStore().Delete(ctx, key); err != nil {
+			klog.CtxErrorf(ctx, "delete file %s of product image %d err: %v", key, media.MediaId, err)
+			ok = false
+		}
+	}
+	return ok
+}
@@ -0,0 +1,95 @@
+// Copyright 2023 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/cloudwego/biz-demo/book-shop/app/item/common/constant"
+	"github.com/cloudwego/biz-demo/book-shop/app/item/domain/repository"
+	"github.com/cloudwego/biz-demo/book-shop/pkg/utils"
+	"github.com/cloudwego/kitex/pkg/klog"
+)
+
+const (
+	priceScheduleInterval  = 5 * time.Second
+	priceScheduleBatchSize = 100
+)
+
+// ProductPriceScheduler applies the due list price changes and syncs the promotions which start or end
+// to the search index, the product reads work out the promotion in effect by themselves.
+type ProductPriceScheduler struct {
+	*utils.PeriodicRunner
+}
+
+func NewProductPriceScheduler() *ProductPriceScheduler {
+	s := &ProductPriceScheduler{}
+	s.PeriodicRunner = utils.NewPeriodicRunner(priceScheduleInterval, s.runDue)
+	return s
+}
+
+func (s *ProductPriceScheduler) runDue(ctx context.Context) {
+	s.applyListPriceChanges(ctx)
+	s.syncPromotions(ctx)
+}
+
+func (s *ProductPriceScheduler) applyListPriceChanges(ctx context.Context) {
+	priceRepo := repository.GetRegistry().GetProductPriceRepository()
+	now := time.Now()
+	changes, err := priceRepo.ListDueListPriceChanges(ctx, now, priceScheduleBatchSize)
+	if err != nil {
+		klog.CtxErrorf(ctx, "list due list price changes err: %v", err)
+		return
+	}
+	for _, change := range changes {
+		claimed, err := priceRepo.ClaimListPriceChange(ctx, change.PriceId, now)
+		if err != nil {
+			klog.CtxErrorf(ctx, "claim list price change %d err: %v", change.PriceId, err)
+			continue
+		}
+		if !claimed {
+			continue
+		}
+		if err := GetProductPriceServiceInstance().ApplyListPriceChange(ctx, change); err != nil {
+			// e.g. the product is deleted, the change is not retried
+			klog.CtxWarnf(ctx, "apply list price change %d err: %v", change.PriceId, err)
+			if err := priceRepo.FailListPriceChange(ctx, change.PriceId, err.Error()); err != nil {
+				klog.CtxErrorf(ctx, "fail list price change %d err: %v", change.PriceId, err)
+			}
+		}
+	}
+}
+
+// syncPromotions a promotion which has started becomes active, an active one which has ended is ended,
+// so that the search index gets the price in effect
+func (s *ProductPriceScheduler) syncPromotions(ctx context.Context) {
+	priceRepo := repository.GetRegistry().GetProductPriceRepository()
+	promotions, err := priceRepo.ListDuePromotions(ctx, time.Now(), priceScheduleBatchSize)
+	if err != nil {
+		klog.CtxErrorf(ctx, "list due promotions err: %v", err)
+		return
+	}
+	for _, promotion := range promotions {
+		toStatus := constant.ProductPriceStatusActive
+		if promotion.Status == constant.ProductPriceStatusActive {
+			toStatus = constant.ProductPriceStatusEnded
+		}
+		if err := priceRepo.SyncPromotion(ctx, promotion.PriceId, promotion.Status, toStatus); err != nil {
+			klog.CtxErrorf(ctx, "sync promotion %d err: %v", promotion.PriceId, err)
+		}
+	}
+}
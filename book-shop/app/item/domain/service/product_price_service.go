@@ -0,0 +1,138 @@
+// Copyright 2023 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/cloudwego/biz-demo/book-shop/app/item/common/constant"
+	"github.com/cloudwego/biz-demo/book-shop/app/item/common/entity"
+	"github.com/cloudwego/biz-demo/book-shop/app/item/domain/repository"
+	"github.com/cloudwego/biz-demo/book-shop/pkg/errno"
+	"github.com/cloudwego/biz-demo/book-shop/pkg/utils"
+)
+
+// ProductPriceService scheduled list price changes and promotions
+type ProductPriceService struct{}
+
+var productPriceService ProductPriceService
+
+func GetProductPriceServiceInstance() *ProductPriceService {
+	return &productPriceService
+}
+
+// SchedulePriceChange a change with effectiveTo is a promotion in [effectiveFrom, effectiveTo),
+// the list price is in effect again after it. Without effectiveTo the list price is changed at effectiveFrom.
+func (s *ProductPriceService) SchedulePriceChange(ctx context.Context, productId, skuId, price int64,
+	effectiveFrom time.Time, effectiveTo *time.Time, operator string,
+) (int64, error) {
+	if price < 0 {
+		return 0, errno.ParamErr.WithMessage("price can not be negative")
+	}
+	kind := constant.ProductPriceKindList
+	if effectiveTo != nil {
+		if !effectiveTo.After(effectiveFrom) || !effectiveTo.After(time.Now()) {
+			return 0, errno.ParamErr.WithMessage("effective_to must be after effective_from and now")
+		}
+		kind = constant.ProductPriceKindPromotion
+	}
+	product, err := repository.GetRegistry().GetProductRepository().GetProductById(ctx, productId)
+	if err != nil {
+		return 0, err
+	}
+	if product.Status == constant.ProductStatusDelete {
+		return 0, errors.New("商品已删除")
+	}
+	priceId, err := utils.GenerateID()
+	if err != nil {
+		return 0, err
+	}
+	err = repository.GetRegistry().GetProductPriceRepository().CreatePriceChange(ctx, &entity.ProductPriceEntity{
+		PriceId:       priceId,
+		ProductId:     productId,
+		SkuId:         skuId,
+		Kind:          kind,
+		Price:         price,
+		EffectiveFrom: effectiveFrom,
+		EffectiveTo:   effectiveTo,
+		Status:        constant.ProductPriceStatusPending,
+		Operator:      operator,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return priceId, nil
+}
+
+func (s *ProductPriceService) GetPriceHistory(ctx context.Context, productId int64, limit int) ([]*entity.ProductPriceEntity, error) {
+	if limit <= 0 {
+		limit = constant.ProductPriceHistoryDefaultLimit
+	}
+	if limit > constant.ProductPriceHistoryMaxLimit {
+		limit = constant.ProductPriceHistoryMaxLimit
+	}
+	return repository.GetRegistry().GetProductPriceRepository().ListPriceHistory(ctx, productId, limit)
+}
+
+// applyListPriceChangeRetries edits which lose the version check against a concurrent edit are retried
+const applyListPriceChangeRetries = 3
+
+// ApplyListPriceChange writes the price of a claimed list price change to the product, the change is
+// marked applied in the same transaction. A price change does not need review and keeps the status.
+func (s *ProductPriceService) ApplyListPriceChange(ctx context.Context, change *entity.ProductPriceEntity) error {
+	var err error
+	for i := 0; i < applyListPriceChangeRetries; i++ {
+		if err = s.applyListPriceChange(ctx, change); !errors.Is(err, errno.ProductVersionConflictErr) {
+			return err
+		}
+	}
+	return err
+}
+
+func (s *ProductPriceService) applyListPriceChange(ctx context.Context, change *entity.ProductPriceEntity) error {
+	origin, err := repository.GetRegistry().GetProductRepository().GetProductById(ctx, change.ProductId)
+	if err != nil {
+		return err
+	}
+	if origin.Status == constant.ProductStatusDelete {
+		return errors.New("商品已删除")
+	}
+	target, err := origin.Clone()
+	if err != nil {
+		return err
+	}
+	if change.SkuId == 0 {
+		if len(target.Skus) > 0 {
+			return errors.New("商品已添加规格, 需按规格调价")
+		}
+		target.Price = change.Price
+	} else {
+		sku := target.GetSku(change.SkuId)
+		if sku == nil {
+			return errors.New("规格不存在")
+		}
+		sku.Price = change.Price
+		target.SummarizeSkus()
+	}
+	operation := &entity.ProductOperationEntity{
+		Operator:      change.Operator,
+		OperationType: constant.StateOperationTypeSave,
+		PriceId:       change.PriceId,
+	}
+	return repository.GetRegistry().GetProductRepository().UpdateProduct(ctx, origin, target, operation)
+}
@@ -18,6 +18,7 @@ package service
 import (
 	"context"
 
+	"github.com/cloudwego/biz-demo/book-shop/app/item/common/constant"
 	"github.com/cloudwego/biz-demo/book-shop/app/item/common/entity"
 	"github.com/cloudwego/biz-demo/book-shop/app/item/domain/repository"
 )
@@ -40,22 +41,21 @@ func (s *ProductQueryService) GetProduct(ctx context.Context, productId int64) (
 	return do, nil
 }
 
-func (s *ProductQueryService) ListProducts(ctx context.Context, name, spuName *string, status *int64) ([]*entity.ProductEntity, error) {
-	filterParam := make(map[string]interface{})
-	if name != nil {
-		filterParam["name"] = *name
+func (s *ProductQueryService) ListProducts(ctx context.Context, query *entity.ProductListQuery) (*entity.ProductListResult, error) {
+	if query.Limit <= 0 {
+		query.Limit = constant.ProductListDefaultLimit
 	}
-	if spuName != nil {
-		filterParam["spu_name"] = *spuName
+	if query.Limit > constant.ProductListMaxLimit {
+		query.Limit = constant.ProductListMaxLimit
 	}
-	if status != nil {
-		filterParam["status"] = *status
+	if query.Offset < 0 {
+		query.Offset = 0
 	}
-	entities, err := repository.GetRegistry().GetProductRepository().ListProducts(ctx, filterParam)
+	result, err := repository.GetRegistry().GetProductRepository().ListProducts(ctx, query)
 	if err != nil {
 		return nil, err
 	}
-	return entities, nil
+	return result, nil
 }
 
 func (s *ProductQueryService) MGet2C(ctx context.Context, productIds []int64) ([]*entity.ProductEntity, error) {
@@ -66,10 +66,39 @@ func (s *ProductQueryService) MGet2C(ctx context.Context, productIds []int64) ([
 	return do, nil
 }
 
-func (s *ProductQueryService) Search(ctx context.Context, name, description, spuName *string) ([]*entity.ProductEntity, error) {
-	do, err := repository.GetRegistry().GetProduct2CRepository().SearchProducts(ctx, name, description, spuName)
+func (s *ProductQueryService) Search(ctx context.Context, query *entity.ProductSearchQuery) (*entity.ProductSearchResult, error) {
+	if query.PageSize <= 0 {
+		query.PageSize = constant.SearchDefaultPageSize
+	}
+	if query.PageSize > constant.SearchMaxPageSize {
+		query.PageSize = constant.SearchMaxPageSize
+	}
+	result, err := repository.GetRegistry().GetProduct2CRepository().SearchProducts(ctx, query)
 	if err != nil {
 		return nil, err
 	}
-	return do, nil
+	// the search index only has the category ids
+	result.CategoryFacets, err = GetProductCategoryServiceInstance().FillCategoryFacets(ctx, result.CategoryFacets)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// GetProductHistory returns a page of the product history newest first and whether there are more
+func (s *ProductQueryService) GetProductHistory(ctx context.Context, productId, cursor int64, pageSize int) ([]*entity.ProductHistoryEntity, bool, error) {
+	if pageSize <= 0 {
+		pageSize = constant.ProductHistoryDefaultPageSize
+	}
+	if pageSize > constant.ProductHistoryMaxPageSize {
+		pageSize = constant.ProductHistoryMaxPageSize
+	}
+	histories, err := repository.GetRegistry().GetProductRepository().ListProductHistory(ctx, productId, cursor, pageSize+1)
+	if err != nil {
+		return nil, false, err
+	}
+	if len(histories) > pageSize {
+		return histories[:pageSize], true, nil
+	}
+	return histories, false, nil
 }
@@ -0,0 +1,229 @@
+// Copyright 2023 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/cloudwego/biz-demo/book-shop/app/item/common/constant"
+	"github.com/cloudwego/biz-demo/book-shop/app/item/common/entity"
+)
+
+// StateHookFunc side effect of an operation, run after the product change is saved
+type StateHookFunc func(ctx context.Context, origin, target *entity.ProductEntity) error
+
+// StateTransition one operation of the product state machine
+type StateTransition struct {
+	Operation constant.StateOperationType
+	Name      string
+	// From the states the operation applies to, empty means any state
+	From []constant.ProductStatus
+	// Guard optional check besides From
+	Guard CanTransferFunc
+	// Target builds the state after the operation
+	Target ConstructTargetInfoFunc
+	// Hooks failures are logged only, the change has been saved
+	Hooks []StateHookFunc
+}
+
+// StateMachineDefinition declares the product states and the operations between them
+type StateMachineDefinition struct {
+	States      []constant.ProductStatus
+	Transitions []*StateTransition
+}
+
+// StateMachine a validated StateMachineDefinition
+type StateMachine struct {
+	states      map[constant.ProductStatus]bool
+	transitions []*StateTransition
+	operations  map[constant.StateOperationType]*StateTransition
+}
+
+// NewStateMachine validate the definition, every transition must have a name and a target,
+// refer to declared states only and an operation can not be declared twice
+func NewStateMachine(def *StateMachineDefinition) (*StateMachine, error) {
+	m := &StateMachine{
+		states:     make(map[constant.ProductStatus]bool),
+		operations: make(map[constant.StateOperationType]*StateTransition),
+	}
+	for _, state := range def.States {
+		if _, ok := constant.ProductStatusDescMap[state]; !ok {
+			return nil, fmt.Errorf("state machine: unknown state %d", state)
+		}
+		m.states[state] = true
+	}
+	for _, t := range def.Transitions {
+		if t.Name == "" || t.Target == nil {
+			return nil, fmt.Errorf("state machine: operation %d has no name or target", t.Operation)
+		}
+		if _, ok := m.operations[t.Operation]; ok {
+			return nil, fmt.Errorf("state machine: operation %d is declared twice", t.Operation)
+		}
+		for _, state := range t.From {
+			if !m.states[state] {
+				return nil, fmt.Errorf("state machine: operation %s from undeclared state %d", t.Name, state)
+			}
+		}
+		m.operations[t.Operation] = t
+		m.transitions = append(m.transitions, t)
+	}
+	return m, nil
+}
+
+func (m *StateMachine) getTransition(operation constant.StateOperationType) (*StateTransition, error) {
+	if t, ok := m.operations[operation]; ok {
+		return t, nil
+	}
+	return nil, fmt.Errorf("不支持的操作: %d", operation)
+}
+
+// CanTransfer whether the operation can be applied to a product in originalInfo
+func (m *StateMachine) CanTransfer(operation constant.StateOperationType, originalInfo *ProductStateInfo) error {
+	t, err := m.getTransition(operation)
+	if err != nil {
+		return err
+	}
+	if len(t.From) > 0 && !containsState(t.From, originalInfo.Status) {
+		return fmt.Errorf("商品%s状态不可%s", constant.ProductStatusDescMap[originalInfo.Status], t.Name)
+	}
+	if t.Guard != nil {
+		return t.Guard(originalInfo)
+	}
+	return nil
+}
+
+// Transfer the state after the operation, the target must be a declared state
+func (m *StateMachine) Transfer(operation constant.StateOperationType, originalInfo *ProductStateInfo) (*ProductStateInfo, error) {
+	t, err := m.getTransition(operation)
+	if err != nil {
+		return nil, err
+	}
+	target := t.Target(originalInfo)
+	if !m.states[target.Status] {
+		return nil, fmt.Errorf("state machine: operation %s leads to undeclared state %d", t.Name, target.Status)
+	}
+	return target, nil
+}
+
+// LegalTransitions the operations which can be applied to a product in originalInfo, in declaration order
+func (m *StateMachine) LegalTransitions(originalInfo *ProductStateInfo) []*StateTransition {
+	ret := make([]*StateTransition, 0)
+	for _, t := range m.transitions {
+		if m.CanTransfer(t.Operation, originalInfo) == nil {
+			ret = append(ret, t)
+		}
+	}
+	return ret
+}
+
+func (m *StateMachine) hooks(operation constant.StateOperationType) []StateHookFunc {
+	if t, ok := m.operations[operation]; ok {
+		return t.Hooks
+	}
+	return nil
+}
+
+func containsState(states []constant.ProductStatus, state constant.ProductStatus) bool {
+	for _, s := range states {
+		if s == state {
+			return true
+		}
+	}
+	return false
+}
+
+func toState(status constant.ProductStatus) ConstructTargetInfoFunc {
+	return func(originalInfo *ProductStateInfo) *ProductStateInfo {
+		return &ProductStateInfo{Status: status}
+	}
+}
+
+// DefaultProductStateMachine the product life cycle:
+// an online product goes to review when an edit needs approval, approval puts it online again and
+// rejection takes it offline. Add is excluded from the legal operations of an existing product by its guard.
+func DefaultProductStateMachine() *StateMachineDefinition {
+	alive := []constant.ProductStatus{
+		constant.ProductStatusOnline,
+		constant.ProductStatusOffline,
+		constant.ProductStatusPendingReview,
+	}
+	return &StateMachineDefinition{
+		States: []constant.ProductStatus{
+			constant.ProductStatusOnline,
+			constant.ProductStatusOffline,
+			constant.ProductStatusDelete,
+			constant.ProductStatusPendingReview,
+		},
+		Transitions: []*StateTransition{
+			{
+				Operation: constant.StateOperationTypeAdd,
+				Name:      "新增",
+				Guard: func(originalInfo *ProductStateInfo) error {
+					if !originalInfo.IsNew {
+						return errors.New("商品已存在")
+					}
+					return nil
+				},
+				Target: toState(constant.ProductStatusOnline),
+			},
+			{
+				Operation: constant.StateOperationTypeSave,
+				Name:      "编辑",
+				From:      alive,
+				// keep the status, unless an online product is changed in a way that needs approval
+				Target: func(originalInfo *ProductStateInfo) *ProductStateInfo {
+					if originalInfo.Status == constant.ProductStatusOnline && originalInfo.NeedReview {
+						return &ProductStateInfo{Status: constant.ProductStatusPendingReview}
+					}
+					return &ProductStateInfo{Status: originalInfo.Status}
+				},
+			},
+			{
+				Operation: constant.StateOperationTypeDel,
+				Name:      "删除",
+				From:      alive,
+				Target:    toState(constant.ProductStatusDelete),
+				Hooks:     []StateHookFunc{cancelPendingSchedulesHook},
+			},
+			{
+				Operation: constant.StateOperationTypeOffline,
+				Name:      "下架",
+				From:      []constant.ProductStatus{constant.ProductStatusOnline, constant.ProductStatusPendingReview},
+				Target:    toState(constant.ProductStatusOffline),
+			},
+			{
+				Operation: constant.StateOperationTypeOnline,
+				Name:      "上架",
+				From:      []constant.ProductStatus{constant.ProductStatusOffline},
+				Target:    toState(constant.ProductStatusOnline),
+			},
+			{
+				Operation: constant.StateOperationTypeApprove,
+				Name:      "审核通过",
+				From:      []constant.ProductStatus{constant.ProductStatusPendingReview},
+				Target:    toState(constant.ProductStatusOnline),
+			},
+			{
+				Operation: constant.StateOperationTypeReject,
+				Name:      "审核驳回",
+				From:      []constant.ProductStatus{constant.ProductStatusPendingReview},
+				Target:    toState(constant.ProductStatusOffline),
+			},
+		},
+	}
+}
@@ -0,0 +1,72 @@
+// Copyright 2023 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/cloudwego/biz-demo/book-shop/app/item/common/constant"
+	"github.com/cloudwego/biz-demo/book-shop/app/item/domain/repository"
+	"github.com/cloudwego/biz-demo/book-shop/pkg/utils"
+	"github.com/cloudwego/kitex/pkg/klog"
+)
+
+const (
+	stateScheduleInterval  = 5 * time.Second
+	stateScheduleBatchSize = 100
+)
+
+// ProductStateScheduler applies the due scheduled state changes through ProductStateService,
+// a schedule is claimed before it is applied so that several item instances can run the scheduler.
+type ProductStateScheduler struct {
+	*utils.PeriodicRunner
+}
+
+func NewProductStateScheduler() *ProductStateScheduler {
+	s := &ProductStateScheduler{}
+	s.PeriodicRunner = utils.NewPeriodicRunner(stateScheduleInterval, s.runDue)
+	return s
+}
+
+func (s *ProductStateScheduler) runDue(ctx context.Context) {
+	scheduleRepo := repository.GetRegistry().GetProductScheduleRepository()
+	now := time.Now()
+	schedules, err := scheduleRepo.ListDueSchedules(ctx, now, stateScheduleBatchSize)
+	if err != nil {
+		klog.CtxErrorf(ctx, "list due product schedules err: %v", err)
+		return
+	}
+	for _, schedule := range schedules {
+		claimed, err := scheduleRepo.ClaimSchedule(ctx, schedule.ScheduleId, now)
+		if err != nil {
+			klog.CtxErrorf(ctx, "claim product schedule %d err: %v", schedule.ScheduleId, err)
+			continue
+		}
+		if !claimed {
+			continue
+		}
+		// the applied schedule is marked done together with the state change
+		if err := GetProductStateService().ApplyScheduledStateOperation(ctx, schedule); err != nil {
+			// e.g. the product is already online, the schedule is not retried. A schedule done
+			// by another run in the meantime is no longer running and is left as it is.
+			klog.CtxWarnf(ctx, "apply product schedule %d err: %v", schedule.ScheduleId, err)
+			if err := scheduleRepo.FinishSchedule(ctx, schedule.ScheduleId, constant.ProductScheduleStatusFailed, err.Error()); err != nil {
+				klog.CtxErrorf(ctx, "finish product schedule %d err: %v", schedule.ScheduleId, err)
+			}
+		}
+	}
+}
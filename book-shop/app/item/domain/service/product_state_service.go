@@ -18,15 +18,21 @@ package service
 import (
 	"context"
 	"errors"
+	"reflect"
+	"time"
 
 	"github.com/cloudwego/biz-demo/book-shop/app/item/common/constant"
 	"github.com/cloudwego/biz-demo/book-shop/app/item/common/entity"
 	"github.com/cloudwego/biz-demo/book-shop/app/item/domain/repository"
+	"github.com/cloudwego/biz-demo/book-shop/pkg/errno"
+	"github.com/cloudwego/biz-demo/book-shop/pkg/utils"
 	"github.com/cloudwego/kitex/pkg/klog"
 )
 
 // ProductStateService product state machine service
-type ProductStateService struct{}
+type ProductStateService struct {
+	machine *StateMachine
+}
 
 var productStateService ProductStateService
 
@@ -36,83 +42,34 @@ func GetProductStateService() *ProductStateService {
 
 type ProductStateInfo struct {
 	Status constant.ProductStatus
+	// IsNew the product has not been saved yet
+	IsNew bool
+	// NeedReview the edit changes fields which need approval when the product is online
+	NeedReview bool
 }
 
 type CanTransferFunc func(originalInfo *ProductStateInfo) error
 
 type ConstructTargetInfoFunc func(originalInfo *ProductStateInfo) *ProductStateInfo
 
-var canTransferFuncMap = map[constant.StateOperationType]CanTransferFunc{
-	constant.StateOperationTypeAdd: func(originalInfo *ProductStateInfo) error {
-		return nil
-	},
-	constant.StateOperationTypeSave: func(originalInfo *ProductStateInfo) error {
-		if originalInfo.Status == constant.ProductStatusDelete {
-			return errors.New("商品已删除")
-		}
-		return nil
-	},
-	constant.StateOperationTypeDel: func(originalInfo *ProductStateInfo) error {
-		return nil
-	},
-	constant.StateOperationTypeOnline: func(originalInfo *ProductStateInfo) error {
-		if originalInfo.Status != constant.ProductStatusOffline {
-			return errors.New("商品非下架状态")
-		}
-		return nil
-	},
-	constant.StateOperationTypeOffline: func(originalInfo *ProductStateInfo) error {
-		if originalInfo.Status != constant.ProductStatusOnline {
-			return errors.New("商品非上架状态")
-		}
-		return nil
-	},
-}
-
-var constructTargetInfoFuncMap = map[constant.StateOperationType]ConstructTargetInfoFunc{
-	constant.StateOperationTypeAdd: func(originalInfo *ProductStateInfo) (ret *ProductStateInfo) {
-		ret = &ProductStateInfo{}
-		ret.Status = constant.ProductStatusOnline
-		return
-	},
-	constant.StateOperationTypeSave: func(originalInfo *ProductStateInfo) (ret *ProductStateInfo) {
-		ret = &ProductStateInfo{}
-		ret.Status = constant.ProductStatusOnline
-		return
-	},
-	constant.StateOperationTypeDel: func(originalInfo *ProductStateInfo) (ret *ProductStateInfo) {
-		ret = &ProductStateInfo{}
-		ret.Status = constant.ProductStatusDelete
-		return
-	},
-	constant.StateOperationTypeOnline: func(originalInfo *ProductStateInfo) (ret *ProductStateInfo) {
-		ret = &ProductStateInfo{}
-		ret.Status = constant.ProductStatusOnline
-		return
-	},
-	constant.StateOperationTypeOffline: func(originalInfo *ProductStateInfo) (ret *ProductStateInfo) {
-		ret = &ProductStateInfo{}
-		ret.Status = constant.ProductStatusOffline
-		return
-	},
+// LoadStateMachine validate and install the state machine, called once at startup
+func (s *ProductStateService) LoadStateMachine(def *StateMachineDefinition) error {
+	machine, err := NewStateMachine(def)
+	if err != nil {
+		return err
+	}
+	s.machine = machine
+	return nil
 }
 
 // GetCanTransferFunc get the validating func
 func (s *ProductStateService) GetCanTransferFunc(operationType constant.StateOperationType) (CanTransferFunc, error) {
-	if canTransferFunc, ok := canTransferFuncMap[operationType]; ok {
-		return canTransferFunc, nil
-	}
-
-	return nil, errors.New("GetCanTransferFunc not found")
-}
-
-// GetConstructTargetInfoFunc get func to change product status
-func (s *ProductStateService) getConstructTargetInfoFunc(operationType constant.StateOperationType) (ConstructTargetInfoFunc, error) {
-	if constructTargetInfoFunc, ok := constructTargetInfoFuncMap[operationType]; ok {
-		return constructTargetInfoFunc, nil
+	if _, err := s.machine.getTransition(operationType); err != nil {
+		return nil, err
 	}
-
-	return nil, errors.New("GetConstructTargetInfoFunc not found")
+	return func(originalInfo *ProductStateInfo) error {
+		return s.machine.CanTransfer(operationType, originalInfo)
+	}, nil
 }
 
 // ConstructTargetInfo change product status
@@ -126,23 +83,178 @@ func (s *ProductStateService) ConstructTargetInfo(originProduct *entity.ProductE
 	originStateInfo := &ProductStateInfo{
 		Status: originProduct.Status,
 	}
-	constructFunc, err := s.getConstructTargetInfoFunc(operation)
+	targetState, err := s.machine.Transfer(operation, originStateInfo)
 	if err != nil {
 		return nil, err
 	}
-	targetState := constructFunc(originStateInfo)
 	targetProduct.Status = targetState.Status
 	return targetProduct, nil
 }
 
+// GetLegalOperations the operations the product can take now, so that only valid actions are offered
+func (s *ProductStateService) GetLegalOperations(ctx context.Context, productId int64) ([]*StateTransition, error) {
+	product, err := GetProductQueryServiceInstance().GetProduct(ctx, productId)
+	if err != nil {
+		return nil, err
+	}
+	return s.machine.LegalTransitions(&ProductStateInfo{Status: product.Status}), nil
+}
+
 // OperateProduct update product
-func (s *ProductStateService) OperateProduct(ctx context.Context, origin, target *entity.ProductEntity) error {
+func (s *ProductStateService) OperateProduct(ctx context.Context, origin, target *entity.ProductEntity,
+	operation *entity.ProductOperationEntity,
+) error {
 	repo := repository.GetRegistry().GetProductRepository()
 	// update status
-	err := repo.UpdateProduct(ctx, origin, target)
+	err := repo.UpdateProduct(ctx, origin, target, operation)
 	if err != nil {
 		klog.CtxErrorf(ctx, "OperateProduct err: %v", err)
 		return err
 	}
 	return nil
 }
+
+// scheduleOperationMap target status -> the operation which can be scheduled for it
+var scheduleOperationMap = map[constant.ProductStatus]constant.StateOperationType{
+	constant.ProductStatusOnline:  constant.StateOperationTypeOnline,
+	constant.ProductStatusOffline: constant.StateOperationTypeOffline,
+}
+
+// ScheduleStateChange schedule the product to go online or offline at executeAt,
+// the state rules are checked when the schedule is applied
+func (s *ProductStateService) ScheduleStateChange(ctx context.Context, productId int64, targetStatus constant.ProductStatus,
+	executeAt time.Time, operator string,
+) (int64, error) {
+	operation, ok := scheduleOperationMap[targetStatus]
+	if !ok || !executeAt.After(time.Now()) {
+		return 0, errno.ParamErr
+	}
+	product, err := repository.GetRegistry().GetProductRepository().GetProductById(ctx, productId)
+	if err != nil {
+		return 0, err
+	}
+	if product.Status == constant.ProductStatusDelete {
+		return 0, errors.New("商品已删除")
+	}
+	scheduleId, err := utils.GenerateID()
+	if err != nil {
+		return 0, err
+	}
+	err = repository.GetRegistry().GetProductScheduleRepository().CreateSchedule(ctx, &entity.ProductScheduleEntity{
+		ScheduleId:    scheduleId,
+		ProductId:     productId,
+		OperationType: operation,
+		ExecuteAt:     executeAt,
+		Status:        constant.ProductScheduleStatusPending,
+		Operator:      operator,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return scheduleId, nil
+}
+
+// CancelScheduledChange cancel a schedule which has not been applied
+func (s *ProductStateService) CancelScheduledChange(ctx context.Context, scheduleId int64) error {
+	return repository.GetRegistry().GetProductScheduleRepository().CancelSchedule(ctx, scheduleId)
+}
+
+// ApplyStateOperation validate the operation against the current product state and apply it
+func (s *ProductStateService) ApplyStateOperation(ctx context.Context, productId int64,
+	operation constant.StateOperationType, operator string,
+) error {
+	return s.applyStateOperation(ctx, productId, operation, func(origin, target *entity.ProductEntity) error {
+		return GetProductUpdateServiceInstance().EditProduct(ctx, origin, target, operator, operation)
+	})
+}
+
+// ApplyScheduledStateOperation applies a claimed schedule, which is marked done in the transaction
+// changing the state, so that a schedule taken over after a timeout is never applied twice
+func (s *ProductStateService) ApplyScheduledStateOperation(ctx context.Context, schedule *entity.ProductScheduleEntity) error {
+	return s.applyStateOperation(ctx, schedule.ProductId, schedule.OperationType, func(origin, target *entity.ProductEntity) error {
+		return GetProductUpdateServiceInstance().EditScheduledProduct(ctx, origin, target, schedule)
+	})
+}
+
+func (s *ProductStateService) applyStateOperation(ctx context.Context, productId int64,
+	operation constant.StateOperationType, edit func(origin, target *entity.ProductEntity) error,
+) error {
+	originEntity, err := GetProductQueryServiceInstance().GetProduct(ctx, productId)
+	if err != nil {
+		return err
+	}
+	validateFunc, err := s.GetCanTransferFunc(operation)
+	if err != nil {
+		return err
+	}
+	if err = validateFunc(&ProductStateInfo{Status: originEntity.Status}); err != nil {
+		return err
+	}
+	targetEntity, err := s.ConstructTargetInfo(originEntity, operation)
+	if err != nil {
+		return err
+	}
+	if err = edit(originEntity, targetEntity); err != nil {
+		return err
+	}
+	s.runHooks(ctx, operation, originEntity, targetEntity)
+	return nil
+}
+
+// ApplyEdit save an edited product, an online product goes to review when the edit needs approval
+func (s *ProductStateService) ApplyEdit(ctx context.Context, origin, target *entity.ProductEntity, operator string) error {
+	originStateInfo := &ProductStateInfo{
+		Status:     origin.Status,
+		NeedReview: editNeedReview(origin, target),
+	}
+	if err := s.machine.CanTransfer(constant.StateOperationTypeSave, originStateInfo); err != nil {
+		return err
+	}
+	targetState, err := s.machine.Transfer(constant.StateOperationTypeSave, originStateInfo)
+	if err != nil {
+		return err
+	}
+	target.Status = targetState.Status
+	err = GetProductUpdateServiceInstance().EditProduct(ctx, origin, target, operator, constant.StateOperationTypeSave)
+	if err != nil {
+		return err
+	}
+	s.runHooks(ctx, constant.StateOperationTypeSave, origin, target)
+	return nil
+}
+
+func (s *ProductStateService) runHooks(ctx context.Context, operation constant.StateOperationType, origin, target *entity.ProductEntity) {
+	for _, hook := range s.machine.hooks(operation) {
+		if err := hook(ctx, origin, target); err != nil {
+			klog.CtxErrorf(ctx, "product %d state hook of operation %d err: %v", target.ProductId, operation, err)
+		}
+	}
+}
+
+// editNeedReview the content of the product is changed, price and stock are changed without review
+func editNeedReview(origin, target *entity.ProductEntity) bool {
+	if origin.Name != target.Name || origin.Pic != target.Pic || origin.Description != target.Description {
+		return true
+	}
+	if origin.Property == nil || target.Property == nil {
+		if origin.Property != target.Property {
+			return true
+		}
+	} else if *origin.Property != *target.Property {
+		return true
+	}
+	// a new sku or a changed edition needs review, price and stock do not
+	for _, sku := range target.Skus {
+		originSku := origin.GetSku(sku.SkuId)
+		if originSku == nil || originSku.Edition != sku.Edition || originSku.ISBN != sku.ISBN ||
+			!reflect.DeepEqual(originSku.Attributes, sku.Attributes) {
+			return true
+		}
+	}
+	return false
+}
+
+// cancelPendingSchedulesHook a deleted product can not go online or offline any more
+func cancelPendingSchedulesHook(ctx context.Context, origin, target *entity.ProductEntity) error {
+	return repository.GetRegistry().GetProductScheduleRepository().CancelProductSchedules(ctx, target.ProductId)
+}
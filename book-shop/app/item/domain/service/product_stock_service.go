@@ -17,8 +17,14 @@ package service
 
 import (
 	"context"
+	"sort"
+	"time"
 
+	"github.com/cloudwego/biz-demo/book-shop/app/item/common/constant"
+	"github.com/cloudwego/biz-demo/book-shop/app/item/common/entity"
 	"github.com/cloudwego/biz-demo/book-shop/app/item/domain/repository"
+	"github.com/cloudwego/biz-demo/book-shop/pkg/errno"
+	"github.com/cloudwego/biz-demo/book-shop/pkg/utils"
 )
 
 type ProductStockService struct{}
@@ -29,10 +35,115 @@ func GetProductStockServiceInstance() *ProductStockService {
 	return &productStockService
 }
 
-func (s *ProductStockService) IncreaseStockNum(ctx context.Context, productId, incrNum int64) error {
-	return repository.GetRegistry().GetStockRepository().IncrStock(ctx, productId, incrNum)
+// IncreaseStockNum a call with an already applied idempotencyKey returns the first result without changing stock
+func (s *ProductStockService) IncreaseStockNum(ctx context.Context, productId, skuId, incrNum int64, idempotencyKey string) error {
+	if len(idempotencyKey) > constant.StockIdempotencyKeyMaxLen {
+		return errno.ParamErr
+	}
+	return repository.GetRegistry().GetStockRepository().IncrStock(ctx, productId, skuId, incrNum, idempotencyKey)
 }
 
-func (s *ProductStockService) DecreaseStockNum(ctx context.Context, productId, decrNum int64) error {
-	return repository.GetRegistry().GetStockRepository().DecrStock(ctx, productId, decrNum)
+// DecreaseStockNum a call with an already applied idempotencyKey returns the first result without changing stock
+func (s *ProductStockService) DecreaseStockNum(ctx context.Context, productId, skuId, decrNum int64, idempotencyKey string) error {
+	if len(idempotencyKey) > constant.StockIdempotencyKeyMaxLen {
+		return errno.ParamErr
+	}
+	return repository.GetRegistry().GetStockRepository().DecrStock(ctx, productId, skuId, decrNum, idempotencyKey)
+}
+
+// BatchIncreaseStockNum returns the stock of several products in one transaction,
+// a call with an already applied idempotencyKey returns the first result without changing stock
+func (s *ProductStockService) BatchIncreaseStockNum(ctx context.Context, lines []*entity.StockLineEntity, idempotencyKey string) error {
+	if len(idempotencyKey) > constant.StockIdempotencyKeyMaxLen {
+		return errno.ParamErr
+	}
+	lines, err := mergeStockLines(lines)
+	if err != nil {
+		return err
+	}
+	return repository.GetRegistry().GetStockRepository().BatchIncrStock(ctx, lines, idempotencyKey)
+}
+
+// BatchDecreaseStockNum decreases the stock of several products in one transaction,
+// when any line is short nothing is decreased and the shortages are returned with errno.StockNotEnoughErr
+func (s *ProductStockService) BatchDecreaseStockNum(ctx context.Context, lines []*entity.StockLineEntity, idempotencyKey string) ([]*entity.StockShortageEntity, error) {
+	if len(idempotencyKey) > constant.StockIdempotencyKeyMaxLen {
+		return nil, errno.ParamErr
+	}
+	lines, err := mergeStockLines(lines)
+	if err != nil {
+		return nil, err
+	}
+	shortages, err := repository.GetRegistry().GetStockRepository().BatchDecrStock(ctx, lines, idempotencyKey)
+	if err != nil {
+		return nil, err
+	}
+	if len(shortages) > 0 {
+		return shortages, errno.StockNotEnoughErr
+	}
+	return nil, nil
+}
+
+// mergeStockLines sums up the lines of the same sku and sorts them by product id and sku id
+func mergeStockLines(lines []*entity.StockLineEntity) ([]*entity.StockLineEntity, error) {
+	if len(lines) == 0 {
+		return nil, errno.ParamErr
+	}
+	type stockKey struct {
+		productId int64
+		skuId     int64
+	}
+	stockNumMap := make(map[stockKey]int64, len(lines))
+	for _, line := range lines {
+		if line == nil || line.StockNum <= 0 {
+			return nil, errno.ParamErr
+		}
+		stockNumMap[stockKey{line.ProductId, line.SkuId}] += line.StockNum
+	}
+	ret := make([]*entity.StockLineEntity, 0, len(stockNumMap))
+	for key, stockNum := range stockNumMap {
+		ret = append(ret, &entity.StockLineEntity{ProductId: key.productId, SkuId: key.skuId, StockNum: stockNum})
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		if ret[i].ProductId != ret[j].ProductId {
+			return ret[i].ProductId < ret[j].ProductId
+		}
+		return ret[i].SkuId < ret[j].SkuId
+	})
+	return ret, nil
+}
+
+// ReserveStock holds stock for ttl, a zero ttl means the default one.
+// The hold has to be confirmed or released before it expires, otherwise the sweeper releases it.
+func (s *ProductStockService) ReserveStock(ctx context.Context, productId, skuId, stockNum int64, ttl time.Duration) (*entity.StockReservationEntity, error) {
+	if stockNum <= 0 || ttl < 0 || ttl > constant.StockReservationMaxTTL {
+		return nil, errno.ParamErr
+	}
+	if ttl == 0 {
+		ttl = constant.StockReservationDefaultTTL
+	}
+	reservationId, err := utils.GenerateID()
+	if err != nil {
+		return nil, err
+	}
+	reservation := &entity.StockReservationEntity{
+		ReservationId: reservationId,
+		ProductId:     productId,
+		SkuId:         skuId,
+		StockNum:      stockNum,
+		Status:        constant.StockReservationStatusReserved,
+		ExpireAt:      time.Now().Add(ttl),
+	}
+	if err := repository.GetRegistry().GetStockRepository().ReserveStock(ctx, reservation); err != nil {
+		return nil, err
+	}
+	return reservation, nil
+}
+
+func (s *ProductStockService) ConfirmReservation(ctx context.Context, reservationId int64) error {
+	return repository.GetRegistry().GetStockRepository().ConfirmReservation(ctx, reservationId)
+}
+
+func (s *ProductStockService) ReleaseReservation(ctx context.Context, reservationId int64) error {
+	return repository.GetRegistry().GetStockRepository().ReleaseReservation(ctx, reservationId)
 }
@@ -17,9 +17,12 @@ package service
 
 import (
 	"context"
+	"strings"
 
+	"github.com/cloudwego/biz-demo/book-shop/app/item/common/constant"
 	"github.com/cloudwego/biz-demo/book-shop/app/item/common/entity"
 	"github.com/cloudwego/biz-demo/book-shop/app/item/domain/repository"
+	"github.com/cloudwego/biz-demo/book-shop/pkg/errno"
 )
 
 // ProductUpdateService product update service
@@ -31,16 +34,60 @@ func GetProductUpdateServiceInstance() *ProductUpdateService {
 	return &productUpdateService
 }
 
-func (s *ProductUpdateService) AddProduct(ctx context.Context, entity *entity.ProductEntity) error {
-	err := repository.GetRegistry().GetProductRepository().AddProduct(ctx, entity)
+// AddProduct the fields left empty are prefilled from the book metadata first
+func (s *ProductUpdateService) AddProduct(ctx context.Context, product *entity.ProductEntity, operator string) error {
+	return s.addProduct(ctx, product, &entity.ProductOperationEntity{
+		Operator:      operator,
+		OperationType: constant.StateOperationTypeAdd,
+	})
+}
+
+// AddImportedProduct adds the product of an import row, the progress of job is saved together with the product
+func (s *ProductUpdateService) AddImportedProduct(ctx context.Context, product *entity.ProductEntity, job *entity.ProductImportJobEntity) error {
+	return s.addProduct(ctx, product, &entity.ProductOperationEntity{
+		Operator:      job.Operator,
+		OperationType: constant.StateOperationTypeAdd,
+		ImportJob:     job,
+	})
+}
+
+func (s *ProductUpdateService) addProduct(ctx context.Context, product *entity.ProductEntity, operation *entity.ProductOperationEntity) error {
+	GetBookMetadataServiceInstance().Prefill(ctx, product)
+	if strings.TrimSpace(product.Name) == "" {
+		return errno.ParamErr.WithMessage("name is required, no book metadata is found for the isbn")
+	}
+	err := repository.GetRegistry().GetProductRepository().AddProduct(ctx, product, operation)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-func (s *ProductUpdateService) EditProduct(ctx context.Context, origin, target *entity.ProductEntity) error {
-	err := repository.GetRegistry().GetProductRepository().UpdateProduct(ctx, origin, target)
+// EditProduct operationType is the constant.StateOperationType recorded in the product history
+func (s *ProductUpdateService) EditProduct(ctx context.Context, origin, target *entity.ProductEntity,
+	operator string, operationType constant.StateOperationType,
+) error {
+	return s.editProduct(ctx, origin, target, &entity.ProductOperationEntity{
+		Operator:      operator,
+		OperationType: operationType,
+	})
+}
+
+// EditScheduledProduct applies a claimed state schedule, the schedule is marked done together with the product
+func (s *ProductUpdateService) EditScheduledProduct(ctx context.Context, origin, target *entity.ProductEntity,
+	schedule *entity.ProductScheduleEntity,
+) error {
+	return s.editProduct(ctx, origin, target, &entity.ProductOperationEntity{
+		Operator:      schedule.Operator,
+		OperationType: schedule.OperationType,
+		ScheduleId:    schedule.ScheduleId,
+	})
+}
+
+func (s *ProductUpdateService) editProduct(ctx context.Context, origin, target *entity.ProductEntity,
+	operation *entity.ProductOperationEntity,
+) error {
+	err := repository.GetRegistry().GetProductRepository().UpdateProduct(ctx, origin, target, operation)
 	if err != nil {
 		return err
 	}
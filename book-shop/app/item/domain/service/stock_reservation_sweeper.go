@@ -0,0 +1,66 @@
+// Copyright 2023 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/cloudwego/biz-demo/book-shop/app/item/domain/repository"
+	"github.com/cloudwego/biz-demo/book-shop/pkg/utils"
+	"github.com/cloudwego/kitex/pkg/klog"
+)
+
+const (
+	reservationSweepInterval  = 10 * time.Second
+	reservationSweepBatchSize = 100
+)
+
+// ReservationSweeper releases the stock reservations which were neither
+// confirmed nor released before they expired, e.g. the caller crashed in between.
+type ReservationSweeper struct {
+	*utils.PeriodicRunner
+}
+
+func NewReservationSweeper() *ReservationSweeper {
+	s := &ReservationSweeper{}
+	s.PeriodicRunner = utils.NewPeriodicRunner(reservationSweepInterval, s.sweep)
+	return s
+}
+
+func (s *ReservationSweeper) sweep(ctx context.Context) {
+	stockRepo := repository.GetRegistry().GetStockRepository()
+	for {
+		reservationIds, err := stockRepo.ListExpiredReservations(ctx, time.Now(), reservationSweepBatchSize)
+		if err != nil {
+			klog.CtxErrorf(ctx, "list expired stock reservations err: %v", err)
+			return
+		}
+		released := 0
+		for _, reservationId := range reservationIds {
+			// the reservation may be released concurrently, ReleaseReservation is idempotent
+			if err := stockRepo.ReleaseReservation(ctx, reservationId); err != nil {
+				klog.CtxErrorf(ctx, "release expired stock reservation %d err: %v", reservationId, err)
+				continue
+			}
+			released++
+		}
+		// stop on a short batch, or when nothing could be released to avoid spinning on failures
+		if len(reservationIds) < reservationSweepBatchSize || released == 0 {
+			return
+		}
+	}
+}
@@ -55,6 +55,78 @@ func (s *ItemServiceImpl) Offline(ctx context.Context, req *item.OfflineReq) (re
 	return resp, err
 }
 
+// ScheduleStateChange implements the ItemServiceImpl interface.
+func (s *ItemServiceImpl) ScheduleStateChange(ctx context.Context, req *item.ScheduleStateChangeReq) (resp *item.ScheduleStateChangeResp, err error) {
+	resp, err = handler.NewScheduleStateChangeHandler(ctx, req).ScheduleStateChange()
+	return resp, err
+}
+
+// SchedulePriceChange implements the ItemServiceImpl interface.
+func (s *ItemServiceImpl) SchedulePriceChange(ctx context.Context, req *item.SchedulePriceChangeReq) (resp *item.SchedulePriceChangeResp, err error) {
+	resp, err = handler.NewSchedulePriceChangeHandler(ctx, req).SchedulePriceChange()
+	return resp, err
+}
+
+// GetPriceHistory implements the ItemServiceImpl interface.
+func (s *ItemServiceImpl) GetPriceHistory(ctx context.Context, req *item.GetPriceHistoryReq) (resp *item.GetPriceHistoryResp, err error) {
+	resp, err = handler.NewGetPriceHistoryHandler(ctx, req).GetPriceHistory()
+	return resp, err
+}
+
+// CancelScheduledChange implements the ItemServiceImpl interface.
+func (s *ItemServiceImpl) CancelScheduledChange(ctx context.Context, req *item.CancelScheduledChangeReq) (resp *item.CancelScheduledChangeResp, err error) {
+	resp, err = handler.NewCancelScheduledChangeHandler(ctx, req).CancelScheduledChange()
+	return resp, err
+}
+
+// Review implements the ItemServiceImpl interface.
+func (s *ItemServiceImpl) Review(ctx context.Context, req *item.ReviewReq) (resp *item.ReviewResp, err error) {
+	resp, err = handler.NewReviewHandler(ctx, req).Review()
+	return resp, err
+}
+
+// GetLegalOperations implements the ItemServiceImpl interface.
+func (s *ItemServiceImpl) GetLegalOperations(ctx context.Context, req *item.GetLegalOperationsReq) (resp *item.GetLegalOperationsResp, err error) {
+	resp, err = handler.NewGetLegalOperationsHandler(ctx, req).GetLegalOperations()
+	return resp, err
+}
+
+// UploadProductMedia implements the ItemServiceImpl interface.
+func (s *ItemServiceImpl) UploadProductMedia(ctx context.Context, req *item.UploadProductMediaReq) (resp *item.UploadProductMediaResp, err error) {
+	resp, err = handler.NewUploadProductMediaHandler(ctx, req).UploadProductMedia()
+	return resp, err
+}
+
+// SaveProductGallery implements the ItemServiceImpl interface.
+func (s *ItemServiceImpl) SaveProductGallery(ctx context.Context, req *item.SaveProductGalleryReq) (resp *item.SaveProductGalleryResp, err error) {
+	resp, err = handler.NewSaveProductGalleryHandler(ctx, req).SaveProductGallery()
+	return resp, err
+}
+
+// CreateCategory implements the ItemServiceImpl interface.
+func (s *ItemServiceImpl) CreateCategory(ctx context.Context, req *item.CreateCategoryReq) (resp *item.CreateCategoryResp, err error) {
+	resp, err = handler.NewCreateCategoryHandler(ctx, req).CreateCategory()
+	return resp, err
+}
+
+// UpdateCategory implements the ItemServiceImpl interface.
+func (s *ItemServiceImpl) UpdateCategory(ctx context.Context, req *item.UpdateCategoryReq) (resp *item.UpdateCategoryResp, err error) {
+	resp, err = handler.NewUpdateCategoryHandler(ctx, req).UpdateCategory()
+	return resp, err
+}
+
+// DeleteCategory implements the ItemServiceImpl interface.
+func (s *ItemServiceImpl) DeleteCategory(ctx context.Context, req *item.DeleteCategoryReq) (resp *item.DeleteCategoryResp, err error) {
+	resp, err = handler.NewDeleteCategoryHandler(ctx, req).DeleteCategory()
+	return resp, err
+}
+
+// GetCategoryTree implements the ItemServiceImpl interface.
+func (s *ItemServiceImpl) GetCategoryTree(ctx context.Context, req *item.GetCategoryTreeReq) (resp *item.GetCategoryTreeResp, err error) {
+	resp, err = handler.NewGetCategoryTreeHandler(ctx, req).GetCategoryTree()
+	return resp, err
+}
+
 // Get implements the ItemServiceImpl interface.
 func (s *ItemServiceImpl) Get(ctx context.Context, req *item.GetReq) (resp *item.GetResp, err error) {
 	resp, err = handler.NewGetHandler(ctx, req).Get()
@@ -73,6 +145,36 @@ func (s *ItemServiceImpl) List(ctx context.Context, req *item.ListReq) (resp *it
 	return resp, err
 }
 
+// GetProductHistory implements the ItemServiceImpl interface.
+func (s *ItemServiceImpl) GetProductHistory(ctx context.Context, req *item.GetProductHistoryReq) (resp *item.GetProductHistoryResp, err error) {
+	resp, err = handler.NewGetProductHistoryHandler(ctx, req).GetProductHistory()
+	return resp, err
+}
+
+// ImportProducts implements the ItemServiceImpl interface.
+func (s *ItemServiceImpl) ImportProducts(ctx context.Context, req *item.ImportProductsReq) (resp *item.ImportProductsResp, err error) {
+	resp, err = handler.NewImportProductsHandler(ctx, req).ImportProducts()
+	return resp, err
+}
+
+// GetImportJob implements the ItemServiceImpl interface.
+func (s *ItemServiceImpl) GetImportJob(ctx context.Context, req *item.GetImportJobReq) (resp *item.GetImportJobResp, err error) {
+	resp, err = handler.NewGetImportJobHandler(ctx, req).GetImportJob()
+	return resp, err
+}
+
+// ListLowStock implements the ItemServiceImpl interface.
+func (s *ItemServiceImpl) ListLowStock(ctx context.Context, req *item.ListLowStockReq) (resp *item.ListLowStockResp, err error) {
+	resp, err = handler.NewListLowStockHandler(ctx, req).ListLowStock()
+	return resp, err
+}
+
+// SetLowStockThreshold implements the ItemServiceImpl interface.
+func (s *ItemServiceImpl) SetLowStockThreshold(ctx context.Context, req *item.SetLowStockThresholdReq) (resp *item.SetLowStockThresholdResp, err error) {
+	resp, err = handler.NewSetLowStockThresholdHandler(ctx, req).SetLowStockThreshold()
+	return resp, err
+}
+
 // MGet2C implements the ItemServiceImpl interface.
 func (s *ItemServiceImpl) MGet2C(ctx context.Context, req *item.MGet2CReq) (resp *item.MGet2CResp, err error) {
 	resp, err = handler.NewMGet2CHandler(ctx, req).MGet()
@@ -90,3 +192,33 @@ func (s *ItemServiceImpl) DecrStockRevert(ctx context.Context, req *item.DecrSto
 	resp, err = handler.NewDecrStockRevertHandler(ctx, req).DecrStockRevert()
 	return resp, err
 }
+
+// BatchDecrStock implements the ItemServiceImpl interface.
+func (s *ItemServiceImpl) BatchDecrStock(ctx context.Context, req *item.BatchDecrStockReq) (resp *item.BatchDecrStockResp, err error) {
+	resp, err = handler.NewBatchDecrStockHandler(ctx, req).BatchDecrStock()
+	return resp, err
+}
+
+// BatchDecrStockRevert implements the ItemServiceImpl interface.
+func (s *ItemServiceImpl) BatchDecrStockRevert(ctx context.Context, req *item.BatchDecrStockReq) (resp *item.BatchDecrStockResp, err error) {
+	resp, err = handler.NewBatchDecrStockRevertHandler(ctx, req).BatchDecrStockRevert()
+	return resp, err
+}
+
+// ReserveStock implements the ItemServiceImpl interface.
+func (s *ItemServiceImpl) ReserveStock(ctx context.Context, req *item.ReserveStockReq) (resp *item.ReserveStockResp, err error) {
+	resp, err = handler.NewReserveStockHandler(ctx, req).ReserveStock()
+	return resp, err
+}
+
+// ConfirmReservation implements the ItemServiceImpl interface.
+func (s *ItemServiceImpl) ConfirmReservation(ctx context.Context, req *item.ConfirmReservationReq) (resp *item.ConfirmReservationResp, err error) {
+	resp, err = handler.NewConfirmReservationHandler(ctx, req).ConfirmReservation()
+	return resp, err
+}
+
+// ReleaseReservation implements the ItemServiceImpl interface.
+func (s *ItemServiceImpl) ReleaseReservation(ctx context.Context, req *item.ReleaseReservationReq) (resp *item.ReleaseReservationResp, err error) {
+	resp, err = handler.NewReleaseReservationHandler(ctx, req).ReleaseReservation()
+	return resp, err
+}
@@ -49,7 +49,7 @@ func (h *AddHandler) Add() (*item.AddResp, error) {
 		return resp, nil
 	}
 
-	err = updateService.AddProduct(h.ctx, entity)
+	err = updateService.AddProduct(h.ctx, entity, h.param.GetOperatorName())
 	if err != nil {
 		resp.BaseResp = errno.BuildBaseResp(err)
 		return resp, nil
@@ -41,7 +41,7 @@ func (h *DecrStockHandler) DecrStock() (*item.DecrStockResp, error) {
 	}
 
 	stockService := service.GetProductStockServiceInstance()
-	err := stockService.DecreaseStockNum(h.ctx, h.param.ProductId, h.param.StockNum)
+	err := stockService.DecreaseStockNum(h.ctx, h.param.ProductId, h.param.GetSkuId(), h.param.StockNum, h.param.GetIdempotencyKey())
 	if err != nil {
 		resp.BaseResp = errno.BuildBaseResp(err)
 		return resp, nil
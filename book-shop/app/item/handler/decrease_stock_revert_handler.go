@@ -41,7 +41,7 @@ func (h *DecrStockRevertHandler) DecrStockRevert() (*item.DecrStockResp, error)
 	}
 
 	stockService := service.GetProductStockServiceInstance()
-	err := stockService.IncreaseStockNum(h.ctx, h.param.ProductId, h.param.StockNum)
+	err := stockService.IncreaseStockNum(h.ctx, h.param.ProductId, h.param.GetSkuId(), h.param.StockNum, h.param.GetIdempotencyKey())
 	if err != nil {
 		resp.BaseResp = errno.BuildBaseResp(err)
 		return resp, nil
@@ -42,37 +42,7 @@ func (h *DeleteHandler) Delete() (*item.DeleteResp, error) {
 	}
 
 	stateService := service.GetProductStateService()
-	queryService := service.GetProductQueryServiceInstance()
-	updateService := service.GetProductUpdateServiceInstance()
-
-	// 0. get origin info
-	originEntity, err := queryService.GetProduct(h.ctx, h.param.ProductId)
-	if err != nil {
-		resp.BaseResp = errno.BuildBaseResp(err)
-		return resp, nil
-	}
-
-	// 1. validate
-	validateFunc, err := stateService.GetCanTransferFunc(constant.ProductStatusDelete)
-	if err != nil {
-		resp.BaseResp = errno.BuildBaseResp(err)
-		return resp, nil
-	}
-	err = validateFunc(&service.ProductStateInfo{Status: originEntity.Status})
-	if err != nil {
-		resp.BaseResp = errno.BuildBaseResp(err)
-		return resp, nil
-	}
-
-	// 2. construct do
-	targetEntity, err := stateService.ConstructTargetInfo(originEntity, constant.StateOperationTypeDel)
-	if err != nil {
-		resp.BaseResp = errno.BuildBaseResp(err)
-		return resp, nil
-	}
-
-	// 3. process
-	err = updateService.EditProduct(h.ctx, originEntity, targetEntity)
+	err := stateService.ApplyStateOperation(h.ctx, h.param.ProductId, constant.StateOperationTypeDel, h.param.GetOperatorName())
 	if err != nil {
 		resp.BaseResp = errno.BuildBaseResp(err)
 		return resp, nil
@@ -41,7 +41,7 @@ func (h *EditHandler) Edit() (*item.EditResp, error) {
 		BaseResp: errno.BuildBaseResp(errno.Success),
 	}
 
-	updateService := service.GetProductUpdateServiceInstance()
+	stateService := service.GetProductStateService()
 	queryService := service.GetProductQueryServiceInstance()
 
 	originEntity, err := queryService.GetProduct(h.ctx, h.param.ProductId)
@@ -49,12 +49,17 @@ func (h *EditHandler) Edit() (*item.EditResp, error) {
 		resp.BaseResp = errno.BuildBaseResp(err)
 		return resp, nil
 	}
+	// the product was changed after the editor read it
+	if originEntity.Version != h.param.Version {
+		resp.BaseResp = errno.BuildBaseResp(errno.ProductVersionConflictErr)
+		return resp, nil
+	}
 	targetEntity, err := converter.ConvertEditReq2Entity(originEntity, h.param)
 	if err != nil {
 		resp.BaseResp = errno.BuildBaseResp(err)
 		return resp, nil
 	}
-	err = updateService.EditProduct(h.ctx, originEntity, targetEntity)
+	err = stateService.ApplyEdit(h.ctx, originEntity, targetEntity, h.param.GetOperatorName())
 	if err != nil {
 		resp.BaseResp = errno.BuildBaseResp(err)
 		return resp, nil
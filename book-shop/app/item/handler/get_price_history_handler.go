@@ -0,0 +1,58 @@
+// Copyright 2023 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package handler
+
+import (
+	"context"
+
+	"github.com/cloudwego/biz-demo/book-shop/app/item/common/converter"
+	"github.com/cloudwego/biz-demo/book-shop/app/item/domain/service"
+	"github.com/cloudwego/biz-demo/book-shop/kitex_gen/cwg/bookshop/item"
+	"github.com/cloudwego/biz-demo/book-shop/pkg/errno"
+)
+
+type GetPriceHistoryHandler struct {
+	ctx   context.Context
+	param *item.GetPriceHistoryReq
+}
+
+func NewGetPriceHistoryHandler(ctx context.Context, req *item.GetPriceHistoryReq) *GetPriceHistoryHandler {
+	return &GetPriceHistoryHandler{
+		ctx:   ctx,
+		param: req,
+	}
+}
+
+func (h *GetPriceHistoryHandler) GetPriceHistory() (*item.GetPriceHistoryResp, error) {
+	resp := &item.GetPriceHistoryResp{
+		BaseResp: errno.BuildBaseResp(errno.Success),
+	}
+
+	priceService := service.GetProductPriceServiceInstance()
+	changes, err := priceService.GetPriceHistory(h.ctx, h.param.ProductId, int(h.param.GetLimit()))
+	if err != nil {
+		resp.BaseResp = errno.BuildBaseResp(err)
+		return resp, nil
+	}
+
+	dtos := make([]*item.PriceChange, 0, len(changes))
+	for _, e := range changes {
+		dtos = append(dtos, converter.ConvertPriceEntity2DTO(e))
+	}
+	resp.Changes = dtos
+
+	return resp, nil
+}
@@ -0,0 +1,61 @@
+// Copyright 2023 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package handler
+
+import (
+	"context"
+
+	"github.com/cloudwego/biz-demo/book-shop/app/item/common/converter"
+	"github.com/cloudwego/biz-demo/book-shop/app/item/domain/service"
+	"github.com/cloudwego/biz-demo/book-shop/kitex_gen/cwg/bookshop/item"
+	"github.com/cloudwego/biz-demo/book-shop/pkg/errno"
+)
+
+type GetProductHistoryHandler struct {
+	ctx   context.Context
+	param *item.GetProductHistoryReq
+}
+
+func NewGetProductHistoryHandler(ctx context.Context, req *item.GetProductHistoryReq) *GetProductHistoryHandler {
+	return &GetProductHistoryHandler{
+		ctx:   ctx,
+		param: req,
+	}
+}
+
+func (h *GetProductHistoryHandler) GetProductHistory() (*item.GetProductHistoryResp, error) {
+	resp := &item.GetProductHistoryResp{
+		BaseResp: errno.BuildBaseResp(errno.Success),
+	}
+
+	queryService := service.GetProductQueryServiceInstance()
+	histories, hasMore, err := queryService.GetProductHistory(h.ctx, h.param.ProductId, h.param.GetCursor(), int(h.param.GetPageSize()))
+	if err != nil {
+		resp.BaseResp = errno.BuildBaseResp(err)
+		return resp, nil
+	}
+
+	resp.Histories = make([]*item.ProductHistory, 0, len(histories))
+	for _, history := range histories {
+		resp.Histories = append(resp.Histories, converter.ConvertHistoryEntity2DTO(history))
+	}
+	resp.HasMore = hasMore
+	if hasMore {
+		resp.NextCursor = histories[len(histories)-1].Id
+	}
+
+	return resp, nil
+}
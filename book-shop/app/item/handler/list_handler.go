@@ -41,24 +41,20 @@ func (h *ListHandler) List() (*item.ListResp, error) {
 		BaseResp: errno.BuildBaseResp(errno.Success),
 	}
 
-	if h.param.Name == nil && h.param.SpuName == nil && h.param.Status == nil {
-		resp.BaseResp = errno.BuildBaseResp(errno.ParamErr)
-		return resp, nil
-	}
-
 	queryService := service.GetProductQueryServiceInstance()
-	entities, err := queryService.ListProducts(h.ctx, h.param.Name, h.param.SpuName, (*int64)(h.param.Status))
+	result, err := queryService.ListProducts(h.ctx, converter.ConvertListReq2Query(h.param))
 	if err != nil {
 		resp.BaseResp = errno.BuildBaseResp(err)
 		return resp, nil
 	}
 
-	dtos := make([]*item.Product, 0)
-	for _, e := range entities {
+	dtos := make([]*item.Product, 0, len(result.Products))
+	for _, e := range result.Products {
 		dtos = append(dtos, converter.ConvertEntity2DTO(e))
 	}
 
 	resp.Products = dtos
+	resp.Total = result.Total
 
 	return resp, nil
 }
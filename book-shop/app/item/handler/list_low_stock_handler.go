@@ -0,0 +1,59 @@
+// Copyright 2023 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package handler
+
+import (
+	"context"
+
+	"github.com/cloudwego/biz-demo/book-shop/app/item/common/converter"
+	"github.com/cloudwego/biz-demo/book-shop/app/item/domain/service"
+	"github.com/cloudwego/biz-demo/book-shop/kitex_gen/cwg/bookshop/item"
+	"github.com/cloudwego/biz-demo/book-shop/pkg/errno"
+)
+
+type ListLowStockHandler struct {
+	ctx   context.Context
+	param *item.ListLowStockReq
+}
+
+func NewListLowStockHandler(ctx context.Context, req *item.ListLowStockReq) *ListLowStockHandler {
+	return &ListLowStockHandler{
+		ctx:   ctx,
+		param: req,
+	}
+}
+
+func (h *ListLowStockHandler) ListLowStock() (*item.ListLowStockResp, error) {
+	resp := &item.ListLowStockResp{
+		BaseResp: errno.BuildBaseResp(errno.Success),
+	}
+
+	lowStockService := service.GetLowStockServiceInstance()
+	products, total, err := lowStockService.ListLowStockProducts(h.ctx, int(h.param.GetLimit()), int(h.param.GetOffset()))
+	if err != nil {
+		resp.BaseResp = errno.BuildBaseResp(err)
+		return resp, nil
+	}
+
+	dtos := make([]*item.LowStockProduct, 0, len(products))
+	for _, e := range products {
+		dtos = append(dtos, converter.ConvertLowStockProductEntity2DTO(e))
+	}
+	resp.Products = dtos
+	resp.Total = total
+
+	return resp, nil
+}
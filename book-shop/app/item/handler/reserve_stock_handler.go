@@ -0,0 +1,55 @@
+// Copyright 2023 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package handler
+
+import (
+	"context"
+	"time"
+
+	"github.com/cloudwego/biz-demo/book-shop/app/item/domain/service"
+	"github.com/cloudwego/biz-demo/book-shop/kitex_gen/cwg/bookshop/item"
+	"github.com/cloudwego/biz-demo/book-shop/pkg/errno"
+)
+
+type ReserveStockHandler struct {
+	ctx   context.Context
+	param *item.ReserveStockReq
+}
+
+func NewReserveStockHandler(ctx context.Context, req *item.ReserveStockReq) *ReserveStockHandler {
+	return &ReserveStockHandler{
+		ctx:   ctx,
+		param: req,
+	}
+}
+
+func (h *ReserveStockHandler) ReserveStock() (*item.ReserveStockResp, error) {
+	resp := &item.ReserveStockResp{
+		BaseResp: errno.BuildBaseResp(errno.Success),
+	}
+
+	stockService := service.GetProductStockServiceInstance()
+	ttl := time.Duration(h.param.GetTtlSeconds()) * time.Second
+	reservation, err := stockService.ReserveStock(h.ctx, h.param.ProductId, h.param.GetSkuId(), h.param.StockNum, ttl)
+	if err != nil {
+		resp.BaseResp = errno.BuildBaseResp(err)
+		return resp, nil
+	}
+	resp.ReservationId = reservation.ReservationId
+	resp.ExpireTime = reservation.ExpireAt.Unix()
+
+	return resp, nil
+}
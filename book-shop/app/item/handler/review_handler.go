@@ -0,0 +1,56 @@
+// Copyright 2023 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package handler
+
+import (
+	"context"
+
+	"github.com/cloudwego/biz-demo/book-shop/app/item/common/constant"
+	"github.com/cloudwego/biz-demo/book-shop/app/item/domain/service"
+	"github.com/cloudwego/biz-demo/book-shop/kitex_gen/cwg/bookshop/item"
+	"github.com/cloudwego/biz-demo/book-shop/pkg/errno"
+)
+
+type ReviewHandler struct {
+	ctx   context.Context
+	param *item.ReviewReq
+}
+
+func NewReviewHandler(ctx context.Context, req *item.ReviewReq) *ReviewHandler {
+	return &ReviewHandler{
+		ctx:   ctx,
+		param: req,
+	}
+}
+
+func (h *ReviewHandler) Review() (*item.ReviewResp, error) {
+	resp := &item.ReviewResp{
+		BaseResp: errno.BuildBaseResp(errno.Success),
+	}
+
+	operation := constant.StateOperationTypeReject
+	if h.param.Approved {
+		operation = constant.StateOperationTypeApprove
+	}
+	stateService := service.GetProductStateService()
+	err := stateService.ApplyStateOperation(h.ctx, h.param.ProductId, operation, h.param.GetOperatorName())
+	if err != nil {
+		resp.BaseResp = errno.BuildBaseResp(err)
+		return resp, nil
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,59 @@
+// Copyright 2023 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package handler
+
+import (
+	"context"
+	"time"
+
+	"github.com/cloudwego/biz-demo/book-shop/app/item/domain/service"
+	"github.com/cloudwego/biz-demo/book-shop/kitex_gen/cwg/bookshop/item"
+	"github.com/cloudwego/biz-demo/book-shop/pkg/errno"
+)
+
+type SchedulePriceChangeHandler struct {
+	ctx   context.Context
+	param *item.SchedulePriceChangeReq
+}
+
+func NewSchedulePriceChangeHandler(ctx context.Context, req *item.SchedulePriceChangeReq) *SchedulePriceChangeHandler {
+	return &SchedulePriceChangeHandler{
+		ctx:   ctx,
+		param: req,
+	}
+}
+
+func (h *SchedulePriceChangeHandler) SchedulePriceChange() (*item.SchedulePriceChangeResp, error) {
+	resp := &item.SchedulePriceChangeResp{
+		BaseResp: errno.BuildBaseResp(errno.Success),
+	}
+
+	var effectiveTo *time.Time
+	if h.param.EffectiveTo != nil {
+		t := time.Unix(*h.param.EffectiveTo, 0)
+		effectiveTo = &t
+	}
+	priceService := service.GetProductPriceServiceInstance()
+	priceId, err := priceService.SchedulePriceChange(h.ctx, h.param.ProductId, h.param.GetSkuId(), h.param.Price,
+		time.Unix(h.param.EffectiveFrom, 0), effectiveTo, h.param.GetOperatorName())
+	if err != nil {
+		resp.BaseResp = errno.BuildBaseResp(err)
+		return resp, nil
+	}
+	resp.PriceId = priceId
+
+	return resp, nil
+}
@@ -0,0 +1,54 @@
+// Copyright 2023 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package handler
+
+import (
+	"context"
+	"time"
+
+	"github.com/cloudwego/biz-demo/book-shop/app/item/domain/service"
+	"github.com/cloudwego/biz-demo/book-shop/kitex_gen/cwg/bookshop/item"
+	"github.com/cloudwego/biz-demo/book-shop/pkg/errno"
+)
+
+type ScheduleStateChangeHandler struct {
+	ctx   context.Context
+	param *item.ScheduleStateChangeReq
+}
+
+func NewScheduleStateChangeHandler(ctx context.Context, req *item.ScheduleStateChangeReq) *ScheduleStateChangeHandler {
+	return &ScheduleStateChangeHandler{
+		ctx:   ctx,
+		param: req,
+	}
+}
+
+func (h *ScheduleStateChangeHandler) ScheduleStateChange() (*item.ScheduleStateChangeResp, error) {
+	resp := &item.ScheduleStateChangeResp{
+		BaseResp: errno.BuildBaseResp(errno.Success),
+	}
+
+	stateService := service.GetProductStateService()
+	scheduleId, err := stateService.ScheduleStateChange(h.ctx, h.param.ProductId, int64(h.param.TargetStatus),
+		time.Unix(h.param.ExecuteTime, 0), h.param.GetOperatorName())
+	if err != nil {
+		resp.BaseResp = errno.BuildBaseResp(err)
+		return resp, nil
+	}
+	resp.ScheduleId = scheduleId
+
+	return resp, nil
+}
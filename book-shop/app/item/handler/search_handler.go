@@ -42,17 +42,12 @@ func (h *SearchHandler) Search() (*item.SearchResp, error) {
 	}
 
 	queryService := service.GetProductQueryServiceInstance()
-	entities, err := queryService.Search(h.ctx, h.param.Name, h.param.Description, h.param.SpuName)
+	result, err := queryService.Search(h.ctx, converter.ConvertSearchReq2Query(h.param))
 	if err != nil {
 		resp.BaseResp = errno.BuildBaseResp(err)
 		return resp, nil
 	}
-	dtos := make([]*item.Product, 0)
-	for _, e := range entities {
-		dtos = append(dtos, converter.ConvertEntity2DTO(e))
-	}
-
-	resp.Products = dtos
+	converter.ConvertSearchResult2Resp(result, resp)
 
 	return resp, nil
 }
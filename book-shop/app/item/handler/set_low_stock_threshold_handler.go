@@ -0,0 +1,50 @@
+// Copyright 2023 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package handler
+
+import (
+	"context"
+
+	"github.com/cloudwego/biz-demo/book-shop/app/item/domain/service"
+	"github.com/cloudwego/biz-demo/book-shop/kitex_gen/cwg/bookshop/item"
+	"github.com/cloudwego/biz-demo/book-shop/pkg/errno"
+)
+
+type SetLowStockThresholdHandler struct {
+	ctx   context.Context
+	param *item.SetLowStockThresholdReq
+}
+
+func NewSetLowStockThresholdHandler(ctx context.Context, req *item.SetLowStockThresholdReq) *SetLowStockThresholdHandler {
+	return &SetLowStockThresholdHandler{
+		ctx:   ctx,
+		param: req,
+	}
+}
+
+func (h *SetLowStockThresholdHandler) SetLowStockThreshold() (*item.SetLowStockThresholdResp, error) {
+	resp := &item.SetLowStockThresholdResp{
+		BaseResp: errno.BuildBaseResp(errno.Success),
+	}
+
+	lowStockService := service.GetLowStockServiceInstance()
+	if err := lowStockService.SetThreshold(h.ctx, h.param.ProductId, h.param.Threshold); err != nil {
+		resp.BaseResp = errno.BuildBaseResp(err)
+		return resp, nil
+	}
+
+	return resp, nil
+}
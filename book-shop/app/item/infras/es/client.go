@@ -21,6 +21,7 @@ import (
 	"sync"
 
 	"github.com/bytedance/sonic"
+	"github.com/cloudwego/biz-demo/book-shop/app/item/common/constant"
 	"github.com/cloudwego/biz-demo/book-shop/app/item/common/entity"
 	"github.com/cloudwego/biz-demo/book-shop/pkg/conf"
 	"github.com/olivere/elastic/v7"
@@ -56,6 +57,18 @@ func UpsertProductES(ctx context.Context, productId int64, product *entity.Produ
 	return err
 }
 
+// IndexProductES overwrites the product doc with an external version,
+// a doc that is older than the indexed one is ignored
+func IndexProductES(ctx context.Context, productId int64, product *entity.ProductEntity, version int64) error {
+	doc := getDocFromEntity(product)
+	_, err := GetESClient().Index().Index(conf.ProductESIndex).Id(strconv.FormatInt(productId, 10)).
+		BodyJson(doc).VersionType("external").Version(version).Refresh("true").Do(ctx)
+	if elastic.IsConflict(err) {
+		return nil
+	}
+	return err
+}
+
 func BatchGetProductById(ctx context.Context, productIds []int64) ([]*entity.ProductEntity, error) {
 	mgetSvc := GetESClient().MultiGet()
 	for _, id := range productIds {
@@ -69,38 +82,170 @@ func BatchGetProductById(ctx context.Context, productIds []int64) ([]*entity.Pro
 	}
 	entities := make([]*entity.ProductEntity, 0)
 	for _, doc := range rsp.Docs {
+		if !doc.Found {
+			continue
+		}
 		entities = append(entities, getEntityFromSource(string(doc.Source)))
 	}
 	return entities, nil
 }
 
-func SearchProduct(ctx context.Context, filter map[string]interface{}) ([]*entity.ProductEntity, error) {
+// SearchProduct searches online products with search_after pagination,
+// price buckets and spu_name facets are aggregated over all the hits.
+func SearchProduct(ctx context.Context, query *entity.ProductSearchQuery) (*entity.ProductSearchResult, error) {
 	boolQuery := elastic.NewBoolQuery()
-	for k, v := range filter {
-		boolQuery.Must(elastic.NewMatchQuery(k, v))
+	if query.Name != nil {
+		boolQuery.Must(elastic.NewMatchQuery("name", *query.Name))
+	}
+	if query.Description != nil {
+		boolQuery.Must(elastic.NewMatchQuery("description", *query.Description))
+	}
+	if query.SpuName != nil {
+		boolQuery.Must(elastic.NewMatchQuery("spu_name", *query.SpuName))
 	}
-	searchResult, err := GetESClient().Search().
+	boolQuery.Filter(elastic.NewTermQuery("status", constant.ProductStatusOnline))
+	if query.MinPrice != nil || query.MaxPrice != nil {
+		priceQuery := elastic.NewRangeQuery("price")
+		if query.MinPrice != nil {
+			priceQuery.Gte(*query.MinPrice)
+		}
+		if query.MaxPrice != nil {
+			priceQuery.Lte(*query.MaxPrice)
+		}
+		boolQuery.Filter(priceQuery)
+	}
+	if query.CategoryId != nil {
+		boolQuery.Filter(elastic.NewTermQuery("category_path_ids", *query.CategoryId))
+	}
+	if query.Tag != nil {
+		boolQuery.Filter(elastic.NewTermQuery("tags", *query.Tag))
+	}
+
+	searchSvc := GetESClient().Search().
 		Index(conf.ProductESIndex).
 		Query(boolQuery).
-		Size(10000).
-		From(0).
-		Do(ctx)
+		TrackTotalHits(true).
+		Size(query.PageSize+1).
+		Aggregation("price_buckets", newPriceBucketAggregation()).
+		Aggregation("spu_name_facets", elastic.NewTermsAggregation().Field("spu_name.keyword").Size(constant.SearchSpuNameFacetSize)).
+		Aggregation("category_facets", elastic.NewTermsAggregation().Field("category_path_ids").Size(constant.SearchCategoryFacetSize))
+
+	sortField := ""
+	switch query.SortField {
+	case constant.SearchSortFieldPrice:
+		sortField = "price"
+	case constant.SearchSortFieldStock:
+		sortField = "stock"
+	}
+	if sortField == "" {
+		searchSvc.SortBy(elastic.NewScoreSort(), elastic.NewFieldSort("product_id").Asc())
+	} else {
+		searchSvc.SortBy(elastic.NewFieldSort(sortField).Order(!query.SortDesc), elastic.NewFieldSort("product_id").Asc())
+	}
+	if query.Cursor != "" {
+		cursor, err := entity.DecodeSearchCursor(query.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		if sortField == "" {
+			searchSvc.SearchAfter(cursor.Score, cursor.ProductId)
+		} else {
+			searchSvc.SearchAfter(cursor.Value, cursor.ProductId)
+		}
+	}
+
+	searchResult, err := searchSvc.Do(ctx)
 	if err != nil {
 		return nil, err
 	}
-	if searchResult.Hits.TotalHits.Value <= 0 || len(searchResult.Hits.Hits) <= 0 {
-		return []*entity.ProductEntity{}, nil
+	ret := &entity.ProductSearchResult{
+		Products:       make([]*entity.ProductEntity, 0),
+		PriceBuckets:   make([]*entity.PriceBucketEntity, 0),
+		SpuNameFacets:  make([]*entity.FacetBucketEntity, 0),
+		CategoryFacets: make([]*entity.CategoryFacetEntity, 0),
+	}
+	if searchResult.Hits.TotalHits != nil {
+		ret.Total = searchResult.Hits.TotalHits.Value
+	}
+	hits := searchResult.Hits.Hits
+	if len(hits) > query.PageSize {
+		hits = hits[:query.PageSize]
+		ret.HasMore = true
+	}
+	for _, hit := range hits {
+		ret.Products = append(ret.Products, getEntityFromSource(string(hit.Source)))
+	}
+	if ret.HasMore {
+		last := ret.Products[len(ret.Products)-1]
+		cursor := &entity.SearchCursor{ProductId: last.ProductId}
+		switch sortField {
+		case "price":
+			cursor.Value = last.Price
+		case "stock":
+			cursor.Value = last.Stock
+		default:
+			if score := hits[len(hits)-1].Score; score != nil {
+				cursor.Score = *score
+			}
+		}
+		if ret.NextCursor, err = cursor.Encode(); err != nil {
+			return nil, err
+		}
+	}
+
+	if agg, ok := searchResult.Aggregations.Range("price_buckets"); ok {
+		for _, bucket := range agg.Buckets {
+			b := &entity.PriceBucketEntity{Count: bucket.DocCount}
+			if bucket.From != nil {
+				from := int64(*bucket.From)
+				b.MinPrice = &from
+			}
+			if bucket.To != nil {
+				to := int64(*bucket.To)
+				b.MaxPrice = &to
+			}
+			ret.PriceBuckets = append(ret.PriceBuckets, b)
+		}
+	}
+	if agg, ok := searchResult.Aggregations.Terms("spu_name_facets"); ok {
+		for _, bucket := range agg.Buckets {
+			key, _ := bucket.Key.(string)
+			ret.SpuNameFacets = append(ret.SpuNameFacets, &entity.FacetBucketEntity{Key: key, Count: bucket.DocCount})
+		}
 	}
-	ret := make([]*entity.ProductEntity, 0)
-	for _, hit := range searchResult.Hits.Hits {
-		ret = append(ret, getEntityFromSource(string(hit.Source)))
+	if agg, ok := searchResult.Aggregations.Terms("category_facets"); ok {
+		for _, bucket := range agg.Buckets {
+			key, _ := bucket.Key.(float64)
+			ret.CategoryFacets = append(ret.CategoryFacets, &entity.CategoryFacetEntity{
+				CategoryId: int64(key),
+				Count:      bucket.DocCount,
+			})
+		}
 	}
 	return ret, nil
 }
 
+func newPriceBucketAggregation() *elastic.RangeAggregation {
+	agg := elastic.NewRangeAggregation().Field("price")
+	bounds := constant.SearchPriceRangeBounds
+	for i, bound := range bounds {
+		if i == 0 {
+			agg.AddUnboundedFrom(bound)
+		} else {
+			agg.AddRange(bounds[i-1], bound)
+		}
+	}
+	if len(bounds) > 0 {
+		agg.AddUnboundedTo(bounds[len(bounds)-1])
+	}
+	return agg
+}
+
 func getEntityFromSource(source string) *entity.ProductEntity {
 	sourceMap := make(map[string]interface{})
 	_ = sonic.UnmarshalString(source, &sourceMap)
+	// the documents indexed before the author was added have none
+	author, _ := sourceMap["author"].(string)
 	ret := &entity.ProductEntity{
 		ProductId:   int64(sourceMap["product_id"].(float64)),
 		Name:        sourceMap["name"].(string),
@@ -110,11 +255,78 @@ func getEntityFromSource(source string) *entity.ProductEntity {
 			ISBN:     sourceMap["isbn"].(string),
 			SpuName:  sourceMap["spu_name"].(string),
 			SpuPrice: int64(sourceMap["spu_price"].(float64)),
+			Author:   author,
 		},
 		Price:  int64(sourceMap["price"].(float64)),
 		Stock:  int64(sourceMap["stock"].(float64)),
 		Status: int64(sourceMap["status"].(float64)),
 	}
+	if promoPrice, ok := sourceMap["promo_price"].(float64); ok {
+		ret.PromoPrices = map[int64]int64{0: int64(promoPrice)}
+	}
+	categoryIds, _ := sourceMap["category_ids"].([]interface{})
+	for _, id := range categoryIds {
+		if v, ok := id.(float64); ok {
+			ret.CategoryIds = append(ret.CategoryIds, int64(v))
+		}
+	}
+	categoryPaths, _ := sourceMap["category_paths"].([]interface{})
+	for _, path := range categoryPaths {
+		if v, ok := path.(string); ok {
+			ret.CategoryPaths = append(ret.CategoryPaths, v)
+		}
+	}
+	tags, _ := sourceMap["tags"].([]interface{})
+	for _, tag := range tags {
+		if v, ok := tag.(string); ok {
+			ret.Tags = append(ret.Tags, v)
+		}
+	}
+	gallery, _ := sourceMap["gallery"].([]interface{})
+	for _, g := range gallery {
+		mediaMap, ok := g.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		media := &entity.ProductMediaEntity{
+			MediaId:   int64(mediaMap["media_id"].(float64)),
+			ProductId: ret.ProductId,
+			Width:     int32(mediaMap["width"].(float64)),
+			Height:    int32(mediaMap["height"].(float64)),
+			SortOrder: int32(mediaMap["sort_order"].(float64)),
+		}
+		media.URL, _ = mediaMap["url"].(string)
+		media.ThumbnailURL, _ = mediaMap["thumbnail_url"].(string)
+		media.IsPrimary, _ = mediaMap["is_primary"].(bool)
+		ret.Gallery = append(ret.Gallery, media)
+	}
+	skus, _ := sourceMap["skus"].([]interface{})
+	for _, s := range skus {
+		skuMap, ok := s.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		sku := &entity.SkuEntity{
+			SkuId:   int64(skuMap["sku_id"].(float64)),
+			Edition: skuMap["edition"].(string),
+			ISBN:    skuMap["isbn"].(string),
+			Price:   int64(skuMap["price"].(float64)),
+			Stock:   int64(skuMap["stock"].(float64)),
+		}
+		if attributes, ok := skuMap["attributes"].(map[string]interface{}); ok {
+			sku.Attributes = make(map[string]string, len(attributes))
+			for k, v := range attributes {
+				sku.Attributes[k], _ = v.(string)
+			}
+		}
+		if promoPrice, ok := skuMap["promo_price"].(float64); ok {
+			if ret.PromoPrices == nil {
+				ret.PromoPrices = make(map[int64]int64)
+			}
+			ret.PromoPrices[sku.SkuId] = int64(promoPrice)
+		}
+		ret.Skus = append(ret.Skus, sku)
+	}
 	return ret
 }
 
@@ -122,16 +334,61 @@ func getDocFromEntity(e *entity.ProductEntity) map[string]interface{} {
 	ret := map[string]interface{}{
 		"product_id":  e.ProductId,
 		"name":        e.Name,
-		"pic":         e.Pic,
+		"pic":         e.PrimaryPic(),
 		"description": e.Description,
 		"price":       e.Price,
 		"stock":       e.Stock,
 		"status":      e.Status,
 	}
+	// category_path_ids has the ancestors as well, so that a product is found under every level of its categories
+	if len(e.CategoryIds) > 0 {
+		ret["category_ids"] = e.CategoryIds
+		ret["category_paths"] = e.CategoryPaths
+		ret["category_path_ids"] = e.CategoryAncestorIds()
+	}
+	if len(e.Tags) > 0 {
+		ret["tags"] = e.Tags
+	}
+	if len(e.Gallery) > 0 {
+		gallery := make([]map[string]interface{}, 0, len(e.Gallery))
+		for _, media := range e.Gallery {
+			gallery = append(gallery, map[string]interface{}{
+				"media_id":      media.MediaId,
+				"url":           media.URL,
+				"thumbnail_url": media.ThumbnailURL,
+				"width":         media.Width,
+				"height":        media.Height,
+				"sort_order":    media.SortOrder,
+				"is_primary":    media.IsPrimary,
+			})
+		}
+		ret["gallery"] = gallery
+	}
 	if e.Property != nil {
 		ret["isbn"] = e.Property.ISBN
 		ret["spu_name"] = e.Property.SpuName
 		ret["spu_price"] = e.Property.SpuPrice
+		ret["author"] = e.Property.Author
+	}
+	if len(e.Skus) > 0 {
+		skus := make([]map[string]interface{}, 0, len(e.Skus))
+		for _, sku := range e.Skus {
+			skuDoc := map[string]interface{}{
+				"sku_id":     sku.SkuId,
+				"edition":    sku.Edition,
+				"attributes": sku.Attributes,
+				"isbn":       sku.ISBN,
+				"price":      sku.Price,
+				"stock":      sku.Stock,
+			}
+			if promoPrice := e.GetPromoPrice(sku.SkuId); promoPrice != nil {
+				skuDoc["promo_price"] = *promoPrice
+			}
+			skus = append(skus, skuDoc)
+		}
+		ret["skus"] = skus
+	} else if promoPrice := e.GetPromoPrice(0); promoPrice != nil {
+		ret["promo_price"] = *promoPrice
 	}
 	return ret
 }
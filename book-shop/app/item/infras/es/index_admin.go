@@ -0,0 +1,270 @@
+// Copyright 2023 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package es
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/bytedance/sonic"
+	"github.com/cloudwego/biz-demo/book-shop/app/item/common/entity"
+	"github.com/cloudwego/biz-demo/book-shop/pkg/conf"
+	"github.com/olivere/elastic/v7"
+)
+
+// productIndexMapping is the mapping of the versioned product indices,
+// it keeps the same field types that the dynamic mapping used to produce.
+// The skus are nested under their product so that a sku can be matched on its own fields,
+// the gallery is only kept in the source.
+const productIndexMapping = `{
+	"mappings": {
+		"properties": {
+			"product_id":        {"type": "long"},
+			"name":              {"type": "text", "fields": {"keyword": {"type": "keyword", "ignore_above": 256}}},
+			"pic":               {"type": "text", "fields": {"keyword": {"type": "keyword", "ignore_above": 256}}},
+			"description":       {"type": "text", "fields": {"keyword": {"type": "keyword", "ignore_above": 256}}},
+			"isbn":              {"type": "text", "fields": {"keyword": {"type": "keyword", "ignore_above": 256}}},
+			"spu_name":          {"type": "text", "fields": {"keyword": {"type": "keyword", "ignore_above": 256}}},
+			"spu_price":         {"type": "long"},
+			"author":            {"type": "text", "fields": {"keyword": {"type": "keyword", "ignore_above": 256}}},
+			"price":             {"type": "long"},
+			"promo_price":       {"type": "long"},
+			"stock":             {"type": "long"},
+			"status":            {"type": "long"},
+			"gallery":           {"type": "object", "enabled": false},
+			"tags":              {"type": "keyword"},
+			"category_ids":      {"type": "long"},
+			"category_paths":    {"type": "keyword"},
+			"category_path_ids": {"type": "long"},
+			"skus": {
+				"type": "nested",
+				"properties": {
+					"sku_id":      {"type": "long"},
+					"edition":     {"type": "text", "fields": {"keyword": {"type": "keyword", "ignore_above": 256}}},
+					"attributes":  {"type": "flattened"},
+					"isbn":        {"type": "text", "fields": {"keyword": {"type": "keyword", "ignore_above": 256}}},
+					"price":       {"type": "long"},
+					"promo_price": {"type": "long"},
+					"stock":       {"type": "long"}
+				}
+			}
+		}
+	}
+}`
+
+// ProductDoc is a product document read back from ES
+type ProductDoc struct {
+	Source  map[string]interface{}
+	Version int64
+}
+
+// NewProductIndexName returns a new versioned index name for conf.ProductESIndex
+func NewProductIndexName() string {
+	return conf.ProductESIndex + "_" + time.Now().Format("20060102150405")
+}
+
+func CreateProductIndex(ctx context.Context, index string) error {
+	_, err := GetESClient().CreateIndex(index).BodyString(productIndexMapping).Do(ctx)
+	return err
+}
+
+// BulkIndexProducts writes products into the given index with version 0,
+// so every later outbox event overwrites them.
+func BulkIndexProducts(ctx context.Context, index string, products []*entity.ProductEntity) error {
+	if len(products) == 0 {
+		return nil
+	}
+	bulk := GetESClient().Bulk().Index(index)
+	for _, p := range products {
+		bulk.Add(elastic.NewBulkIndexRequest().
+			Id(strconv.FormatInt(p.ProductId, 10)).
+			VersionType("external_gte").
+			Version(0).
+			Doc(getDocFromEntity(p)))
+	}
+	rsp, err := bulk.Do(ctx)
+	if err != nil {
+		return err
+	}
+	for _, item := range rsp.Failed() {
+		if item.Status == http.StatusConflict {
+			continue
+		}
+		return fmt.Errorf("bulk index product %s failed: %v", item.Id, item.Error)
+	}
+	return nil
+}
+
+// SwapProductAlias points conf.ProductESIndex to the new index in one atomic request,
+// a legacy concrete index with the alias name is removed. The indices detached from the alias are returned.
+func SwapProductAlias(ctx context.Context, newIndex string) ([]string, error) {
+	cli := GetESClient()
+	exists, err := cli.IndexExists(conf.ProductESIndex).Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	actions := make([]elastic.AliasAction, 0)
+	oldIndices := make([]string, 0)
+	if exists {
+		aliases, err := cli.Aliases().Index(conf.ProductESIndex).Do(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for index := range aliases.Indices {
+			if index == newIndex {
+				continue
+			}
+			oldIndices = append(oldIndices, index)
+			if index == conf.ProductESIndex {
+				actions = append(actions, elastic.NewAliasRemoveIndexAction(index))
+			} else {
+				actions = append(actions, elastic.NewAliasRemoveAction(conf.ProductESIndex).Index(index))
+			}
+		}
+	}
+	actions = append(actions, elastic.NewAliasAddAction(conf.ProductESIndex).Index(newIndex))
+	_, err = cli.Alias().Action(actions...).Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(oldIndices)
+	return oldIndices, nil
+}
+
+// MGetProductDocs returns the found docs of conf.ProductESIndex by product id
+func MGetProductDocs(ctx context.Context, productIds []int64) (map[int64]*ProductDoc, error) {
+	ret := make(map[int64]*ProductDoc)
+	if len(productIds) == 0 {
+		return ret, nil
+	}
+	mgetSvc := GetESClient().MultiGet()
+	for _, id := range productIds {
+		mgetSvc.Add(elastic.NewMultiGetItem().
+			Index(conf.ProductESIndex).
+			Id(strconv.FormatInt(id, 10)))
+	}
+	rsp, err := mgetSvc.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, doc := range rsp.Docs {
+		if !doc.Found {
+			continue
+		}
+		productId, err := strconv.ParseInt(doc.Id, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		source := make(map[string]interface{})
+		if err := sonic.Unmarshal(doc.Source, &source); err != nil {
+			return nil, err
+		}
+		d := &ProductDoc{Source: source}
+		if doc.Version != nil {
+			d.Version = *doc.Version
+		}
+		ret[productId] = d
+	}
+	return ret, nil
+}
+
+// RepairProductDoc overwrites the doc at the version it was read with,
+// it loses to any outbox event published in the meantime.
+func RepairProductDoc(ctx context.Context, product *entity.ProductEntity, version int64) error {
+	_, err := GetESClient().Index().Index(conf.ProductESIndex).Id(strconv.FormatInt(product.ProductId, 10)).
+		BodyJson(getDocFromEntity(product)).VersionType("external_gte").Version(version).Refresh("true").Do(ctx)
+	if elastic.IsConflict(err) {
+		return nil
+	}
+	return err
+}
+
+// DeleteProductDoc deletes the doc at the version it was read with,
+// a doc written again in the meantime is kept.
+func DeleteProductDoc(ctx context.Context, productId, version int64) error {
+	_, err := GetESClient().Delete().Index(conf.ProductESIndex).Id(strconv.FormatInt(productId, 10)).
+		VersionType("external_gte").Version(version).Refresh("true").Do(ctx)
+	if elastic.IsNotFound(err) || elastic.IsConflict(err) {
+		return nil
+	}
+	return err
+}
+
+// ScrollProductIds walks over all the docs of conf.ProductESIndex, fn gets their versions by product id
+func ScrollProductIds(ctx context.Context, batchSize int, fn func(versions map[int64]int64) error) error {
+	scroll := GetESClient().Scroll(conf.ProductESIndex).
+		FetchSourceContext(elastic.NewFetchSourceContext(false)).
+		Version(true).
+		Size(batchSize)
+	defer func() {
+		_ = scroll.Clear(context.Background())
+	}()
+	for {
+		rsp, err := scroll.Do(ctx)
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		versions := make(map[int64]int64, len(rsp.Hits.Hits))
+		for _, hit := range rsp.Hits.Hits {
+			id, err := strconv.ParseInt(hit.Id, 10, 64)
+			if err != nil {
+				return err
+			}
+			if hit.Version != nil {
+				versions[id] = *hit.Version
+			}
+		}
+		if err := fn(versions); err != nil {
+			return err
+		}
+	}
+}
+
+// DiffProductDoc returns the fields whose value in ES differs from the product,
+// the expected doc is built by the same mapping used for indexing.
+func DiffProductDoc(product *entity.ProductEntity, source map[string]interface{}) ([]string, error) {
+	expectedStr, err := sonic.MarshalString(getDocFromEntity(product))
+	if err != nil {
+		return nil, err
+	}
+	expected := make(map[string]interface{})
+	if err := sonic.UnmarshalString(expectedStr, &expected); err != nil {
+		return nil, err
+	}
+	fields := make([]string, 0)
+	for k, v := range expected {
+		if !reflect.DeepEqual(v, source[k]) {
+			fields = append(fields, k)
+		}
+	}
+	for k := range source {
+		if _, ok := expected[k]; !ok {
+			fields = append(fields, k)
+		}
+	}
+	sort.Strings(fields)
+	return fields, nil
+}
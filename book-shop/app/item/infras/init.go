@@ -15,8 +15,12 @@
 
 package infras
 
-import "github.com/cloudwego/biz-demo/book-shop/app/item/infras/repository"
+import (
+	"github.com/cloudwego/biz-demo/book-shop/app/item/infras/outbox"
+	"github.com/cloudwego/biz-demo/book-shop/app/item/infras/repository"
+)
 
 func Init() {
 	repository.Init()
+	outbox.NewRelay(repository.DB, repository.ProductDocPublisher()).Start()
 }
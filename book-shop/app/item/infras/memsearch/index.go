@@ -0,0 +1,229 @@
+// Copyright 2023 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package memsearch
+
+import (
+	"context"
+	"math"
+	"sync"
+
+	"github.com/cloudwego/biz-demo/book-shop/app/item/common/entity"
+	"github.com/jinzhu/copier"
+)
+
+const (
+	bm25K1 = 1.2
+	bm25B  = 0.75
+
+	// keywordIgnoreAbove keeps the same limit as the keyword sub fields of the ES mapping
+	keywordIgnoreAbove = 256
+)
+
+// textField inverted index of a tokenized field
+type textField struct {
+	postings map[string]map[int64]int // term -> product id -> term frequency
+	lengths  map[int64]int            // product id -> token count
+	totalLen int
+}
+
+func newTextField() *textField {
+	return &textField{
+		postings: make(map[string]map[int64]int),
+		lengths:  make(map[int64]int),
+	}
+}
+
+func (f *textField) add(productId int64, text string) {
+	tokens := tokenize(text)
+	for _, t := range tokens {
+		if f.postings[t] == nil {
+			f.postings[t] = make(map[int64]int)
+		}
+		f.postings[t][productId]++
+	}
+	f.lengths[productId] = len(tokens)
+	f.totalLen += len(tokens)
+}
+
+func (f *textField) remove(productId int64, text string) {
+	for _, t := range tokenize(text) {
+		delete(f.postings[t], productId)
+		if len(f.postings[t]) == 0 {
+			delete(f.postings, t)
+		}
+	}
+	f.totalLen -= f.lengths[productId]
+	delete(f.lengths, productId)
+}
+
+// score BM25 score of the terms in the doc, matched is false if none of the terms is in the doc
+func (f *textField) score(productId int64, terms []string) (score float64, matched bool) {
+	docCount := float64(len(f.lengths))
+	if docCount == 0 {
+		return 0, false
+	}
+	avgLen := float64(f.totalLen) / docCount
+	docLen := float64(f.lengths[productId])
+	for _, t := range terms {
+		tf := float64(f.postings[t][productId])
+		if tf == 0 {
+			continue
+		}
+		matched = true
+		n := float64(len(f.postings[t]))
+		idf := math.Log(1 + (docCount-n+0.5)/(n+0.5))
+		norm := bm25K1 * (1 - bm25B + bm25B*docLen/math.Max(avgLen, 1))
+		score += idf * tf / (tf + norm)
+	}
+	return score, matched
+}
+
+// keywordField exact value index
+type keywordField map[string]map[int64]struct{}
+
+func (f keywordField) add(productId int64, value string) {
+	if len([]rune(value)) > keywordIgnoreAbove {
+		return
+	}
+	if f[value] == nil {
+		f[value] = make(map[int64]struct{})
+	}
+	f[value][productId] = struct{}{}
+}
+
+func (f keywordField) remove(productId int64, value string) {
+	delete(f[value], productId)
+	if len(f[value]) == 0 {
+		delete(f, value)
+	}
+}
+
+type document struct {
+	product *entity.ProductEntity
+	version int64
+}
+
+// Index an in-process product index with the same behavior as the ES product index
+type Index struct {
+	mu          sync.RWMutex
+	docs        map[int64]*document
+	name        *textField
+	description *textField
+	spuName     *textField
+	spuNameKey  keywordField
+	isbnKey     keywordField
+}
+
+var defaultIndex = NewIndex()
+
+// GetIndex the index shared by the item service
+func GetIndex() *Index {
+	return defaultIndex
+}
+
+func NewIndex() *Index {
+	return &Index{
+		docs:        make(map[int64]*document),
+		name:        newTextField(),
+		description: newTextField(),
+		spuName:     newTextField(),
+		spuNameKey:  make(keywordField),
+		isbnKey:     make(keywordField),
+	}
+}
+
+// Upsert indexes the product with an external version like es.IndexProductES,
+// a product older than the indexed one is ignored.
+func (i *Index) Upsert(ctx context.Context, productId int64, product *entity.ProductEntity, version int64) error {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	if old, ok := i.docs[productId]; ok {
+		if version <= old.version {
+			return nil
+		}
+		i.removeLocked(productId, old.product)
+	}
+	p := cloneProduct(product)
+	p.ProductId = productId
+	i.docs[productId] = &document{product: p, version: version}
+	i.name.add(productId, p.Name)
+	i.description.add(productId, p.Description)
+	i.spuName.add(productId, spuNameOf(p))
+	i.spuNameKey.add(productId, spuNameOf(p))
+	i.isbnKey.add(productId, isbnOf(p))
+	return nil
+}
+
+func (i *Index) Delete(productId int64) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	if old, ok := i.docs[productId]; ok {
+		i.removeLocked(productId, old.product)
+		delete(i.docs, productId)
+	}
+}
+
+func (i *Index) removeLocked(productId int64, p *entity.ProductEntity) {
+	i.name.remove(productId, p.Name)
+	i.description.remove(productId, p.Description)
+	i.spuName.remove(productId, spuNameOf(p))
+	i.spuNameKey.remove(productId, spuNameOf(p))
+	i.isbnKey.remove(productId, isbnOf(p))
+}
+
+// MGet returns the indexed products in the order of productIds, missing ones are skipped
+func (i *Index) MGet(productIds []int64) []*entity.ProductEntity {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+	ret := make([]*entity.ProductEntity, 0)
+	for _, id := range productIds {
+		if d, ok := i.docs[id]; ok {
+			ret = append(ret, cloneProduct(d.product))
+		}
+	}
+	return ret
+}
+
+// FindByISBN exact match on isbn
+func (i *Index) FindByISBN(isbn string) []*entity.ProductEntity {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+	ret := make([]*entity.ProductEntity, 0)
+	for id := range i.isbnKey[isbn] {
+		ret = append(ret, cloneProduct(i.docs[id].product))
+	}
+	return ret
+}
+
+func spuNameOf(p *entity.ProductEntity) string {
+	if p.Property == nil {
+		return ""
+	}
+	return p.Property.SpuName
+}
+
+func isbnOf(p *entity.ProductEntity) string {
+	if p.Property == nil {
+		return ""
+	}
+	return p.Property.ISBN
+}
+
+func cloneProduct(p *entity.ProductEntity) *entity.ProductEntity {
+	ret := &entity.ProductEntity{}
+	_ = copier.CopyWithOption(ret, p, copier.Option{DeepCopy: true})
+	return ret
+}
@@ -0,0 +1,273 @@
+// Copyright 2023 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package memsearch
+
+import (
+	"context"
+	"sort"
+
+	"github.com/cloudwego/biz-demo/book-shop/app/item/common/constant"
+	"github.com/cloudwego/biz-demo/book-shop/app/item/common/entity"
+)
+
+type hit struct {
+	product *entity.ProductEntity
+	score   float64
+}
+
+// Search has the same semantics as es.SearchProduct: every given text condition must match
+// at least one of its terms, only online products within the price range are returned,
+// pagination, sorting and aggregations work the same way.
+func (i *Index) Search(ctx context.Context, query *entity.ProductSearchQuery) (*entity.ProductSearchResult, error) {
+	var cursor *entity.SearchCursor
+	if query.Cursor != "" {
+		c, err := entity.DecodeSearchCursor(query.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		cursor = c
+	}
+
+	i.mu.RLock()
+	hits := i.matchLocked(query)
+	i.mu.RUnlock()
+
+	less := hitLess(query)
+	sort.Slice(hits, func(a, b int) bool {
+		return less(hits[a], hits[b])
+	})
+
+	ret := &entity.ProductSearchResult{
+		Products:       make([]*entity.ProductEntity, 0),
+		Total:          int64(len(hits)),
+		PriceBuckets:   priceBuckets(hits),
+		SpuNameFacets:  spuNameFacets(hits),
+		CategoryFacets: categoryFacets(hits),
+	}
+
+	page := hits
+	if cursor != nil {
+		after := cursorHit(query, cursor)
+		start := sort.Search(len(hits), func(n int) bool {
+			return less(after, hits[n])
+		})
+		page = hits[start:]
+	}
+	if len(page) > query.PageSize {
+		page = page[:query.PageSize]
+		ret.HasMore = true
+	}
+	for _, h := range page {
+		ret.Products = append(ret.Products, cloneProduct(h.product))
+	}
+	if ret.HasMore {
+		last := page[len(page)-1]
+		c := &entity.SearchCursor{ProductId: last.product.ProductId}
+		switch query.SortField {
+		case constant.SearchSortFieldPrice:
+			c.Value = last.product.Price
+		case constant.SearchSortFieldStock:
+			c.Value = last.product.Stock
+		default:
+			c.Score = last.score
+		}
+		var err error
+		if ret.NextCursor, err = c.Encode(); err != nil {
+			return nil, err
+		}
+	}
+	return ret, nil
+}
+
+func (i *Index) matchLocked(query *entity.ProductSearchQuery) []*hit {
+	type condition struct {
+		field *textField
+		terms []string
+	}
+	conditions := make([]condition, 0)
+	if query.Name != nil {
+		conditions = append(conditions, condition{i.name, tokenize(*query.Name)})
+	}
+	if query.Description != nil {
+		conditions = append(conditions, condition{i.description, tokenize(*query.Description)})
+	}
+	if query.SpuName != nil {
+		conditions = append(conditions, condition{i.spuName, tokenize(*query.SpuName)})
+	}
+
+	hits := make([]*hit, 0)
+	for id, d := range i.docs {
+		p := d.product
+		if p.Status != constant.ProductStatusOnline {
+			continue
+		}
+		if query.MinPrice != nil && p.Price < *query.MinPrice {
+			continue
+		}
+		if query.MaxPrice != nil && p.Price > *query.MaxPrice {
+			continue
+		}
+		if query.CategoryId != nil && !hasCategory(p, *query.CategoryId) {
+			continue
+		}
+		if query.Tag != nil && !hasTag(p, *query.Tag) {
+			continue
+		}
+		total, matched := 0.0, true
+		for _, c := range conditions {
+			score, ok := c.field.score(id, c.terms)
+			if !ok {
+				matched = false
+				break
+			}
+			total += score
+		}
+		if matched {
+			hits = append(hits, &hit{product: p, score: total})
+		}
+	}
+	return hits
+}
+
+// hitLess the sort order of es.SearchProduct, product id is the tie breaker
+func hitLess(query *entity.ProductSearchQuery) func(a, b *hit) bool {
+	var value func(h *hit) int64
+	switch query.SortField {
+	case constant.SearchSortFieldPrice:
+		value = func(h *hit) int64 { return h.product.Price }
+	case constant.SearchSortFieldStock:
+		value = func(h *hit) int64 { return h.product.Stock }
+	}
+	return func(a, b *hit) bool {
+		if value == nil {
+			if a.score != b.score {
+				return a.score > b.score
+			}
+		} else if va, vb := value(a), value(b); va != vb {
+			if query.SortDesc {
+				return va > vb
+			}
+			return va < vb
+		}
+		return a.product.ProductId < b.product.ProductId
+	}
+}
+
+// cursorHit a fake hit sitting at the position of the cursor
+func cursorHit(query *entity.ProductSearchQuery, cursor *entity.SearchCursor) *hit {
+	h := &hit{
+		product: &entity.ProductEntity{ProductId: cursor.ProductId},
+		score:   cursor.Score,
+	}
+	switch query.SortField {
+	case constant.SearchSortFieldPrice:
+		h.product.Price = cursor.Value
+	case constant.SearchSortFieldStock:
+		h.product.Stock = cursor.Value
+	}
+	return h
+}
+
+func priceBuckets(hits []*hit) []*entity.PriceBucketEntity {
+	bounds := constant.SearchPriceRangeBounds
+	buckets := make([]*entity.PriceBucketEntity, 0)
+	for n := 0; n <= len(bounds) && len(bounds) > 0; n++ {
+		b := &entity.PriceBucketEntity{}
+		if n > 0 {
+			minPrice := bounds[n-1]
+			b.MinPrice = &minPrice
+		}
+		if n < len(bounds) {
+			maxPrice := bounds[n]
+			b.MaxPrice = &maxPrice
+		}
+		for _, h := range hits {
+			if (b.MinPrice == nil || h.product.Price >= *b.MinPrice) &&
+				(b.MaxPrice == nil || h.product.Price < *b.MaxPrice) {
+				b.Count++
+			}
+		}
+		buckets = append(buckets, b)
+	}
+	return buckets
+}
+
+func spuNameFacets(hits []*hit) []*entity.FacetBucketEntity {
+	counts := make(map[string]int64)
+	for _, h := range hits {
+		name := spuNameOf(h.product)
+		if len([]rune(name)) > keywordIgnoreAbove {
+			continue
+		}
+		counts[name]++
+	}
+	facets := make([]*entity.FacetBucketEntity, 0, len(counts))
+	for k, v := range counts {
+		facets = append(facets, &entity.FacetBucketEntity{Key: k, Count: v})
+	}
+	sort.Slice(facets, func(a, b int) bool {
+		if facets[a].Count != facets[b].Count {
+			return facets[a].Count > facets[b].Count
+		}
+		return facets[a].Key < facets[b].Key
+	})
+	if len(facets) > constant.SearchSpuNameFacetSize {
+		facets = facets[:constant.SearchSpuNameFacetSize]
+	}
+	return facets
+}
+
+// categoryFacets a product counts for its categories and all their ancestors
+func categoryFacets(hits []*hit) []*entity.CategoryFacetEntity {
+	counts := make(map[int64]int64)
+	for _, h := range hits {
+		for _, id := range h.product.CategoryAncestorIds() {
+			counts[id]++
+		}
+	}
+	facets := make([]*entity.CategoryFacetEntity, 0, len(counts))
+	for k, v := range counts {
+		facets = append(facets, &entity.CategoryFacetEntity{CategoryId: k, Count: v})
+	}
+	sort.Slice(facets, func(a, b int) bool {
+		if facets[a].Count != facets[b].Count {
+			return facets[a].Count > facets[b].Count
+		}
+		return facets[a].CategoryId < facets[b].CategoryId
+	})
+	if len(facets) > constant.SearchCategoryFacetSize {
+		facets = facets[:constant.SearchCategoryFacetSize]
+	}
+	return facets
+}
+
+func hasCategory(p *entity.ProductEntity, categoryId int64) bool {
+	for _, id := range p.CategoryAncestorIds() {
+		if id == categoryId {
+			return true
+		}
+	}
+	return false
+}
+
+func hasTag(p *entity.ProductEntity, tag string) bool {
+	for _, t := range p.Tags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
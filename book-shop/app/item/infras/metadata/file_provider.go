@@ -0,0 +1,75 @@
+// Copyright 2023 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package metadata
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/bytedance/sonic"
+	"github.com/cloudwego/biz-demo/book-shop/app/item/common/entity"
+	"github.com/cloudwego/biz-demo/book-shop/pkg/isbn"
+)
+
+type bookRecord struct {
+	ISBN        string `json:"isbn"`
+	Title       string `json:"title"`
+	Author      string `json:"author"`
+	Description string `json:"description"`
+}
+
+// FileProvider the books of a JSON file loaded into memory, a fixture standing in for a real catalog
+type FileProvider struct {
+	books map[string]*entity.BookMetadataEntity
+}
+
+// NewFileProvider the isbns of the file can be in either form, they are normalized when loaded
+func NewFileProvider(path string) (*FileProvider, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	records := make([]*bookRecord, 0)
+	if err := sonic.Unmarshal(data, &records); err != nil {
+		return nil, fmt.Errorf("invalid book metadata file %s: %w", path, err)
+	}
+	p := &FileProvider{
+		books: make(map[string]*entity.BookMetadataEntity, len(records)),
+	}
+	for _, record := range records {
+		normalized, err := isbn.Normalize(record.ISBN)
+		if err != nil || normalized == "" {
+			return nil, fmt.Errorf("invalid isbn %q in book metadata file %s", record.ISBN, path)
+		}
+		p.books[normalized] = &entity.BookMetadataEntity{
+			ISBN:        normalized,
+			Title:       record.Title,
+			Author:      record.Author,
+			Description: record.Description,
+		}
+	}
+	return p, nil
+}
+
+func (p *FileProvider) Lookup(ctx context.Context, isbn string) (*entity.BookMetadataEntity, error) {
+	book, ok := p.books[isbn]
+	if !ok {
+		return nil, nil
+	}
+	ret := *book
+	return &ret, nil
+}
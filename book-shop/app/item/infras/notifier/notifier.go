@@ -0,0 +1,62 @@
+// Copyright 2023 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package notifier
+
+import (
+	"context"
+
+	"github.com/cloudwego/biz-demo/book-shop/app/item/common/entity"
+	"github.com/cloudwego/biz-demo/book-shop/app/item/domain/service"
+	"github.com/cloudwego/biz-demo/book-shop/pkg/conf"
+	"github.com/cloudwego/kitex/pkg/klog"
+)
+
+// NewLowStockNotifier the notifier of conf.LowStockNotifier, alerts are logged by default
+func NewLowStockNotifier() service.LowStockNotifier {
+	if conf.LowStockNotifier == conf.LowStockNotifierWebhook {
+		return NewWebhookNotifier(conf.LowStockWebhookURL)
+	}
+	return LogNotifier{}
+}
+
+// LogNotifier writes the alerts into the log
+type LogNotifier struct{}
+
+func (n LogNotifier) Notify(ctx context.Context, alert *entity.LowStockAlertEntity) error {
+	klog.CtxWarnf(ctx, "low stock: product %d %s has %d available, threshold %d",
+		alert.ProductId, alert.Name, alert.AvailableStock, alert.Threshold)
+	return nil
+}
+
+// ChannelNotifier hands the alerts to an in-process consumer, e.g. a test waiting for them
+type ChannelNotifier struct {
+	C chan *entity.LowStockAlertEntity
+}
+
+func NewChannelNotifier(size int) *ChannelNotifier {
+	return &ChannelNotifier{
+		C: make(chan *entity.LowStockAlertEntity, size),
+	}
+}
+
+func (n *ChannelNotifier) Notify(ctx context.Context, alert *entity.LowStockAlertEntity) error {
+	select {
+	case n.C <- alert:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
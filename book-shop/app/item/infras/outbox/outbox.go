@@ -0,0 +1,39 @@
+// Copyright 2023 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package outbox
+
+import (
+	"time"
+
+	"github.com/bytedance/sonic"
+	"github.com/cloudwego/biz-demo/book-shop/app/item/common/entity"
+	"github.com/cloudwego/biz-demo/book-shop/app/item/common/po"
+	"gorm.io/gorm"
+)
+
+// Append records the latest snapshot of the product in the outbox,
+// tx must be the transaction which writes the product row.
+func Append(tx *gorm.DB, product *entity.ProductEntity) error {
+	payload, err := sonic.MarshalString(product)
+	if err != nil {
+		return err
+	}
+	return tx.Create(&po.ProductOutbox{
+		ProductId:   product.ProductId,
+		Payload:     payload,
+		NextRetryAt: time.Now(),
+	}).Error
+}
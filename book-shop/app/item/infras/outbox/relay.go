@@ -0,0 +1,151 @@
+// Copyright 2023 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package outbox
+
+import (
+	"context"
+	"time"
+
+	"github.com/bytedance/sonic"
+	"github.com/cloudwego/biz-demo/book-shop/app/item/common/entity"
+	"github.com/cloudwego/biz-demo/book-shop/app/item/common/po"
+	"github.com/cloudwego/biz-demo/book-shop/pkg/utils"
+	"github.com/cloudwego/kitex/pkg/klog"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	relayInterval  = time.Second
+	relayBatchSize = 100
+	maxRetryTimes  = 16
+	maxRetryDelay  = 5 * time.Minute
+)
+
+// Publisher writes a product snapshot into the search index, older versions must be ignored
+type Publisher func(ctx context.Context, productId int64, product *entity.ProductEntity, version int64) error
+
+// Relay drains the product outbox into the search index.
+// Events of one product are published in the order they were written, the outbox id is used as
+// the external version of the doc, so a late retry never overwrites a newer snapshot.
+type Relay struct {
+	*utils.PeriodicRunner
+	db      *gorm.DB
+	publish Publisher
+}
+
+func NewRelay(db *gorm.DB, publish Publisher) *Relay {
+	r := &Relay{
+		db:      db,
+		publish: publish,
+	}
+	r.PeriodicRunner = utils.NewPeriodicRunner(relayInterval, r.drain)
+	return r
+}
+
+func (r *Relay) drain(ctx context.Context) {
+	for {
+		n, err := r.relayBatch(ctx)
+		if err != nil {
+			klog.CtxErrorf(ctx, "relay product outbox err: %v", err)
+			return
+		}
+		if n < relayBatchSize {
+			return
+		}
+	}
+}
+
+// relayBatch publish a batch of due events, rows are locked with SKIP LOCKED so that
+// several item instances can share the outbox.
+func (r *Relay) relayBatch(ctx context.Context) (int, error) {
+	events := make([]*po.ProductOutbox, 0)
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("next_retry_at <= ?", time.Now()).
+			Order("id").Limit(relayBatchSize).Find(&events).Error
+		if err != nil {
+			return err
+		}
+		// product id -> time when the blocked product can be retried
+		blocked := make(map[int64]time.Time)
+		for _, e := range events {
+			if retryAt, ok := blocked[e.ProductId]; ok {
+				// keep the order of the product, wait for the failed one
+				if err := tx.Model(e).Update("next_retry_at", retryAt).Error; err != nil {
+					return err
+				}
+				continue
+			}
+			pubErr := r.publishEvent(ctx, e)
+			if pubErr == nil {
+				if err := tx.Unscoped().Delete(e).Error; err != nil {
+					return err
+				}
+				continue
+			}
+			klog.CtxWarnf(ctx, "publish product outbox %d err: %v", e.ID, pubErr)
+			e.RetryTimes++
+			if e.RetryTimes >= maxRetryTimes {
+				if err := r.deadLetter(tx, e, pubErr); err != nil {
+					return err
+				}
+				continue
+			}
+			retryAt := time.Now().Add(retryDelay(e.RetryTimes))
+			blocked[e.ProductId] = retryAt
+			if err := tx.Model(e).Updates(map[string]interface{}{
+				"retry_times":   e.RetryTimes,
+				"next_retry_at": retryAt,
+				"last_error":    pubErr.Error(),
+			}).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return len(events), err
+}
+
+func (r *Relay) publishEvent(ctx context.Context, e *po.ProductOutbox) error {
+	product := &entity.ProductEntity{}
+	if err := sonic.UnmarshalString(e.Payload, product); err != nil {
+		return err
+	}
+	return r.publish(ctx, e.ProductId, product, int64(e.ID))
+}
+
+func (r *Relay) deadLetter(tx *gorm.DB, e *po.ProductOutbox, pubErr error) error {
+	klog.Errorf("product outbox %d moved to dead letter, product_id=%d, err: %v", e.ID, e.ProductId, pubErr)
+	if err := tx.Create(&po.ProductOutboxDeadLetter{
+		OutboxId:   int64(e.ID),
+		ProductId:  e.ProductId,
+		Payload:    e.Payload,
+		RetryTimes: e.RetryTimes,
+		LastError:  pubErr.Error(),
+	}).Error; err != nil {
+		return err
+	}
+	return tx.Unscoped().Delete(e).Error
+}
+
+func retryDelay(retryTimes int64) time.Duration {
+	delay := time.Second << retryTimes
+	if delay <= 0 || delay > maxRetryDelay {
+		return maxRetryDelay
+	}
+	return delay
+}
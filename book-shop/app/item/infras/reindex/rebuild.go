@@ -0,0 +1,76 @@
+// Copyright 2023 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package reindex
+
+import (
+	"context"
+	"time"
+
+	"github.com/cloudwego/biz-demo/book-shop/app/item/common/entity"
+	"github.com/cloudwego/biz-demo/book-shop/app/item/common/po"
+	"github.com/cloudwego/biz-demo/book-shop/app/item/infras/es"
+	"github.com/cloudwego/biz-demo/book-shop/app/item/infras/repository"
+	"github.com/cloudwego/kitex/pkg/klog"
+	"gorm.io/gorm"
+)
+
+const batchSize = 500
+
+// Rebuild streams t_product into a new versioned index and swaps the alias to it.
+// Rows changed while the index was being built are written again after the swap.
+func Rebuild(ctx context.Context, db *gorm.DB) (string, error) {
+	index := es.NewProductIndexName()
+	if err := es.CreateProductIndex(ctx, index); err != nil {
+		return "", err
+	}
+	startTime := time.Now()
+	total := 0
+	err := streamProducts(ctx, db.WithContext(ctx), func(products []*entity.ProductEntity) error {
+		total += len(products)
+		return es.BulkIndexProducts(ctx, index, products)
+	})
+	if err != nil {
+		return "", err
+	}
+	klog.CtxInfof(ctx, "%d products written to %s", total, index)
+
+	oldIndices, err := es.SwapProductAlias(ctx, index)
+	if err != nil {
+		return "", err
+	}
+	klog.CtxInfof(ctx, "alias switched to %s, detached indices: %v", index, oldIndices)
+
+	// catch up with the rows changed during the rebuild
+	catchUp := db.WithContext(ctx).Where("updated_at >= ?", startTime)
+	err = streamProducts(ctx, catchUp, func(products []*entity.ProductEntity) error {
+		return es.BulkIndexProducts(ctx, index, products)
+	})
+	if err != nil {
+		return "", err
+	}
+	return index, nil
+}
+
+func streamProducts(ctx context.Context, db *gorm.DB, fn func(products []*entity.ProductEntity) error) error {
+	batch := make([]*po.Product, 0, batchSize)
+	return db.FindInBatches(&batch, batchSize, func(tx *gorm.DB, _ int) error {
+		products, err := repository.LoadProductDOs(ctx, tx.Session(&gorm.Session{NewDB: true}), batch)
+		if err != nil {
+			return err
+		}
+		return fn(products)
+	}).Error
+}
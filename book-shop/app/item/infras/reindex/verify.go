@@ -0,0 +1,148 @@
+// Copyright 2023 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package reindex
+
+import (
+	"context"
+
+	"github.com/cloudwego/biz-demo/book-shop/app/item/common/entity"
+	"github.com/cloudwego/biz-demo/book-shop/app/item/common/po"
+	"github.com/cloudwego/biz-demo/book-shop/app/item/infras/es"
+	"gorm.io/gorm"
+)
+
+type DriftType string
+
+const (
+	DriftTypeMissing  DriftType = "missing"  // in MySQL but not in ES
+	DriftTypeMismatch DriftType = "mismatch" // fields differ
+	DriftTypeExtra    DriftType = "extra"    // in ES but not in MySQL
+)
+
+type Drift struct {
+	ProductId int64
+	Type      DriftType
+	Fields    []string
+}
+
+type Report struct {
+	Checked  int
+	Drifts   []*Drift
+	Repaired int
+}
+
+// Verify diffs every product in MySQL against its ES doc field by field,
+// the drifted docs are rewritten from MySQL when repair is true.
+func Verify(ctx context.Context, db *gorm.DB, repair bool) (*Report, error) {
+	report := &Report{Drifts: make([]*Drift, 0)}
+	productIds := make(map[int64]struct{})
+
+	err := streamProducts(ctx, db.WithContext(ctx), func(products []*entity.ProductEntity) error {
+		ids := make([]int64, 0, len(products))
+		for _, p := range products {
+			ids = append(ids, p.ProductId)
+			productIds[p.ProductId] = struct{}{}
+		}
+		docs, err := es.MGetProductDocs(ctx, ids)
+		if err != nil {
+			return err
+		}
+		for _, p := range products {
+			report.Checked++
+			doc, ok := docs[p.ProductId]
+			if !ok {
+				report.Drifts = append(report.Drifts, &Drift{ProductId: p.ProductId, Type: DriftTypeMissing})
+				if repair {
+					if err := es.RepairProductDoc(ctx, p, 0); err != nil {
+						return err
+					}
+					report.Repaired++
+				}
+				continue
+			}
+			fields, err := es.DiffProductDoc(p, doc.Source)
+			if err != nil {
+				return err
+			}
+			if len(fields) == 0 {
+				continue
+			}
+			report.Drifts = append(report.Drifts, &Drift{ProductId: p.ProductId, Type: DriftTypeMismatch, Fields: fields})
+			if repair {
+				if err := es.RepairProductDoc(ctx, p, doc.Version); err != nil {
+					return err
+				}
+				report.Repaired++
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = es.ScrollProductIds(ctx, batchSize, func(versions map[int64]int64) error {
+		candidates := make([]int64, 0)
+		for id := range versions {
+			if _, ok := productIds[id]; !ok {
+				candidates = append(candidates, id)
+			}
+		}
+		// a product created after MySQL was read is not extra
+		extraIds, err := filterMissingProducts(ctx, db, candidates)
+		if err != nil {
+			return err
+		}
+		for _, id := range extraIds {
+			report.Drifts = append(report.Drifts, &Drift{ProductId: id, Type: DriftTypeExtra})
+			if repair {
+				if err := es.DeleteProductDoc(ctx, id, versions[id]); err != nil {
+					return err
+				}
+				report.Repaired++
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+// filterMissingProducts returns the ids which are not in MySQL now
+func filterMissingProducts(ctx context.Context, db *gorm.DB, productIds []int64) ([]int64, error) {
+	if len(productIds) == 0 {
+		return productIds, nil
+	}
+	existing := make([]int64, 0)
+	err := db.WithContext(ctx).Model(&po.Product{}).Where("product_id IN ?", productIds).
+		Pluck("product_id", &existing).Error
+	if err != nil {
+		return nil, err
+	}
+	existingSet := make(map[int64]struct{}, len(existing))
+	for _, id := range existing {
+		existingSet[id] = struct{}{}
+	}
+	ret := make([]int64, 0)
+	for _, id := range productIds {
+		if _, ok := existingSet[id]; !ok {
+			ret = append(ret, id)
+		}
+	}
+	return ret, nil
+}
@@ -18,6 +18,7 @@ package converter
 import (
 	"context"
 
+	"github.com/bytedance/sonic"
 	"github.com/cloudwego/biz-demo/book-shop/app/item/common/entity"
 	"github.com/cloudwego/biz-demo/book-shop/app/item/common/po"
 )
@@ -35,15 +36,42 @@ func (converter *productDO2POConverter) Convert2po(ctx context.Context, do *enti
 		ISBN:        "",
 		SpuName:     "",
 		SpuPrice:    0,
+		Author:      "",
 		Price:       do.Price,
 		Stock:       do.Stock,
 		Status:      do.Status,
+		Version:     do.Version,
 	}
 	if do.Property != nil {
 		po.ISBN = do.Property.ISBN
 		po.SpuName = do.Property.SpuName
 		po.SpuPrice = do.Property.SpuPrice
+		po.Author = do.Property.Author
 	}
 
 	return po, nil
 }
+
+type skuDO2POConverter struct{}
+
+var SkuDO2POConverter = &skuDO2POConverter{}
+
+func (converter *skuDO2POConverter) Convert2po(ctx context.Context, productId int64, do *entity.SkuEntity) (*po.ProductSku, error) {
+	attributes := ""
+	if len(do.Attributes) > 0 {
+		var err error
+		if attributes, err = sonic.MarshalString(do.Attributes); err != nil {
+			return nil, err
+		}
+	}
+	po := &po.ProductSku{
+		SkuId:      do.SkuId,
+		ProductId:  productId,
+		Edition:    do.Edition,
+		Attributes: attributes,
+		ISBN:       do.ISBN,
+		Price:      do.Price,
+		Stock:      do.Stock,
+	}
+	return po, nil
+}
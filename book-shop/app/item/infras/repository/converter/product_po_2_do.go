@@ -18,6 +18,7 @@ package converter
 import (
 	"context"
 
+	"github.com/bytedance/sonic"
 	"github.com/cloudwego/biz-demo/book-shop/app/item/common/entity"
 	"github.com/cloudwego/biz-demo/book-shop/app/item/common/po"
 )
@@ -36,11 +37,49 @@ func (converter *productPO2DOConverter) Convert2do(ctx context.Context, po *po.P
 			ISBN:     po.ISBN,
 			SpuName:  po.SpuName,
 			SpuPrice: po.SpuPrice,
+			Author:   po.Author,
 		},
-		Price:  po.Price,
-		Stock:  po.Stock,
-		Status: po.Status,
+		Price:   po.Price,
+		Stock:   po.Stock,
+		Status:  po.Status,
+		Version: po.Version,
 	}
 
 	return do, nil
 }
+
+// Convert2doWithSkus the skus are attached in the given order
+func (converter *productPO2DOConverter) Convert2doWithSkus(ctx context.Context, po *po.Product, skuPOs []*po.ProductSku) (*entity.ProductEntity, error) {
+	do, err := converter.Convert2do(ctx, po)
+	if err != nil {
+		return nil, err
+	}
+	for _, skuPO := range skuPOs {
+		sku, err := SkuPO2DOConverter.Convert2do(ctx, skuPO)
+		if err != nil {
+			return nil, err
+		}
+		do.Skus = append(do.Skus, sku)
+	}
+	return do, nil
+}
+
+type skuPO2DOConverter struct{}
+
+var SkuPO2DOConverter = skuPO2DOConverter{}
+
+func (converter *skuPO2DOConverter) Convert2do(ctx context.Context, po *po.ProductSku) (*entity.SkuEntity, error) {
+	do := &entity.SkuEntity{
+		SkuId:   po.SkuId,
+		Edition: po.Edition,
+		ISBN:    po.ISBN,
+		Price:   po.Price,
+		Stock:   po.Stock,
+	}
+	if po.Attributes != "" {
+		if err := sonic.UnmarshalString(po.Attributes, &do.Attributes); err != nil {
+			return nil, err
+		}
+	}
+	return do, nil
+}
@@ -16,6 +16,9 @@
 package differ
 
 import (
+	"fmt"
+
+	"github.com/cloudwego/biz-demo/book-shop/app/item/common/entity"
 	"github.com/cloudwego/biz-demo/book-shop/app/item/common/po"
 	"github.com/r3labs/diff/v2"
 )
@@ -25,16 +28,37 @@ type productPODiffer struct{}
 var ProductPODiffer *productPODiffer
 
 func (differ *productPODiffer) GetChangedMap(origin, target *po.Product) map[string]interface{} {
-	d, _ := diff.NewDiffer(diff.TagName("json"))
 	changedMap := make(map[string]interface{})
+	for _, change := range differ.getChangeLog(origin, target) {
+		changedMap[change.Path[0]] = change.To
+	}
+	return changedMap
+}
+
+// GetFieldChanges the changed columns with their old and new values, used by the product history
+func (differ *productPODiffer) GetFieldChanges(origin, target *po.Product) []*entity.FieldChangeEntity {
+	ret := make([]*entity.FieldChangeEntity, 0)
+	for _, change := range differ.getChangeLog(origin, target) {
+		ret = append(ret, &entity.FieldChangeEntity{
+			Field:  change.Path[0],
+			Before: fmt.Sprint(change.From),
+			After:  fmt.Sprint(change.To),
+		})
+	}
+	return ret
+}
+
+func (differ *productPODiffer) getChangeLog(origin, target *po.Product) diff.Changelog {
+	d, _ := diff.NewDiffer(diff.TagName("json"))
 	changeLog, _ := d.Diff(origin, target)
+	ret := make(diff.Changelog, 0, len(changeLog))
 	for _, change := range changeLog {
 		if depth := len(change.Path); depth != 1 {
 			continue
 		}
 		if change.Type == diff.UPDATE {
-			changedMap[change.Path[0]] = change.To
+			ret = append(ret, change)
 		}
 	}
-	return changedMap
+	return ret
 }
@@ -16,7 +16,13 @@
 package repository
 
 import (
+	"context"
+
+	"github.com/cloudwego/biz-demo/book-shop/app/item/common/po"
 	"github.com/cloudwego/biz-demo/book-shop/app/item/domain/repository"
+	"github.com/cloudwego/biz-demo/book-shop/app/item/infras/es"
+	"github.com/cloudwego/biz-demo/book-shop/app/item/infras/memsearch"
+	"github.com/cloudwego/biz-demo/book-shop/app/item/infras/outbox"
 	"github.com/cloudwego/biz-demo/book-shop/pkg/conf"
 	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
@@ -27,10 +33,52 @@ var DB *gorm.DB
 func register() {
 	productRepository := ProductRepositoryImpl{}
 	stockRepository := StockRepositoryImpl{}
-	product2CRepository := Product2CRepositoryImpl{}
+	scheduleRepository := ProductScheduleRepositoryImpl{}
+	importRepository := ProductImportRepositoryImpl{}
+	priceRepository := ProductPriceRepositoryImpl{}
+	mediaRepository := ProductMediaRepositoryImpl{}
+	categoryRepository := ProductCategoryRepositoryImpl{}
+	var product2CRepository repository.Product2CRepository = Product2CRepositoryImpl{}
+	if conf.ProductSearchBackend == conf.ProductSearchBackendMemory {
+		product2CRepository = Product2CMemRepositoryImpl{}
+	}
 	repository.GetRegistry().SetProductRepository(productRepository)
 	repository.GetRegistry().SetStockRepository(stockRepository)
 	repository.GetRegistry().SetProduct2CRepository(product2CRepository)
+	repository.GetRegistry().SetProductScheduleRepository(scheduleRepository)
+	repository.GetRegistry().SetProductImportRepository(importRepository)
+	repository.GetRegistry().SetProductPriceRepository(priceRepository)
+	repository.GetRegistry().SetProductMediaRepository(mediaRepository)
+	repository.GetRegistry().SetProductCategoryRepository(categoryRepository)
+}
+
+// ProductDocPublisher the publisher of the outbox relay, matching the configured search backend
+func ProductDocPublisher() outbox.Publisher {
+	if conf.ProductSearchBackend == conf.ProductSearchBackendMemory {
+		return memsearch.GetIndex().Upsert
+	}
+	return es.IndexProductES
+}
+
+// loadMemIndex fills the in-process index from t_product, later changes come from the outbox relay
+func loadMemIndex() {
+	ctx := context.Background()
+	batch := make([]*po.Product, 0)
+	err := DB.WithContext(ctx).FindInBatches(&batch, 500, func(tx *gorm.DB, _ int) error {
+		products, err := LoadProductDOs(ctx, tx.Session(&gorm.Session{NewDB: true}), batch)
+		if err != nil {
+			return err
+		}
+		for _, do := range products {
+			if err := memsearch.GetIndex().Upsert(ctx, do.ProductId, do, 0); err != nil {
+				return err
+			}
+		}
+		return nil
+	}).Error
+	if err != nil {
+		panic(err)
+	}
 }
 
 func initDB() {
@@ -49,4 +97,7 @@ func initDB() {
 func Init() {
 	register()
 	initDB()
+	if conf.ProductSearchBackend == conf.ProductSearchBackendMemory {
+		loadMemIndex()
+	}
 }
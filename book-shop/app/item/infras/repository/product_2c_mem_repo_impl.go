@@ -0,0 +1,36 @@
+// Copyright 2023 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package repository
+
+import (
+	"context"
+
+	"github.com/cloudwego/biz-demo/book-shop/app/item/common/entity"
+	"github.com/cloudwego/biz-demo/book-shop/app/item/infras/memsearch"
+)
+
+// Product2CMemRepositoryImpl serves the 2C reads from the in-process index instead of ES,
+// the index lives in one process, so it only fits a single item instance.
+type Product2CMemRepositoryImpl struct{}
+
+func (i Product2CMemRepositoryImpl) MGetProducts2C(ctx context.Context, productIds []int64) ([]*entity.ProductEntity, error) {
+	entities := memsearch.GetIndex().MGet(productIds)
+	return entities, nil
+}
+
+func (i Product2CMemRepositoryImpl) SearchProducts(ctx context.Context, query *entity.ProductSearchQuery) (*entity.ProductSearchResult, error) {
+	return memsearch.GetIndex().Search(ctx, query)
+}
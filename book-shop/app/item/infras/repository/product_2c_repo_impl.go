@@ -29,17 +29,6 @@ func (i Product2CRepositoryImpl) MGetProducts2C(ctx context.Context, productIds
 	return entities, err
 }
 
-func (i Product2CRepositoryImpl) SearchProducts(ctx context.Context, name, description, spuName *string) ([]*entity.ProductEntity, error) {
-	filterMap := make(map[string]interface{})
-	if name != nil {
-		filterMap["name"] = *name
-	}
-	if description != nil {
-		filterMap["description"] = *description
-	}
-	if spuName != nil {
-		filterMap["spu_name"] = *spuName
-	}
-	entities, err := es.SearchProduct(ctx, filterMap)
-	return entities, err
+func (i Product2CRepositoryImpl) SearchProducts(ctx context.Context, query *entity.ProductSearchQuery) (*entity.ProductSearchResult, error) {
+	return es.SearchProduct(ctx, query)
 }
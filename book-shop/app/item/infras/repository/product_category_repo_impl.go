@@ -0,0 +1,378 @@
+// Copyright 2023 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/bytedance/sonic"
+	"github.com/cloudwego/biz-demo/book-shop/app/item/common/constant"
+	"github.com/cloudwego/biz-demo/book-shop/app/item/common/entity"
+	"github.com/cloudwego/biz-demo/book-shop/app/item/common/po"
+	"github.com/cloudwego/biz-demo/book-shop/app/item/infras/outbox"
+	"github.com/cloudwego/biz-demo/book-shop/pkg/errno"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type ProductCategoryRepositoryImpl struct{}
+
+func (i ProductCategoryRepositoryImpl) CreateCategory(ctx context.Context, category *entity.CategoryEntity) error {
+	return DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		parentPath := ""
+		if category.ParentId != 0 {
+			parentPO, err := lockCategory(tx, category.ParentId)
+			if err != nil {
+				return err
+			}
+			parentPath = parentPO.Path
+		}
+		category.Path = entity.JoinCategoryPath(parentPath, category.CategoryId)
+		if len(entity.ParseCategoryPath(category.Path)) > constant.ProductCategoryMaxDepth {
+			return errCategoryTooDeep
+		}
+		if err := checkCategoryName(tx, category.ParentId, category.Name, category.CategoryId); err != nil {
+			return err
+		}
+		return tx.Create(&po.ProductCategory{
+			CategoryId: category.CategoryId,
+			ParentId:   category.ParentId,
+			Name:       category.Name,
+			SortOrder:  category.SortOrder,
+			Path:       category.Path,
+		}).Error
+	})
+}
+
+func (i ProductCategoryRepositoryImpl) UpdateCategory(ctx context.Context, categoryId int64, name *string, parentId *int64,
+	sortOrder *int32,
+) error {
+	return DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		categoryPO, err := lockCategory(tx, categoryId)
+		if err != nil {
+			return err
+		}
+		updates := make(map[string]interface{})
+		targetName, targetParentId := categoryPO.Name, categoryPO.ParentId
+		if name != nil {
+			targetName = *name
+			updates["name"] = targetName
+		}
+		if parentId != nil {
+			targetParentId = *parentId
+		}
+		if sortOrder != nil {
+			updates["sort_order"] = *sortOrder
+		}
+		if targetName != categoryPO.Name || targetParentId != categoryPO.ParentId {
+			if err := checkCategoryName(tx, targetParentId, targetName, categoryId); err != nil {
+				return err
+			}
+		}
+		if targetParentId != categoryPO.ParentId {
+			updates["parent_id"] = targetParentId
+			if err := moveCategory(ctx, tx, categoryPO, targetParentId); err != nil {
+				return err
+			}
+		}
+		if len(updates) == 0 {
+			return nil
+		}
+		return tx.Model(&po.ProductCategory{}).Where("category_id = ?", categoryId).Updates(updates).Error
+	})
+}
+
+func (i ProductCategoryRepositoryImpl) DeleteCategory(ctx context.Context, categoryId int64) error {
+	return DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// the lock keeps products from being put into the category meanwhile
+		if _, err := lockCategory(tx, categoryId); err != nil {
+			return err
+		}
+		var childCount int64
+		if err := tx.Model(&po.ProductCategory{}).Where("parent_id = ?", categoryId).Count(&childCount).Error; err != nil {
+			return err
+		}
+		if childCount > 0 {
+			return errno.ParamErr.WithMessage("a category with children can not be deleted")
+		}
+		var productCount int64
+		if err := tx.Model(&po.ProductCategoryRel{}).Where("category_id = ?", categoryId).Count(&productCount).Error; err != nil {
+			return err
+		}
+		if productCount > 0 {
+			return errno.ParamErr.WithMessage("a category with products can not be deleted")
+		}
+		// deleted for good, so that the name can be used again
+		return tx.Unscoped().Where("category_id = ?", categoryId).Delete(&po.ProductCategory{}).Error
+	})
+}
+
+func (i ProductCategoryRepositoryImpl) ListCategories(ctx context.Context) ([]*entity.CategoryEntity, error) {
+	categoryPOArr := make([]*po.ProductCategory, 0)
+	if err := DB.WithContext(ctx).Order("sort_order, id").Find(&categoryPOArr).Error; err != nil {
+		return nil, err
+	}
+	ret := make([]*entity.CategoryEntity, 0, len(categoryPOArr))
+	for _, categoryPO := range categoryPOArr {
+		ret = append(ret, &entity.CategoryEntity{
+			CategoryId: categoryPO.CategoryId,
+			ParentId:   categoryPO.ParentId,
+			Name:       categoryPO.Name,
+			SortOrder:  categoryPO.SortOrder,
+			Path:       categoryPO.Path,
+		})
+	}
+	return ret, nil
+}
+
+var errCategoryTooDeep = errno.ParamErr.WithMessage(fmt.Sprintf("the category tree has %d levels at most",
+	constant.ProductCategoryMaxDepth))
+
+func lockCategory(tx *gorm.DB, categoryId int64) (*po.ProductCategory, error) {
+	categoryPOArr := make([]*po.ProductCategory, 0)
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("category_id = ?", categoryId).
+		Find(&categoryPOArr).Error; err != nil {
+		return nil, err
+	}
+	if len(categoryPOArr) == 0 {
+		return nil, errors.New("类目不存在")
+	}
+	return categoryPOArr[0], nil
+}
+
+// checkCategoryName the name must be unique among the children of the parent
+func checkCategoryName(tx *gorm.DB, parentId int64, name string, categoryId int64) error {
+	var count int64
+	if err := tx.Model(&po.ProductCategory{}).Where("parent_id = ? AND name = ? AND category_id <> ?", parentId, name, categoryId).
+		Count(&count).Error; err != nil {
+		return err
+	}
+	if count > 0 {
+		return errno.ParamErr.WithMessage(fmt.Sprintf("category %s exists under the same parent", name))
+	}
+	return nil
+}
+
+// categorySubtree the category with its descendants, whose paths have its id as a segment
+func categorySubtree(db *gorm.DB, categoryId int64) *gorm.DB {
+	id := strconv.FormatInt(categoryId, 10)
+	return db.Model(&po.ProductCategory{}).
+		Where("path = ? OR path LIKE ? OR path LIKE ? OR path LIKE ?", id, id+"/%", "%/"+id, "%/"+id+"/%")
+}
+
+// moveCategory rewrites the paths of the subtree of the category for its new parent,
+// the products under the subtree get their new category paths through the outbox
+func moveCategory(ctx context.Context, tx *gorm.DB, categoryPO *po.ProductCategory, parentId int64) error {
+	parentPath := ""
+	if parentId != 0 {
+		parentPO, err := lockCategory(tx, parentId)
+		if err != nil {
+			return err
+		}
+		if parentPO.Path == categoryPO.Path || strings.HasPrefix(parentPO.Path, categoryPO.Path+"/") {
+			return errno.ParamErr.WithMessage("a category can not be moved under itself")
+		}
+		parentPath = parentPO.Path
+	}
+	path := entity.JoinCategoryPath(parentPath, categoryPO.CategoryId)
+	depthDelta := len(entity.ParseCategoryPath(path)) - len(entity.ParseCategoryPath(categoryPO.Path))
+
+	subtree := make([]*po.ProductCategory, 0)
+	if err := categorySubtree(tx, categoryPO.CategoryId).Clauses(clause.Locking{Strength: "UPDATE"}).
+		Find(&subtree).Error; err != nil {
+		return err
+	}
+	subtreeIds := make([]int64, 0, len(subtree))
+	for _, node := range subtree {
+		if len(entity.ParseCategoryPath(node.Path))+depthDelta > constant.ProductCategoryMaxDepth {
+			return errCategoryTooDeep
+		}
+		subtreeIds = append(subtreeIds, node.CategoryId)
+	}
+	for _, node := range subtree {
+		nodePath := path + strings.TrimPrefix(node.Path, categoryPO.Path)
+		if err := tx.Model(&po.ProductCategory{}).Where("category_id = ?", node.CategoryId).
+			Update("path", nodePath).Error; err != nil {
+			return err
+		}
+	}
+
+	productIds := make([]int64, 0)
+	if err := tx.Model(&po.ProductCategoryRel{}).Distinct("product_id").Where("category_id IN ?", subtreeIds).
+		Order("product_id").Pluck("product_id", &productIds).Error; err != nil {
+		return err
+	}
+	for _, productId := range productIds {
+		productPO, err := lockProduct(tx, productId)
+		if err != nil {
+			return err
+		}
+		productDO, err := loadProductDO(ctx, tx, productPO)
+		if err != nil {
+			return err
+		}
+		if err := outbox.Append(tx, productDO); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// saveProductTaxonomy replaces the categories and the tags of the product and sets its category paths.
+// The categories are locked in share mode, so that they can neither be deleted nor moved meanwhile.
+func saveProductTaxonomy(tx *gorm.DB, product *entity.ProductEntity) error {
+	product.CategoryPaths = make([]string, 0, len(product.CategoryIds))
+	if len(product.CategoryIds) > 0 {
+		categoryPOArr := make([]*po.ProductCategory, 0, len(product.CategoryIds))
+		if err := tx.Clauses(clause.Locking{Strength: "SHARE"}).Where("category_id IN ?", product.CategoryIds).
+			Find(&categoryPOArr).Error; err != nil {
+			return err
+		}
+		pathMap := make(map[int64]string, len(categoryPOArr))
+		for _, categoryPO := range categoryPOArr {
+			pathMap[categoryPO.CategoryId] = categoryPO.Path
+		}
+		for _, categoryId := range product.CategoryIds {
+			path, ok := pathMap[categoryId]
+			if !ok {
+				return errno.ParamErr.WithMessage(fmt.Sprintf("category %d does not exist", categoryId))
+			}
+			product.CategoryPaths = append(product.CategoryPaths, path)
+		}
+	}
+
+	if err := tx.Unscoped().Where("product_id = ?", product.ProductId).Delete(&po.ProductCategoryRel{}).Error; err != nil {
+		return err
+	}
+	for _, categoryId := range product.CategoryIds {
+		if err := tx.Create(&po.ProductCategoryRel{ProductId: product.ProductId, CategoryId: categoryId}).Error; err != nil {
+			return err
+		}
+	}
+	if err := tx.Unscoped().Where("product_id = ?", product.ProductId).Delete(&po.ProductTag{}).Error; err != nil {
+		return err
+	}
+	for _, tag := range product.Tags {
+		if err := tx.Create(&po.ProductTag{ProductId: product.ProductId, Tag: tag}).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getTaxonomyChanges the changes of the categories and the tags, origin is nil for a new product
+func getTaxonomyChanges(origin, target *entity.ProductEntity) ([]*entity.FieldChangeEntity, error) {
+	var originCategoryIds []int64
+	var originTags []string
+	if origin != nil {
+		originCategoryIds, originTags = origin.CategoryIds, origin.Tags
+	}
+	ret := make([]*entity.FieldChangeEntity, 0)
+	if len(originCategoryIds) != 0 || len(target.CategoryIds) != 0 {
+		if !reflect.DeepEqual(originCategoryIds, target.CategoryIds) {
+			change, err := newListFieldChange("category_ids", originCategoryIds, target.CategoryIds)
+			if err != nil {
+				return nil, err
+			}
+			ret = append(ret, change)
+		}
+	}
+	if len(originTags) != 0 || len(target.Tags) != 0 {
+		if !reflect.DeepEqual(originTags, target.Tags) {
+			change, err := newListFieldChange("tags", originTags, target.Tags)
+			if err != nil {
+				return nil, err
+			}
+			ret = append(ret, change)
+		}
+	}
+	return ret, nil
+}
+
+func newListFieldChange(field string, before, after interface{}) (*entity.FieldChangeEntity, error) {
+	beforeStr, err := sonic.MarshalString(before)
+	if err != nil {
+		return nil, err
+	}
+	afterStr, err := sonic.MarshalString(after)
+	if err != nil {
+		return nil, err
+	}
+	return &entity.FieldChangeEntity{Field: field, Before: beforeStr, After: afterStr}, nil
+}
+
+type productTaxonomy struct {
+	categoryIds   []int64
+	categoryPaths []string
+	tags          []string
+}
+
+// loadTaxonomies the categories and the tags of the products in the order they were saved, keyed by product id
+func loadTaxonomies(db *gorm.DB, productIds []int64) (map[int64]*productTaxonomy, error) {
+	ret := make(map[int64]*productTaxonomy)
+	if len(productIds) == 0 {
+		return ret, nil
+	}
+	get := func(productId int64) *productTaxonomy {
+		t, ok := ret[productId]
+		if !ok {
+			t = &productTaxonomy{}
+			ret[productId] = t
+		}
+		return t
+	}
+
+	relPOArr := make([]*po.ProductCategoryRel, 0)
+	if err := db.Where("product_id IN ?", productIds).Order("id").Find(&relPOArr).Error; err != nil {
+		return nil, err
+	}
+	if len(relPOArr) > 0 {
+		categoryIds := make([]int64, 0, len(relPOArr))
+		for _, relPO := range relPOArr {
+			categoryIds = append(categoryIds, relPO.CategoryId)
+		}
+		categoryPOArr := make([]*po.ProductCategory, 0)
+		if err := db.Where("category_id IN ?", categoryIds).Find(&categoryPOArr).Error; err != nil {
+			return nil, err
+		}
+		pathMap := make(map[int64]string, len(categoryPOArr))
+		for _, categoryPO := range categoryPOArr {
+			pathMap[categoryPO.CategoryId] = categoryPO.Path
+		}
+		for _, relPO := range relPOArr {
+			t := get(relPO.ProductId)
+			t.categoryIds = append(t.categoryIds, relPO.CategoryId)
+			if path, ok := pathMap[relPO.CategoryId]; ok {
+				t.categoryPaths = append(t.categoryPaths, path)
+			}
+		}
+	}
+
+	tagPOArr := make([]*po.ProductTag, 0)
+	if err := db.Where("product_id IN ?", productIds).Order("id").Find(&tagPOArr).Error; err != nil {
+		return nil, err
+	}
+	for _, tagPO := range tagPOArr {
+		t := get(tagPO.ProductId)
+		t.tags = append(t.tags, tagPO.Tag)
+	}
+	return ret, nil
+}
@@ -0,0 +1,69 @@
+// Copyright 2023 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package repository
+
+import (
+	"context"
+
+	"github.com/bytedance/sonic"
+	"github.com/cloudwego/biz-demo/book-shop/app/item/common/entity"
+	"github.com/cloudwego/biz-demo/book-shop/app/item/common/po"
+	"gorm.io/gorm"
+)
+
+func (i ProductRepositoryImpl) ListProductHistory(ctx context.Context, productId, cursor int64, limit int) ([]*entity.ProductHistoryEntity, error) {
+	historyPOArr := make([]*po.ProductHistory, 0)
+	db := DB.WithContext(ctx).Where("product_id = ?", productId)
+	if cursor > 0 {
+		db = db.Where("id < ?", cursor)
+	}
+	if err := db.Order("id DESC").Limit(limit).Find(&historyPOArr).Error; err != nil {
+		return nil, err
+	}
+	ret := make([]*entity.ProductHistoryEntity, 0, len(historyPOArr))
+	for _, historyPO := range historyPOArr {
+		changes := make([]*entity.FieldChangeEntity, 0)
+		if err := sonic.UnmarshalString(historyPO.Changes, &changes); err != nil {
+			return nil, err
+		}
+		ret = append(ret, &entity.ProductHistoryEntity{
+			Id:            int64(historyPO.ID),
+			ProductId:     historyPO.ProductId,
+			Operator:      historyPO.Operator,
+			OperationType: historyPO.OperationType,
+			Changes:       changes,
+			CreateTime:    historyPO.CreatedAt,
+		})
+	}
+	return ret, nil
+}
+
+// appendProductHistory writes the audit record in the transaction of the product change
+func appendProductHistory(tx *gorm.DB, productId int64, operation *entity.ProductOperationEntity, changes []*entity.FieldChangeEntity) error {
+	if operation == nil {
+		return nil
+	}
+	changesStr, err := sonic.MarshalString(changes)
+	if err != nil {
+		return err
+	}
+	return tx.Create(&po.ProductHistory{
+		ProductId:     productId,
+		Operator:      operation.Operator,
+		OperationType: operation.OperationType,
+		Changes:       changesStr,
+	}).Error
+}
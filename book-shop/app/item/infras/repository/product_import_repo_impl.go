@@ -0,0 +1,184 @@
+// Copyright 2023 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/bytedance/sonic"
+	"github.com/cloudwego/biz-demo/book-shop/app/item/common/constant"
+	"github.com/cloudwego/biz-demo/book-shop/app/item/common/entity"
+	"github.com/cloudwego/biz-demo/book-shop/app/item/common/po"
+	"github.com/cloudwego/biz-demo/book-shop/app/item/domain/repository"
+	"gorm.io/gorm"
+)
+
+type ProductImportRepositoryImpl struct{}
+
+func (i ProductImportRepositoryImpl) CreateImportJob(ctx context.Context, job *entity.ProductImportJobEntity) error {
+	return DB.WithContext(ctx).Create(&po.ProductImportJob{
+		JobId:     job.JobId,
+		Format:    job.Format,
+		Content:   job.Content,
+		Operator:  job.Operator,
+		Status:    job.Status,
+		TotalRows: job.TotalRows,
+	}).Error
+}
+
+func (i ProductImportRepositoryImpl) GetImportJob(ctx context.Context, jobId int64) (*entity.ProductImportJobEntity, error) {
+	jobPOArr := make([]*po.ProductImportJob, 0)
+	if err := DB.WithContext(ctx).Omit("content").Where("job_id = ?", jobId).Find(&jobPOArr).Error; err != nil {
+		return nil, err
+	}
+	if len(jobPOArr) == 0 {
+		return nil, errors.New("导入任务不存在")
+	}
+	return convertImportJobPO2DO(jobPOArr[0])
+}
+
+func (i ProductImportRepositoryImpl) ListRunnableImportJobIds(ctx context.Context, now time.Time, limit int) ([]int64, error) {
+	ids := make([]int64, 0)
+	err := runnableImportJobs(DB.WithContext(ctx).Model(&po.ProductImportJob{}), now).
+		Order("id").Limit(limit).Pluck("job_id", &ids).Error
+	if err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+func (i ProductImportRepositoryImpl) ClaimImportJob(ctx context.Context, jobId, claimToken int64, now time.Time) (*entity.ProductImportJobEntity, error) {
+	// updated_at is refreshed by the update, which restarts the running timeout
+	result := runnableImportJobs(DB.WithContext(ctx).Model(&po.ProductImportJob{}), now).
+		Where("job_id = ?", jobId).
+		Updates(map[string]interface{}{
+			"status":      constant.ProductImportJobStatusRunning,
+			"claim_token": claimToken,
+		})
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, nil
+	}
+	jobPOArr := make([]*po.ProductImportJob, 0)
+	if err := DB.WithContext(ctx).Where("job_id = ?", jobId).Find(&jobPOArr).Error; err != nil {
+		return nil, err
+	}
+	if len(jobPOArr) == 0 {
+		return nil, errors.New("导入任务不存在")
+	}
+	return convertImportJobPO2DO(jobPOArr[0])
+}
+
+func (i ProductImportRepositoryImpl) SaveImportProgress(ctx context.Context, job *entity.ProductImportJobEntity) error {
+	return saveImportProgress(DB.WithContext(ctx), job)
+}
+
+// saveImportProgress is also run in the transaction adding the product of a row, so that a row is never added twice
+func saveImportProgress(tx *gorm.DB, job *entity.ProductImportJobEntity) error {
+	changeMap, err := importProgressMap(job)
+	if err != nil {
+		return err
+	}
+	return updateClaimedImportJob(tx, job, changeMap)
+}
+
+func (i ProductImportRepositoryImpl) FinishImportJob(ctx context.Context, job *entity.ProductImportJobEntity) error {
+	changeMap, err := importProgressMap(job)
+	if err != nil {
+		return err
+	}
+	errMsg := job.ErrMsg
+	if len(errMsg) > 255 {
+		errMsg = errMsg[:255]
+	}
+	changeMap["status"] = job.Status
+	changeMap["err_msg"] = errMsg
+	// the content is not needed any more
+	changeMap["content"] = nil
+	return updateClaimedImportJob(DB.WithContext(ctx), job, changeMap)
+}
+
+func updateClaimedImportJob(db *gorm.DB, job *entity.ProductImportJobEntity, changeMap map[string]interface{}) error {
+	result := db.Model(&po.ProductImportJob{}).
+		Where("job_id = ? AND status = ? AND claim_token = ?", job.JobId, constant.ProductImportJobStatusRunning, job.ClaimToken).
+		Updates(changeMap)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return repository.ErrImportJobTakenOver
+	}
+	return nil
+}
+
+func importProgressMap(job *entity.ProductImportJobEntity) (map[string]interface{}, error) {
+	rowErrors, err := sonic.MarshalString(job.RowErrors)
+	if err != nil {
+		return nil, err
+	}
+	productIds, err := sonic.MarshalString(job.ProductIds)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{
+		"next_row":     job.NextRow,
+		"success_rows": job.SuccessRows,
+		"failed_rows":  job.FailedRows,
+		"row_errors":   rowErrors,
+		"product_ids":  productIds,
+	}, nil
+}
+
+func runnableImportJobs(db *gorm.DB, now time.Time) *gorm.DB {
+	return db.Where("status = ? OR (status = ? AND updated_at <= ?)",
+		constant.ProductImportJobStatusPending,
+		constant.ProductImportJobStatusRunning, now.Add(-constant.ProductImportRunningTimeout))
+}
+
+func convertImportJobPO2DO(jobPO *po.ProductImportJob) (*entity.ProductImportJobEntity, error) {
+	job := &entity.ProductImportJobEntity{
+		JobId:       jobPO.JobId,
+		Format:      jobPO.Format,
+		Content:     jobPO.Content,
+		Operator:    jobPO.Operator,
+		Status:      jobPO.Status,
+		TotalRows:   jobPO.TotalRows,
+		NextRow:     jobPO.NextRow,
+		SuccessRows: jobPO.SuccessRows,
+		FailedRows:  jobPO.FailedRows,
+		RowErrors:   make([]*entity.ImportRowErrorEntity, 0),
+		ProductIds:  make([]int64, 0),
+		ErrMsg:      jobPO.ErrMsg,
+		ClaimToken:  jobPO.ClaimToken,
+		CreateTime:  jobPO.CreatedAt,
+		UpdateTime:  jobPO.UpdatedAt,
+	}
+	if jobPO.RowErrors != "" {
+		if err := sonic.UnmarshalString(jobPO.RowErrors, &job.RowErrors); err != nil {
+			return nil, err
+		}
+	}
+	if jobPO.ProductIds != "" {
+		if err := sonic.UnmarshalString(jobPO.ProductIds, &job.ProductIds); err != nil {
+			return nil, err
+		}
+	}
+	return job, nil
+}
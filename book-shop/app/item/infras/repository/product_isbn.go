@@ -0,0 +1,96 @@
+// Copyright 2023 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package repository
+
+import (
+	"fmt"
+
+	"github.com/cloudwego/biz-demo/book-shop/app/item/common/constant"
+	"github.com/cloudwego/biz-demo/book-shop/app/item/common/entity"
+	"github.com/cloudwego/biz-demo/book-shop/app/item/common/po"
+	"github.com/cloudwego/biz-demo/book-shop/pkg/errno"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// productISBNs the isbns of the product and of its skus, the product usually shares its isbn with one of the skus
+func productISBNs(product *entity.ProductEntity) []string {
+	ret := make([]string, 0, len(product.Skus)+1)
+	seen := make(map[string]bool)
+	add := func(isbn string) {
+		if isbn != "" && !seen[isbn] {
+			seen[isbn] = true
+			ret = append(ret, isbn)
+		}
+	}
+	if product.Property != nil {
+		add(product.Property.ISBN)
+	}
+	for _, sku := range product.Skus {
+		add(sku.ISBN)
+	}
+	return ret
+}
+
+// newISBNs the isbns of target which origin does not have, only they need to be checked on an edit
+func newISBNs(origin, target *entity.ProductEntity) []string {
+	old := make(map[string]bool)
+	for _, isbn := range productISBNs(origin) {
+		old[isbn] = true
+	}
+	ret := make([]string, 0)
+	for _, isbn := range productISBNs(target) {
+		if !old[isbn] {
+			ret = append(ret, isbn)
+		}
+	}
+	return ret
+}
+
+// checkISBNUnique fails when another product of the shop, or a sku of it, has one of the isbns.
+// The book-shop has a single shop, so the isbns are unique in the whole catalog, the deleted
+// products do not count. The rows are read for update, which also locks the gaps of the isbn
+// index, so that two products with the same isbn can not be saved at the same time.
+func checkISBNUnique(tx *gorm.DB, productId int64, isbns []string) error {
+	if len(isbns) == 0 {
+		return nil
+	}
+	productIds := make([]int64, 0)
+	if err := tx.Model(&po.Product{}).Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("isbn IN ? AND product_id <> ?", isbns, productId).
+		Pluck("product_id", &productIds).Error; err != nil {
+		return err
+	}
+	skuProductIds := make([]int64, 0)
+	if err := tx.Model(&po.ProductSku{}).Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("isbn IN ? AND product_id <> ?", isbns, productId).
+		Pluck("product_id", &skuProductIds).Error; err != nil {
+		return err
+	}
+	productIds = append(productIds, skuProductIds...)
+	if len(productIds) == 0 {
+		return nil
+	}
+	owners := make([]*po.Product, 0)
+	if err := tx.Where("product_id IN ? AND status <> ?", productIds, constant.ProductStatusDelete).
+		Limit(1).Find(&owners).Error; err != nil {
+		return err
+	}
+	if len(owners) > 0 {
+		return errno.ISBNAlreadyExistErr.WithMessage(fmt.Sprintf("isbn is already used by product %d", owners[0].ProductId))
+	}
+	return nil
+}
@@ -0,0 +1,101 @@
+// Copyright 2023 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package repository
+
+import (
+	"strings"
+
+	"github.com/cloudwego/biz-demo/book-shop/app/item/common/constant"
+	"github.com/cloudwego/biz-demo/book-shop/app/item/common/entity"
+	"github.com/cloudwego/biz-demo/book-shop/app/item/common/po"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// productListSortColumns the only columns a list can be ordered by
+var productListSortColumns = map[constant.ProductListSortField]string{
+	constant.ProductListSortFieldCreateTime: "id",
+	constant.ProductListSortFieldPrice:      "price",
+	constant.ProductListSortFieldStock:      "stock",
+}
+
+// productListQueryBuilder builds the conditions of one list request on a fresh statement,
+// the column names are fixed here and only the values come from the request
+type productListQueryBuilder struct {
+	query *entity.ProductListQuery
+}
+
+func newProductListQueryBuilder(query *entity.ProductListQuery) *productListQueryBuilder {
+	return &productListQueryBuilder{query: query}
+}
+
+// Filter apply the filters, shared by the count and the page query
+func (b *productListQueryBuilder) Filter(db *gorm.DB) *gorm.DB {
+	q := b.query
+	if q.Name != nil && *q.Name != "" {
+		db = db.Where("name LIKE ?", "%"+escapeLike(*q.Name)+"%")
+	}
+	if q.SpuName != nil {
+		db = db.Where("spu_name = ?", *q.SpuName)
+	}
+	if q.Status != nil {
+		db = db.Where("status = ?", *q.Status)
+	}
+	if q.MinPrice != nil {
+		db = db.Where("price >= ?", *q.MinPrice)
+	}
+	if q.MaxPrice != nil {
+		db = db.Where("price <= ?", *q.MaxPrice)
+	}
+	if q.MinStock != nil {
+		db = db.Where("stock >= ?", *q.MinStock)
+	}
+	if q.MaxStock != nil {
+		db = db.Where("stock <= ?", *q.MaxStock)
+	}
+	if q.CategoryId != nil {
+		sub := db.Session(&gorm.Session{NewDB: true})
+		categoryIds := categorySubtree(sub, *q.CategoryId).Select("category_id")
+		db = db.Where("product_id IN (?)", sub.Model(&po.ProductCategoryRel{}).Select("product_id").
+			Where("category_id IN (?)", categoryIds))
+	}
+	if q.Tag != nil {
+		sub := db.Session(&gorm.Session{NewDB: true})
+		db = db.Where("product_id IN (?)", sub.Model(&po.ProductTag{}).Select("product_id").Where("tag = ?", *q.Tag))
+	}
+	return db
+}
+
+// Page apply the order and the page, id breaks the ties so that pages do not overlap
+func (b *productListQueryBuilder) Page(db *gorm.DB) *gorm.DB {
+	q := b.query
+	column, ok := productListSortColumns[q.SortField]
+	if !ok {
+		column = productListSortColumns[constant.ProductListSortFieldCreateTime]
+	}
+	db = db.Order(clause.OrderByColumn{Column: clause.Column{Name: column}, Desc: q.SortDesc})
+	if column != "id" {
+		db = db.Order(clause.OrderByColumn{Column: clause.Column{Name: "id"}, Desc: q.SortDesc})
+	}
+	return db.Limit(q.Limit).Offset(q.Offset)
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// escapeLike the keyword is matched literally
+func escapeLike(s string) string {
+	return likeEscaper.Replace(s)
+}
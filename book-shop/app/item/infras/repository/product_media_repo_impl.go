@@ -0,0 +1,227 @@
+// Copyright 2023 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/cloudwego/biz-demo/book-shop/app/item/common/constant"
+	"github.com/cloudwego/biz-demo/book-shop/app/item/common/entity"
+	"github.com/cloudwego/biz-demo/book-shop/app/item/common/po"
+	"github.com/cloudwego/biz-demo/book-shop/app/item/infras/outbox"
+	"github.com/cloudwego/biz-demo/book-shop/pkg/errno"
+	"gorm.io/gorm"
+)
+
+type ProductMediaRepositoryImpl struct{}
+
+func (i ProductMediaRepositoryImpl) AddMedia(ctx context.Context, media *entity.ProductMediaEntity, primary bool) error {
+	return DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// the product row lock serializes the gallery changes of the product
+		productPO, err := lockGalleryProduct(tx, media.ProductId)
+		if err != nil {
+			return err
+		}
+		mediaPOArr, err := listGallery(tx, media.ProductId)
+		if err != nil {
+			return err
+		}
+		if len(mediaPOArr) >= constant.ProductMediaMaxCount {
+			return errno.ParamErr.WithMessage(fmt.Sprintf("a product has %d images at most", constant.ProductMediaMaxCount))
+		}
+		hasPrimary := false
+		media.SortOrder = 0
+		for _, mediaPO := range mediaPOArr {
+			hasPrimary = hasPrimary || mediaPO.IsPrimary
+			if mediaPO.SortOrder >= media.SortOrder {
+				media.SortOrder = mediaPO.SortOrder + 1
+			}
+		}
+		media.IsPrimary = primary || !hasPrimary
+		if media.IsPrimary && hasPrimary {
+			if err := tx.Model(&po.ProductMedia{}).Where("product_id = ? AND is_primary = ?", media.ProductId, true).
+				Update("is_primary", false).Error; err != nil {
+				return err
+			}
+		}
+		if err := tx.Create(convertMediaDO2PO(media)).Error; err != nil {
+			return err
+		}
+		return appendGalleryOutbox(ctx, tx, productPO)
+	})
+}
+
+func (i ProductMediaRepositoryImpl) SaveGallery(ctx context.Context, productId int64, mediaIds []int64,
+	primaryMediaId int64,
+) ([]*entity.ProductMediaEntity, error) {
+	ret := make([]*entity.ProductMediaEntity, 0, len(mediaIds))
+	err := DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		productPO, err := lockGalleryProduct(tx, productId)
+		if err != nil {
+			return err
+		}
+		mediaPOArr, err := listGallery(tx, productId)
+		if err != nil {
+			return err
+		}
+		mediaPOMap := make(map[int64]*po.ProductMedia, len(mediaPOArr))
+		for _, mediaPO := range mediaPOArr {
+			mediaPOMap[mediaPO.MediaId] = mediaPO
+		}
+		kept := make(map[int64]bool, len(mediaIds))
+		for _, mediaId := range mediaIds {
+			if _, ok := mediaPOMap[mediaId]; !ok {
+				return errno.ParamErr.WithMessage(fmt.Sprintf("image %d does not belong to the product", mediaId))
+			}
+			if kept[mediaId] {
+				return errno.ParamErr.WithMessage(fmt.Sprintf("image %d is passed more than once", mediaId))
+			}
+			kept[mediaId] = true
+		}
+		if primaryMediaId == 0 && len(mediaIds) > 0 {
+			primaryMediaId = mediaIds[0]
+		}
+		if primaryMediaId != 0 && !kept[primaryMediaId] {
+			return errno.ParamErr.WithMessage("the primary image must be in the gallery")
+		}
+
+		removed := make([]int64, 0)
+		for _, mediaPO := range mediaPOArr {
+			if !kept[mediaPO.MediaId] {
+				removed = append(removed, mediaPO.MediaId)
+			}
+		}
+		// soft deleted, the files are deleted by the media gc
+		if len(removed) > 0 {
+			if err := tx.Where("media_id IN ?", removed).Delete(&po.ProductMedia{}).Error; err != nil {
+				return err
+			}
+		}
+		for sortOrder, mediaId := range mediaIds {
+			mediaPO := mediaPOMap[mediaId]
+			mediaPO.SortOrder = int32(sortOrder)
+			mediaPO.IsPrimary = mediaId == primaryMediaId
+			if err := tx.Model(&po.ProductMedia{}).Where("media_id = ?", mediaId).Updates(map[string]interface{}{
+				"sort_order": mediaPO.SortOrder,
+				"is_primary": mediaPO.IsPrimary,
+			}).Error; err != nil {
+				return err
+			}
+			ret = append(ret, convertMediaPO2DO(mediaPO))
+		}
+		return appendGalleryOutbox(ctx, tx, productPO)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+func (i ProductMediaRepositoryImpl) ListGarbageMedia(ctx context.Context, limit int) ([]*entity.ProductMediaEntity, error) {
+	db := DB.WithContext(ctx)
+	deletedProductIds := db.Model(&po.Product{}).Select("product_id").Where("status = ?", constant.ProductStatusDelete)
+	mediaPOArr := make([]*po.ProductMedia, 0, limit)
+	if err := db.Unscoped().Where("deleted_at IS NOT NULL OR product_id IN (?)", deletedProductIds).
+		Order("id").Limit(limit).Find(&mediaPOArr).Error; err != nil {
+		return nil, err
+	}
+	ret := make([]*entity.ProductMediaEntity, 0, len(mediaPOArr))
+	for _, mediaPO := range mediaPOArr {
+		ret = append(ret, convertMediaPO2DO(mediaPO))
+	}
+	return ret, nil
+}
+
+func (i ProductMediaRepositoryImpl) PurgeMedia(ctx context.Context, mediaId int64) error {
+	return DB.WithContext(ctx).Unscoped().Where("media_id = ?", mediaId).Delete(&po.ProductMedia{}).Error
+}
+
+// lockGalleryProduct the gallery of a deleted product can not be changed, its images are being collected
+func lockGalleryProduct(tx *gorm.DB, productId int64) (*po.Product, error) {
+	productPO, err := lockProduct(tx, productId)
+	if err != nil {
+		return nil, err
+	}
+	if productPO.Status == constant.ProductStatusDelete {
+		return nil, errors.New("商品已删除")
+	}
+	return productPO, nil
+}
+
+func listGallery(db *gorm.DB, productId int64) ([]*po.ProductMedia, error) {
+	mediaPOArr := make([]*po.ProductMedia, 0)
+	if err := db.Where("product_id = ?", productId).Order("sort_order, id").Find(&mediaPOArr).Error; err != nil {
+		return nil, err
+	}
+	return mediaPOArr, nil
+}
+
+// appendGalleryOutbox the gallery and the primary image are part of the product document
+func appendGalleryOutbox(ctx context.Context, tx *gorm.DB, productPO *po.Product) error {
+	productDO, err := loadProductDO(ctx, tx, productPO)
+	if err != nil {
+		return err
+	}
+	return outbox.Append(tx, productDO)
+}
+
+// loadGalleries the galleries of the products in order, keyed by product id
+func loadGalleries(db *gorm.DB, productIds []int64) (map[int64][]*entity.ProductMediaEntity, error) {
+	ret := make(map[int64][]*entity.ProductMediaEntity)
+	if len(productIds) == 0 {
+		return ret, nil
+	}
+	mediaPOArr := make([]*po.ProductMedia, 0)
+	if err := db.Where("product_id IN ?", productIds).Order("sort_order, id").Find(&mediaPOArr).Error; err != nil {
+		return nil, err
+	}
+	for _, mediaPO := range mediaPOArr {
+		ret[mediaPO.ProductId] = append(ret[mediaPO.ProductId], convertMediaPO2DO(mediaPO))
+	}
+	return ret, nil
+}
+
+func convertMediaDO2PO(media *entity.ProductMediaEntity) *po.ProductMedia {
+	return &po.ProductMedia{
+		MediaId:      media.MediaId,
+		ProductId:    media.ProductId,
+		BlobKey:      media.BlobKey,
+		ThumbnailKey: media.ThumbnailKey,
+		Url:          media.URL,
+		ThumbnailUrl: media.ThumbnailURL,
+		Width:        media.Width,
+		Height:       media.Height,
+		SortOrder:    media.SortOrder,
+		IsPrimary:    media.IsPrimary,
+	}
+}
+
+func convertMediaPO2DO(mediaPO *po.ProductMedia) *entity.ProductMediaEntity {
+	return &entity.ProductMediaEntity{
+		MediaId:      mediaPO.MediaId,
+		ProductId:    mediaPO.ProductId,
+		BlobKey:      mediaPO.BlobKey,
+		ThumbnailKey: mediaPO.ThumbnailKey,
+		URL:          mediaPO.Url,
+		ThumbnailURL: mediaPO.ThumbnailUrl,
+		Width:        mediaPO.Width,
+		Height:       mediaPO.Height,
+		SortOrder:    mediaPO.SortOrder,
+		IsPrimary:    mediaPO.IsPrimary,
+	}
+}
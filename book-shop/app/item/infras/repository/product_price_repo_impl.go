@@ -0,0 +1,298 @@
+// Copyright 2023 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/cloudwego/biz-demo/book-shop/app/item/common/constant"
+	"github.com/cloudwego/biz-demo/book-shop/app/item/common/entity"
+	"github.com/cloudwego/biz-demo/book-shop/app/item/common/po"
+	"github.com/cloudwego/biz-demo/book-shop/app/item/infras/outbox"
+	"github.com/cloudwego/biz-demo/book-shop/pkg/errno"
+	"github.com/cloudwego/biz-demo/book-shop/pkg/utils"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type ProductPriceRepositoryImpl struct{}
+
+func (i ProductPriceRepositoryImpl) CreatePriceChange(ctx context.Context, price *entity.ProductPriceEntity) error {
+	return DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// the product row lock serializes the price changes of the product
+		if _, err := lockProduct(tx, price.ProductId); err != nil {
+			return err
+		}
+		if _, err := lockStockSku(tx, price.ProductId, price.SkuId); err != nil {
+			return err
+		}
+		if price.Kind == constant.ProductPriceKindPromotion {
+			var overlapped int64
+			err := tx.Model(&po.ProductPrice{}).
+				Where("product_id = ? AND sku_id = ? AND kind = ? AND status IN ?", price.ProductId, price.SkuId,
+					constant.ProductPriceKindPromotion, []int64{constant.ProductPriceStatusPending, constant.ProductPriceStatusActive}).
+				Where("effective_from < ? AND effective_to > ?", *price.EffectiveTo, price.EffectiveFrom).
+				Count(&overlapped).Error
+			if err != nil {
+				return err
+			}
+			if overlapped > 0 {
+				return errno.ParamErr.WithMessage("the promotion overlaps another promotion of the same sku")
+			}
+		}
+		return tx.Create(&po.ProductPrice{
+			PriceId:       price.PriceId,
+			ProductId:     price.ProductId,
+			SkuId:         price.SkuId,
+			Kind:          price.Kind,
+			Price:         price.Price,
+			OriginPrice:   price.OriginPrice,
+			EffectiveFrom: price.EffectiveFrom,
+			EffectiveTo:   price.EffectiveTo,
+			Status:        price.Status,
+			Operator:      price.Operator,
+		}).Error
+	})
+}
+
+func (i ProductPriceRepositoryImpl) ListPriceHistory(ctx context.Context, productId int64, limit int) ([]*entity.ProductPriceEntity, error) {
+	pricePOArr := make([]*po.ProductPrice, 0, limit)
+	if err := DB.WithContext(ctx).Where("product_id = ?", productId).
+		Order("id DESC").Limit(limit).Find(&pricePOArr).Error; err != nil {
+		return nil, err
+	}
+	return convertPricePOs2DOs(pricePOArr), nil
+}
+
+func (i ProductPriceRepositoryImpl) ListDueListPriceChanges(ctx context.Context, now time.Time, limit int) ([]*entity.ProductPriceEntity, error) {
+	pricePOArr := make([]*po.ProductPrice, 0)
+	if err := dueListPriceChanges(DB.WithContext(ctx), now).
+		Order("effective_from").Limit(limit).Find(&pricePOArr).Error; err != nil {
+		return nil, err
+	}
+	return convertPricePOs2DOs(pricePOArr), nil
+}
+
+func (i ProductPriceRepositoryImpl) ClaimListPriceChange(ctx context.Context, priceId int64, now time.Time) (bool, error) {
+	// updated_at is refreshed by the update, which restarts the running timeout
+	result := dueListPriceChanges(DB.WithContext(ctx).Model(&po.ProductPrice{}), now).
+		Where("price_id = ?", priceId).
+		Update("status", constant.ProductPriceStatusRunning)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
+
+func (i ProductPriceRepositoryImpl) FailListPriceChange(ctx context.Context, priceId int64, errMsg string) error {
+	if len(errMsg) > 255 {
+		errMsg = errMsg[:255]
+	}
+	return DB.WithContext(ctx).Model(&po.ProductPrice{}).
+		Where("price_id = ? AND status = ?", priceId, constant.ProductPriceStatusRunning).
+		Updates(map[string]interface{}{
+			"status":  constant.ProductPriceStatusFailed,
+			"err_msg": errMsg,
+		}).Error
+}
+
+func (i ProductPriceRepositoryImpl) ListDuePromotions(ctx context.Context, now time.Time, limit int) ([]*entity.ProductPriceEntity, error) {
+	pricePOArr := make([]*po.ProductPrice, 0)
+	err := DB.WithContext(ctx).
+		Where("kind = ?", constant.ProductPriceKindPromotion).
+		Where("(status = ? AND effective_from <= ?) OR (status = ? AND effective_to <= ?)",
+			constant.ProductPriceStatusPending, now, constant.ProductPriceStatusActive, now).
+		Order("id").Limit(limit).Find(&pricePOArr).Error
+	if err != nil {
+		return nil, err
+	}
+	return convertPricePOs2DOs(pricePOArr), nil
+}
+
+func (i ProductPriceRepositoryImpl) SyncPromotion(ctx context.Context, priceId int64, fromStatus, toStatus int64) error {
+	return DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		pricePOArr := make([]*po.ProductPrice, 0)
+		if err := tx.Where("price_id = ?", priceId).Find(&pricePOArr).Error; err != nil {
+			return err
+		}
+		if len(pricePOArr) == 0 {
+			return errors.New("价格变更不存在")
+		}
+		// the product row is locked before the price row, as when a promotion is created
+		productPO, err := lockProduct(tx, pricePOArr[0].ProductId)
+		if err != nil {
+			return err
+		}
+		result := tx.Model(&po.ProductPrice{}).Where("price_id = ? AND status = ?", priceId, fromStatus).
+			Update("status", toStatus)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+		productDO, err := loadProductDO(ctx, tx, productPO)
+		if err != nil {
+			return err
+		}
+		return outbox.Append(tx, productDO)
+	})
+}
+
+func dueListPriceChanges(db *gorm.DB, now time.Time) *gorm.DB {
+	return db.Where("kind = ?", constant.ProductPriceKindList).
+		Where("(status = ? AND effective_from <= ?) OR (status = ? AND updated_at <= ?)",
+			constant.ProductPriceStatusPending, now,
+			constant.ProductPriceStatusRunning, now.Add(-constant.ProductPriceRunningTimeout))
+}
+
+// loadPromoPrices the promotional prices in effect at now, keyed by product id and then by sku id.
+// The time window decides, so that a promotion is in effect even before the scheduler has synced it.
+func loadPromoPrices(db *gorm.DB, productIds []int64, now time.Time) (map[int64]map[int64]int64, error) {
+	ret := make(map[int64]map[int64]int64)
+	if len(productIds) == 0 {
+		return ret, nil
+	}
+	pricePOArr := make([]*po.ProductPrice, 0)
+	err := db.Where("product_id IN ? AND kind = ? AND status IN ?", productIds, constant.ProductPriceKindPromotion,
+		[]int64{constant.ProductPriceStatusPending, constant.ProductPriceStatusActive}).
+		Where("effective_from <= ? AND effective_to > ?", now, now).
+		Find(&pricePOArr).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, pricePO := range pricePOArr {
+		promoPrices, ok := ret[pricePO.ProductId]
+		if !ok {
+			promoPrices = make(map[int64]int64)
+			ret[pricePO.ProductId] = promoPrices
+		}
+		if price, ok := promoPrices[pricePO.SkuId]; !ok || pricePO.Price < price {
+			promoPrices[pricePO.SkuId] = pricePO.Price
+		}
+	}
+	return ret, nil
+}
+
+type listPriceChange struct {
+	skuId       int64
+	originPrice int64
+	price       int64
+}
+
+// getListPriceChanges the list prices which differ between origin and target, origin is nil for a new product.
+// The prices of a product with skus are kept by its skus.
+func getListPriceChanges(origin, target *entity.ProductEntity) []*listPriceChange {
+	ret := make([]*listPriceChange, 0)
+	if len(target.Skus) == 0 {
+		if origin == nil {
+			ret = append(ret, &listPriceChange{price: target.Price})
+		} else if origin.Price != target.Price {
+			ret = append(ret, &listPriceChange{originPrice: origin.Price, price: target.Price})
+		}
+		return ret
+	}
+	for _, sku := range target.Skus {
+		var originSku *entity.SkuEntity
+		if origin != nil {
+			originSku = origin.GetSku(sku.SkuId)
+		}
+		if originSku == nil {
+			ret = append(ret, &listPriceChange{skuId: sku.SkuId, price: sku.Price})
+		} else if originSku.Price != sku.Price {
+			ret = append(ret, &listPriceChange{skuId: sku.SkuId, originPrice: originSku.Price, price: sku.Price})
+		}
+	}
+	return ret
+}
+
+// appendPriceHistory records the list price changes of an add or an edit as applied. An operation applying
+// a scheduled change marks that one applied instead, it fails if the change is no longer running.
+func appendPriceHistory(tx *gorm.DB, origin, target *entity.ProductEntity, operation *entity.ProductOperationEntity) error {
+	if operation == nil {
+		return nil
+	}
+	if operation.PriceId != 0 {
+		return applyScheduledPrice(tx, origin, operation.PriceId)
+	}
+	now := time.Now()
+	for _, change := range getListPriceChanges(origin, target) {
+		priceId, err := utils.GenerateID()
+		if err != nil {
+			return err
+		}
+		if err := tx.Create(&po.ProductPrice{
+			PriceId:       priceId,
+			ProductId:     target.ProductId,
+			SkuId:         change.skuId,
+			Kind:          constant.ProductPriceKindList,
+			Price:         change.price,
+			OriginPrice:   change.originPrice,
+			EffectiveFrom: now,
+			Status:        constant.ProductPriceStatusApplied,
+			Operator:      operation.Operator,
+		}).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func applyScheduledPrice(tx *gorm.DB, origin *entity.ProductEntity, priceId int64) error {
+	pricePOArr := make([]*po.ProductPrice, 0)
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("price_id = ? AND status = ?", priceId, constant.ProductPriceStatusRunning).
+		Find(&pricePOArr).Error; err != nil {
+		return err
+	}
+	if len(pricePOArr) == 0 {
+		return errors.New("调价任务已结束或被其他实例执行")
+	}
+	originPrice := origin.Price
+	if skuId := pricePOArr[0].SkuId; skuId != 0 {
+		if sku := origin.GetSku(skuId); sku != nil {
+			originPrice = sku.Price
+		}
+	}
+	return tx.Model(&po.ProductPrice{}).Where("price_id = ?", priceId).
+		Updates(map[string]interface{}{
+			"status":       constant.ProductPriceStatusApplied,
+			"origin_price": originPrice,
+		}).Error
+}
+
+func convertPricePOs2DOs(pricePOArr []*po.ProductPrice) []*entity.ProductPriceEntity {
+	ret := make([]*entity.ProductPriceEntity, 0, len(pricePOArr))
+	for _, pricePO := range pricePOArr {
+		ret = append(ret, &entity.ProductPriceEntity{
+			PriceId:       pricePO.PriceId,
+			ProductId:     pricePO.ProductId,
+			SkuId:         pricePO.SkuId,
+			Kind:          pricePO.Kind,
+			Price:         pricePO.Price,
+			OriginPrice:   pricePO.OriginPrice,
+			EffectiveFrom: pricePO.EffectiveFrom,
+			EffectiveTo:   pricePO.EffectiveTo,
+			Status:        pricePO.Status,
+			Operator:      pricePO.Operator,
+			ErrMsg:        pricePO.ErrMsg,
+			CreateTime:    pricePO.CreatedAt,
+		})
+	}
+	return ret
+}
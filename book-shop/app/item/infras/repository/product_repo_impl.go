@@ -18,37 +18,74 @@ package repository
 import (
 	"context"
 	"errors"
-
-	"github.com/cloudwego/kitex/pkg/klog"
+	"fmt"
 
 	"github.com/cloudwego/biz-demo/book-shop/app/item/common/entity"
 	"github.com/cloudwego/biz-demo/book-shop/app/item/common/po"
-	"github.com/cloudwego/biz-demo/book-shop/app/item/infras/es"
+	"github.com/cloudwego/biz-demo/book-shop/app/item/infras/outbox"
 	"github.com/cloudwego/biz-demo/book-shop/app/item/infras/repository/converter"
 	"github.com/cloudwego/biz-demo/book-shop/app/item/infras/repository/differ"
+	"github.com/cloudwego/biz-demo/book-shop/pkg/errno"
+	"gorm.io/gorm"
 )
 
 type ProductRepositoryImpl struct{}
 
-func (i ProductRepositoryImpl) AddProduct(ctx context.Context, product *entity.ProductEntity) error {
+func (i ProductRepositoryImpl) AddProduct(ctx context.Context, product *entity.ProductEntity, operation *entity.ProductOperationEntity) error {
 	if product == nil {
 		return errors.New("插入数据不可为空")
 	}
+	emptyPO := &po.Product{}
 	po, err := converter.ProductDO2POConverter.Convert2po(ctx, product)
 	if err != nil {
 		return err
 	}
-	// update es async
-	go func() {
-		err := es.UpsertProductES(ctx, po.ProductId, product)
-		if err != nil {
-			klog.CtxErrorf(ctx, "UpsertProductES err: %v", err)
+	// every field of a new product is recorded as changed
+	changes := differ.ProductPODiffer.GetFieldChanges(emptyPO, po)
+	skuChange, err := getSkuChange(nil, product.Skus)
+	if err != nil {
+		return err
+	}
+	if skuChange != nil {
+		changes = append(changes, skuChange)
+	}
+	taxonomyChanges, err := getTaxonomyChanges(nil, product)
+	if err != nil {
+		return err
+	}
+	changes = append(changes, taxonomyChanges...)
+	// es is synced by the outbox relay
+	return DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := checkISBNUnique(tx, product.ProductId, productISBNs(product)); err != nil {
+			return err
+		}
+		if err := tx.Create(po).Error; err != nil {
+			return err
+		}
+		if err := createSkus(ctx, tx, product.ProductId, product.Skus); err != nil {
+			return err
+		}
+		if len(taxonomyChanges) > 0 {
+			if err := saveProductTaxonomy(tx, product); err != nil {
+				return err
+			}
+		}
+		if err := appendPriceHistory(tx, nil, product, operation); err != nil {
+			return err
 		}
-	}()
-	return DB.WithContext(ctx).Create(po).Error
+		if err := appendProductHistory(tx, product.ProductId, operation, changes); err != nil {
+			return err
+		}
+		if operation.ImportJob != nil {
+			if err := saveImportProgress(tx, operation.ImportJob); err != nil {
+				return err
+			}
+		}
+		return outbox.Append(tx, product)
+	})
 }
 
-func (i ProductRepositoryImpl) UpdateProduct(ctx context.Context, origin, target *entity.ProductEntity) error {
+func (i ProductRepositoryImpl) UpdateProduct(ctx context.Context, origin, target *entity.ProductEntity, operation *entity.ProductOperationEntity) error {
 	productId := target.ProductId
 	originPO, err := converter.ProductDO2POConverter.Convert2po(ctx, origin)
 	if err != nil {
@@ -58,16 +95,87 @@ func (i ProductRepositoryImpl) UpdateProduct(ctx context.Context, origin, target
 	if err != nil {
 		return err
 	}
-	// update es async
-	go func() {
-		err := es.UpsertProductES(ctx, productId, target)
-		if err != nil {
-			klog.CtxErrorf(ctx, "UpsertProductES err: %v", err)
-		}
-	}()
 	changeMap := differ.ProductPODiffer.GetChangedMap(originPO, targetPO)
-	return DB.WithContext(ctx).Model(&po.Product{}).Where("product_id = ?", productId).
-		Updates(changeMap).Error
+	changes := differ.ProductPODiffer.GetFieldChanges(originPO, targetPO)
+	skuChange, err := getSkuChange(origin.Skus, target.Skus)
+	if err != nil {
+		return err
+	}
+	if skuChange != nil {
+		changes = append(changes, skuChange)
+	}
+	taxonomyChanges, err := getTaxonomyChanges(origin, target)
+	if err != nil {
+		return err
+	}
+	changes = append(changes, taxonomyChanges...)
+	// the stock is written under the row lock below, that of a product with skus is summed from them
+	delete(changeMap, "stock")
+	// optimistic lock, the row must still have the version that origin was read with.
+	// Every stock operation bumps the version too, so an edit never carries stock from a stale read.
+	changeMap["version"] = originPO.Version + 1
+	target.Version = originPO.Version + 1
+	// es is synced by the outbox relay
+	return DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := checkISBNUnique(tx, productId, newISBNs(origin, target)); err != nil {
+			return err
+		}
+		result := tx.Model(&po.Product{}).Where("product_id = ? AND version = ?", productId, originPO.Version).
+			Updates(changeMap)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return errno.ProductVersionConflictErr
+		}
+		if len(target.Skus) == 0 && target.Stock != origin.Stock {
+			if err := saveProductStock(tx, target, target.Stock-origin.Stock); err != nil {
+				return err
+			}
+		}
+		if skuChange != nil {
+			if err := saveSkus(ctx, tx, productId, origin.Skus, target.Skus); err != nil {
+				return err
+			}
+			if err := summarizeSkus(ctx, tx, target); err != nil {
+				return err
+			}
+		}
+		if len(taxonomyChanges) > 0 {
+			if err := saveProductTaxonomy(tx, target); err != nil {
+				return err
+			}
+		}
+		if err := appendProductHistory(tx, productId, operation, changes); err != nil {
+			return err
+		}
+		if err := appendPriceHistory(tx, origin, target, operation); err != nil {
+			return err
+		}
+		if err := finishAppliedSchedule(tx, operation); err != nil {
+			return err
+		}
+		return outbox.Append(tx, target)
+	})
+}
+
+// saveProductStock applies the edited stock of a product without skus as a delta on the locked row,
+// it can not go below the reserved stock
+func saveProductStock(tx *gorm.DB, product *entity.ProductEntity, delta int64) error {
+	productPO, err := lockProduct(tx, product.ProductId)
+	if err != nil {
+		return err
+	}
+	stock := productPO.Stock + delta
+	if stock < productPO.ReservedStock {
+		return fmt.Errorf("库存不能小于已预占库存 %d", productPO.ReservedStock)
+	}
+	if err := tx.Model(&po.Product{}).Where("product_id = ?", product.ProductId).
+		Update("stock", stock).Error; err != nil {
+		return err
+	}
+	product.Stock = stock
+	return nil
 }
 
 func (i ProductRepositoryImpl) GetProductById(ctx context.Context, productId int64) (*entity.ProductEntity, error) {
@@ -79,29 +187,32 @@ func (i ProductRepositoryImpl) GetProductById(ctx context.Context, productId int
 	if len(products) == 0 {
 		return nil, errors.New("该商品不存在")
 	}
-	do, err := converter.ProductPO2DOConverter.Convert2do(ctx, products[0])
+	do, err := loadProductDO(ctx, DB.WithContext(ctx), products[0])
 	if err != nil {
 		return nil, err
 	}
 	return do, nil
 }
 
-func (i ProductRepositoryImpl) ListProducts(ctx context.Context, filterParam map[string]interface{}) ([]*entity.ProductEntity, error) {
-	products := make([]*po.Product, 0)
-	productEntities := make([]*entity.ProductEntity, 0)
-	DB = DB.Debug().WithContext(ctx)
-	for k, v := range filterParam {
-		DB = DB.Where(k+" = ?", v)
-	}
-	if err := DB.Find(&products).Error; err != nil {
+func (i ProductRepositoryImpl) ListProducts(ctx context.Context, query *entity.ProductListQuery) (*entity.ProductListResult, error) {
+	builder := newProductListQueryBuilder(query)
+	db := DB.WithContext(ctx)
+	var total int64
+	if err := builder.Filter(db.Model(&po.Product{})).Count(&total).Error; err != nil {
 		return nil, err
 	}
-	for _, v := range products {
-		entity, err := converter.ProductPO2DOConverter.Convert2do(ctx, v)
-		if err != nil {
+	products := make([]*po.Product, 0)
+	if total > int64(query.Offset) {
+		if err := builder.Page(builder.Filter(db)).Find(&products).Error; err != nil {
 			return nil, err
 		}
-		productEntities = append(productEntities, entity)
 	}
-	return productEntities, nil
+	productEntities, err := LoadProductDOs(ctx, db, products)
+	if err != nil {
+		return nil, err
+	}
+	return &entity.ProductListResult{
+		Products: productEntities,
+		Total:    total,
+	}, nil
 }
@@ -0,0 +1,127 @@
+// Copyright 2023 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/cloudwego/biz-demo/book-shop/app/item/common/constant"
+	"github.com/cloudwego/biz-demo/book-shop/app/item/common/entity"
+	"github.com/cloudwego/biz-demo/book-shop/app/item/common/po"
+	"gorm.io/gorm"
+)
+
+type ProductScheduleRepositoryImpl struct{}
+
+func (i ProductScheduleRepositoryImpl) CreateSchedule(ctx context.Context, schedule *entity.ProductScheduleEntity) error {
+	return DB.WithContext(ctx).Create(&po.ProductStateSchedule{
+		ScheduleId:    schedule.ScheduleId,
+		ProductId:     schedule.ProductId,
+		OperationType: schedule.OperationType,
+		ExecuteAt:     schedule.ExecuteAt,
+		Status:        schedule.Status,
+		Operator:      schedule.Operator,
+	}).Error
+}
+
+func (i ProductScheduleRepositoryImpl) CancelSchedule(ctx context.Context, scheduleId int64) error {
+	result := DB.WithContext(ctx).Model(&po.ProductStateSchedule{}).
+		Where("schedule_id = ? AND status = ?", scheduleId, constant.ProductScheduleStatusPending).
+		Update("status", constant.ProductScheduleStatusCancelled)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return errors.New("定时任务不存在或已执行")
+	}
+	return nil
+}
+
+func (i ProductScheduleRepositoryImpl) CancelProductSchedules(ctx context.Context, productId int64) error {
+	return DB.WithContext(ctx).Model(&po.ProductStateSchedule{}).
+		Where("product_id = ? AND status = ?", productId, constant.ProductScheduleStatusPending).
+		Update("status", constant.ProductScheduleStatusCancelled).Error
+}
+
+func (i ProductScheduleRepositoryImpl) ListDueSchedules(ctx context.Context, now time.Time, limit int) ([]*entity.ProductScheduleEntity, error) {
+	schedulePOArr := make([]*po.ProductStateSchedule, 0)
+	err := dueSchedules(DB.WithContext(ctx), now).
+		Order("execute_at").Limit(limit).Find(&schedulePOArr).Error
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*entity.ProductScheduleEntity, 0, len(schedulePOArr))
+	for _, schedulePO := range schedulePOArr {
+		ret = append(ret, &entity.ProductScheduleEntity{
+			ScheduleId:    schedulePO.ScheduleId,
+			ProductId:     schedulePO.ProductId,
+			OperationType: schedulePO.OperationType,
+			ExecuteAt:     schedulePO.ExecuteAt,
+			Status:        schedulePO.Status,
+			Operator:      schedulePO.Operator,
+		})
+	}
+	return ret, nil
+}
+
+func (i ProductScheduleRepositoryImpl) ClaimSchedule(ctx context.Context, scheduleId int64, now time.Time) (bool, error) {
+	// updated_at is refreshed by the update, which restarts the running timeout
+	result := dueSchedules(DB.WithContext(ctx).Model(&po.ProductStateSchedule{}), now).
+		Where("schedule_id = ?", scheduleId).
+		Update("status", constant.ProductScheduleStatusRunning)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
+
+func (i ProductScheduleRepositoryImpl) FinishSchedule(ctx context.Context, scheduleId int64, status int64, errMsg string) error {
+	if len(errMsg) > 255 {
+		errMsg = errMsg[:255]
+	}
+	return DB.WithContext(ctx).Model(&po.ProductStateSchedule{}).
+		Where("schedule_id = ? AND status = ?", scheduleId, constant.ProductScheduleStatusRunning).
+		Updates(map[string]interface{}{
+			"status":  status,
+			"err_msg": errMsg,
+		}).Error
+}
+
+// finishAppliedSchedule marks the schedule applied by the operation done, it fails if the schedule
+// is no longer running, e.g. it was done by another instance which took it over
+func finishAppliedSchedule(tx *gorm.DB, operation *entity.ProductOperationEntity) error {
+	if operation == nil || operation.ScheduleId == 0 {
+		return nil
+	}
+	result := tx.Model(&po.ProductStateSchedule{}).
+		Where("schedule_id = ? AND status = ?", operation.ScheduleId, constant.ProductScheduleStatusRunning).
+		Update("status", constant.ProductScheduleStatusDone)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return errors.New("定时任务已结束或被其他实例执行")
+	}
+	return nil
+}
+
+func dueSchedules(db *gorm.DB, now time.Time) *gorm.DB {
+	return db.Where("(status = ? AND execute_at <= ?) OR (status = ? AND updated_at <= ?)",
+		constant.ProductScheduleStatusPending, now,
+		constant.ProductScheduleStatusRunning, now.Add(-constant.ProductScheduleRunningTimeout))
+}
@@ -0,0 +1,277 @@
+// Copyright 2023 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/bytedance/sonic"
+	"github.com/cloudwego/biz-demo/book-shop/app/item/common/entity"
+	"github.com/cloudwego/biz-demo/book-shop/app/item/common/po"
+	"github.com/cloudwego/biz-demo/book-shop/app/item/infras/repository/converter"
+	"github.com/cloudwego/biz-demo/book-shop/pkg/errno"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// LoadProductDOs converts the product rows and attaches their skus, db may be a transaction
+func LoadProductDOs(ctx context.Context, db *gorm.DB, productPOs []*po.Product) ([]*entity.ProductEntity, error) {
+	productIds := make([]int64, 0, len(productPOs))
+	for _, productPO := range productPOs {
+		productIds = append(productIds, productPO.ProductId)
+	}
+	skuPOMap := make(map[int64][]*po.ProductSku, len(productPOs))
+	if len(productIds) > 0 {
+		skuPOArr := make([]*po.ProductSku, 0)
+		if err := db.Where("product_id IN ?", productIds).Order("id").Find(&skuPOArr).Error; err != nil {
+			return nil, err
+		}
+		for _, skuPO := range skuPOArr {
+			skuPOMap[skuPO.ProductId] = append(skuPOMap[skuPO.ProductId], skuPO)
+		}
+	}
+	promoPriceMap, err := loadPromoPrices(db, productIds, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	galleryMap, err := loadGalleries(db, productIds)
+	if err != nil {
+		return nil, err
+	}
+	taxonomyMap, err := loadTaxonomies(db, productIds)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*entity.ProductEntity, 0, len(productPOs))
+	for _, productPO := range productPOs {
+		do, err := converter.ProductPO2DOConverter.Convert2doWithSkus(ctx, productPO, skuPOMap[productPO.ProductId])
+		if err != nil {
+			return nil, err
+		}
+		do.PromoPrices = promoPriceMap[productPO.ProductId]
+		do.Gallery = galleryMap[productPO.ProductId]
+		if taxonomy, ok := taxonomyMap[productPO.ProductId]; ok {
+			do.CategoryIds = taxonomy.categoryIds
+			do.CategoryPaths = taxonomy.categoryPaths
+			do.Tags = taxonomy.tags
+		}
+		ret = append(ret, do)
+	}
+	return ret, nil
+}
+
+func loadProductDO(ctx context.Context, db *gorm.DB, productPO *po.Product) (*entity.ProductEntity, error) {
+	dos, err := LoadProductDOs(ctx, db, []*po.Product{productPO})
+	if err != nil {
+		return nil, err
+	}
+	return dos[0], nil
+}
+
+func createSkus(ctx context.Context, tx *gorm.DB, productId int64, skus []*entity.SkuEntity) error {
+	for _, sku := range skus {
+		skuPO, err := converter.SkuDO2POConverter.Convert2po(ctx, productId, sku)
+		if err != nil {
+			return err
+		}
+		if err := tx.Create(skuPO).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// saveSkus makes the sku rows of the product match skus, the product row must be locked by tx.
+// The stock of a sku is written only when skus changes it from origin, as a delta on the locked row.
+// A sku with reserved stock can neither be removed nor get less stock than it has reserved.
+func saveSkus(ctx context.Context, tx *gorm.DB, productId int64, origin, skus []*entity.SkuEntity) error {
+	skuPOArr := make([]*po.ProductSku, 0)
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("product_id = ?", productId).
+		Order("sku_id").Find(&skuPOArr).Error; err != nil {
+		return err
+	}
+	// the stock reserved on the product row could not be told apart from the sku stock any more
+	if len(skuPOArr) == 0 && len(skus) > 0 {
+		productPO, err := lockProduct(tx, productId)
+		if err != nil {
+			return err
+		}
+		if productPO.ReservedStock > 0 {
+			return errors.New("商品有未确认的预占库存, 暂不能添加规格")
+		}
+	}
+	skuPOMap := make(map[int64]*po.ProductSku, len(skuPOArr))
+	for _, skuPO := range skuPOArr {
+		skuPOMap[skuPO.SkuId] = skuPO
+	}
+	originStock := make(map[int64]int64, len(origin))
+	for _, sku := range origin {
+		originStock[sku.SkuId] = sku.Stock
+	}
+	for _, sku := range skus {
+		skuPO, ok := skuPOMap[sku.SkuId]
+		if !ok {
+			if err := createSkus(ctx, tx, productId, []*entity.SkuEntity{sku}); err != nil {
+				return err
+			}
+			continue
+		}
+		delete(skuPOMap, sku.SkuId)
+		targetPO, err := converter.SkuDO2POConverter.Convert2po(ctx, productId, sku)
+		if err != nil {
+			return err
+		}
+		changeMap := map[string]interface{}{
+			"edition":    targetPO.Edition,
+			"attributes": targetPO.Attributes,
+			"isbn":       targetPO.ISBN,
+			"price":      targetPO.Price,
+		}
+		baseStock, ok := originStock[sku.SkuId]
+		if !ok {
+			baseStock = skuPO.Stock
+		}
+		if sku.Stock != baseStock {
+			stock := skuPO.Stock + sku.Stock - baseStock
+			if stock < skuPO.ReservedStock {
+				return fmt.Errorf("规格 %d 的库存不能小于已预占库存 %d", sku.SkuId, skuPO.ReservedStock)
+			}
+			changeMap["stock"] = stock
+		}
+		if err := tx.Model(&po.ProductSku{}).Where("sku_id = ?", sku.SkuId).
+			Updates(changeMap).Error; err != nil {
+			return err
+		}
+	}
+	for skuId, skuPO := range skuPOMap {
+		if skuPO.ReservedStock > 0 {
+			return fmt.Errorf("规格 %d 有未确认的预占库存, 不能删除", skuId)
+		}
+		if err := tx.Where("sku_id = ?", skuId).Delete(&po.ProductSku{}).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// summarizeSkus reloads the skus of the product in tx and writes their lowest price and
+// total stock to the product row. The stock operations lock the product row first, so none runs in between.
+func summarizeSkus(ctx context.Context, tx *gorm.DB, product *entity.ProductEntity) error {
+	productPO, err := lockProduct(tx, product.ProductId)
+	if err != nil {
+		return err
+	}
+	productDO, err := loadProductDO(ctx, tx, productPO)
+	if err != nil {
+		return err
+	}
+	product.Skus = productDO.Skus
+	product.PromoPrices = productDO.PromoPrices
+	if len(product.Skus) == 0 {
+		return nil
+	}
+	product.SummarizeSkus()
+	return tx.Model(&po.Product{}).Where("product_id = ?", product.ProductId).
+		Updates(map[string]interface{}{
+			"price": product.Price,
+			"stock": product.Stock,
+		}).Error
+}
+
+// getSkuChange returns nil if the skus are not changed
+func getSkuChange(origin, target []*entity.SkuEntity) (*entity.FieldChangeEntity, error) {
+	if len(origin) == 0 && len(target) == 0 || reflect.DeepEqual(origin, target) {
+		return nil, nil
+	}
+	before, err := sonic.MarshalString(origin)
+	if err != nil {
+		return nil, err
+	}
+	after, err := sonic.MarshalString(target)
+	if err != nil {
+		return nil, err
+	}
+	return &entity.FieldChangeEntity{Field: "skus", Before: before, After: after}, nil
+}
+
+// lockStockSku selects the sku of a stock operation for update, the product row must be locked first.
+// nil is returned for a product without skus, whose stock is kept on the product row.
+func lockStockSku(tx *gorm.DB, productId, skuId int64) (*po.ProductSku, error) {
+	if skuId == 0 {
+		var skuCount int64
+		if err := tx.Model(&po.ProductSku{}).Where("product_id = ?", productId).Count(&skuCount).Error; err != nil {
+			return nil, err
+		}
+		if skuCount > 0 {
+			return nil, errno.ParamErr.WithMessage("sku_id is required for a product with skus")
+		}
+		return nil, nil
+	}
+	skuPOArr := make([]*po.ProductSku, 0)
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("sku_id = ? AND product_id = ?", skuId, productId).
+		Find(&skuPOArr).Error; err != nil {
+		return nil, err
+	}
+	if len(skuPOArr) == 0 {
+		return nil, errors.New("规格不存在")
+	}
+	return skuPOArr[0], nil
+}
+
+// availableStock the stock which is not reserved, of the sku if there is one
+func availableStock(productPO *po.Product, skuPO *po.ProductSku) int64 {
+	if skuPO != nil {
+		return skuPO.Stock - skuPO.ReservedStock
+	}
+	return productPO.Stock - productPO.ReservedStock
+}
+
+// saveStockRows writes the stock of the product row and of its sku, the sku change
+// must already be applied to the product row as well to keep the sum.
+// The version is bumped, so that an edit of the product read before the change conflicts.
+func saveStockRows(tx *gorm.DB, productPO *po.Product, skuPO *po.ProductSku) error {
+	productPO.Version++
+	if err := tx.Model(&po.Product{}).Where("product_id = ?", productPO.ProductId).
+		Updates(map[string]interface{}{
+			"stock":          productPO.Stock,
+			"reserved_stock": productPO.ReservedStock,
+			"version":        productPO.Version,
+		}).Error; err != nil {
+		return err
+	}
+	if skuPO == nil {
+		return nil
+	}
+	return tx.Model(&po.ProductSku{}).Where("sku_id = ?", skuPO.SkuId).
+		Updates(map[string]interface{}{
+			"stock":          skuPO.Stock,
+			"reserved_stock": skuPO.ReservedStock,
+		}).Error
+}
+
+// addStock applies the change to the sku and to the product row, which keeps the sum of the sku stock
+func addStock(productPO *po.Product, skuPO *po.ProductSku, stockNum, reservedNum int64) {
+	productPO.Stock += stockNum
+	productPO.ReservedStock += reservedNum
+	if skuPO != nil {
+		skuPO.Stock += stockNum
+		skuPO.ReservedStock += reservedNum
+	}
+}
@@ -0,0 +1,256 @@
+// Copyright 2023 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package repository
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/cloudwego/biz-demo/book-shop/app/item/common/constant"
+	"github.com/cloudwego/biz-demo/book-shop/app/item/common/entity"
+	"github.com/cloudwego/biz-demo/book-shop/app/item/common/po"
+	"github.com/cloudwego/biz-demo/book-shop/app/item/infras/outbox"
+	"github.com/cloudwego/biz-demo/book-shop/pkg/errno"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+func (i StockRepositoryImpl) BatchIncrStock(ctx context.Context, lines []*entity.StockLineEntity, idempotencyKey string) error {
+	var changes []*entity.StockChangeEntity
+	replayed := false
+	err := DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		productPOMap, skuPOMap, err := lockStockLines(tx, lines)
+		if err != nil {
+			return err
+		}
+		// the product row locks serialize the calls with the same key
+		if idempotencyKey != "" {
+			record, err := getIdempotencyKey(tx, idempotencyKey)
+			if err != nil {
+				return err
+			}
+			if record != nil {
+				replayed = true
+				return replayBatchIdempotencyKey(record, lines, constant.StockOpTypeBatchIncr)
+			}
+			if err := createBatchIdempotencyKey(tx, idempotencyKey, lines, constant.StockOpTypeBatchIncr, ""); err != nil {
+				return err
+			}
+		}
+		snapshot := snapshotStock(productPOMap)
+		for _, line := range lines {
+			addStock(productPOMap[line.ProductId], skuPOMap[line.SkuId], line.StockNum, 0)
+		}
+		changes = newStockChanges(productPOMap, snapshot)
+		return saveStocks(ctx, tx, lines, productPOMap, skuPOMap)
+	})
+	if err != nil || replayed {
+		return err
+	}
+	notifyStockChanges(ctx, changes)
+	return nil
+}
+
+func (i StockRepositoryImpl) BatchDecrStock(ctx context.Context, lines []*entity.StockLineEntity, idempotencyKey string) ([]*entity.StockShortageEntity, error) {
+	shortages := make([]*entity.StockShortageEntity, 0)
+	var changes []*entity.StockChangeEntity
+	replayed := false
+	err := DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		productPOMap, skuPOMap, err := lockStockLines(tx, lines)
+		if err != nil {
+			return err
+		}
+		if idempotencyKey != "" {
+			record, err := getIdempotencyKey(tx, idempotencyKey)
+			if err != nil {
+				return err
+			}
+			if record != nil {
+				replayed = true
+				return replayBatchIdempotencyKey(record, lines, constant.StockOpTypeBatchDecr)
+			}
+		}
+		for _, line := range lines {
+			// reserved stock is not available to direct decrements
+			availableNum := availableStock(productPOMap[line.ProductId], skuPOMap[line.SkuId])
+			if availableNum < line.StockNum {
+				shortages = append(shortages, &entity.StockShortageEntity{
+					ProductId:    line.ProductId,
+					SkuId:        line.SkuId,
+					StockNum:     line.StockNum,
+					AvailableNum: availableNum,
+				})
+			}
+		}
+		if len(shortages) > 0 {
+			if idempotencyKey == "" {
+				return nil
+			}
+			// keep the failure so that a retry does not succeed once stock is refilled
+			return createBatchIdempotencyKey(tx, idempotencyKey, lines, constant.StockOpTypeBatchDecr, errno.StockNotEnoughErr.ErrMsg)
+		}
+		if idempotencyKey != "" {
+			if err := createBatchIdempotencyKey(tx, idempotencyKey, lines, constant.StockOpTypeBatchDecr, ""); err != nil {
+				return err
+			}
+		}
+		snapshot := snapshotStock(productPOMap)
+		for _, line := range lines {
+			addStock(productPOMap[line.ProductId], skuPOMap[line.SkuId], -line.StockNum, 0)
+		}
+		changes = newStockChanges(productPOMap, snapshot)
+		return saveStocks(ctx, tx, lines, productPOMap, skuPOMap)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if replayed {
+		return nil, nil
+	}
+	notifyStockChanges(ctx, changes)
+	return shortages, nil
+}
+
+func createBatchIdempotencyKey(tx *gorm.DB, idempotencyKey string, lines []*entity.StockLineEntity, opType constant.StockOpType, errMsg string) error {
+	return tx.Create(&po.StockIdempotencyKey{
+		IdempotencyKey: idempotencyKey,
+		OpType:         opType,
+		ErrMsg:         errMsg,
+		LinesDigest:    stockLinesDigest(lines),
+	}).Error
+}
+
+// replayBatchIdempotencyKey returns the result of the first call, a key reused for other lines is rejected.
+// The shortage lines of a failed first call are not kept, the replay only returns errno.StockNotEnoughErr.
+func replayBatchIdempotencyKey(record *po.StockIdempotencyKey, lines []*entity.StockLineEntity, opType constant.StockOpType) error {
+	if record.OpType != opType || record.LinesDigest != stockLinesDigest(lines) {
+		return errno.ParamErr.WithMessage("idempotency key is used by another stock operation")
+	}
+	if record.ErrMsg != "" {
+		return errno.StockNotEnoughErr
+	}
+	return nil
+}
+
+// stockLinesDigest the lines are merged and sorted by the service, so that the same batch gets the same digest
+func stockLinesDigest(lines []*entity.StockLineEntity) string {
+	h := sha256.New()
+	for _, line := range lines {
+		fmt.Fprintf(h, "%d:%d:%d;", line.ProductId, line.SkuId, line.StockNum)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// lockStockLines selects the product rows of the lines and then their sku rows for update,
+// both in ascending id order, so that concurrent batches never wait on each other in a cycle.
+// The sku map is keyed by sku id, lines of a product without skus have no entry.
+func lockStockLines(tx *gorm.DB, lines []*entity.StockLineEntity) (map[int64]*po.Product, map[int64]*po.ProductSku, error) {
+	productIds := make([]int64, 0, len(lines))
+	skuIds := make([]int64, 0, len(lines))
+	for _, line := range lines {
+		productIds = append(productIds, line.ProductId)
+		if line.SkuId != 0 {
+			skuIds = append(skuIds, line.SkuId)
+		}
+	}
+	productPOArr := make([]*po.Product, 0, len(lines))
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("product_id IN ?", productIds).
+		Order("product_id").Find(&productPOArr).Error; err != nil {
+		return nil, nil, err
+	}
+	productPOMap := make(map[int64]*po.Product, len(productPOArr))
+	for _, productPO := range productPOArr {
+		productPOMap[productPO.ProductId] = productPO
+	}
+	for _, productId := range productIds {
+		if _, ok := productPOMap[productId]; !ok {
+			return nil, nil, fmt.Errorf("item %d not found", productId)
+		}
+	}
+
+	skuPOArr := make([]*po.ProductSku, 0, len(skuIds))
+	if len(skuIds) > 0 {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("sku_id IN ?", skuIds).
+			Order("sku_id").Find(&skuPOArr).Error; err != nil {
+			return nil, nil, err
+		}
+	}
+	skuPOMap := make(map[int64]*po.ProductSku, len(skuPOArr))
+	for _, skuPO := range skuPOArr {
+		skuPOMap[skuPO.SkuId] = skuPO
+	}
+	skuProductIds := make([]int64, 0)
+	if err := tx.Model(&po.ProductSku{}).Where("product_id IN ?", productIds).
+		Distinct().Pluck("product_id", &skuProductIds).Error; err != nil {
+		return nil, nil, err
+	}
+	hasSkus := make(map[int64]bool, len(skuProductIds))
+	for _, productId := range skuProductIds {
+		hasSkus[productId] = true
+	}
+	for _, line := range lines {
+		if line.SkuId == 0 {
+			if hasSkus[line.ProductId] {
+				return nil, nil, errno.ParamErr.WithMessage(fmt.Sprintf("sku_id is required for item %d", line.ProductId))
+			}
+			continue
+		}
+		if skuPO, ok := skuPOMap[line.SkuId]; !ok || skuPO.ProductId != line.ProductId {
+			return nil, nil, fmt.Errorf("sku %d of item %d not found", line.SkuId, line.ProductId)
+		}
+	}
+	return productPOMap, skuPOMap, nil
+}
+
+// saveStocks writes every touched product and sku row, each product is synced to es once
+func saveStocks(ctx context.Context, tx *gorm.DB, lines []*entity.StockLineEntity,
+	productPOMap map[int64]*po.Product, skuPOMap map[int64]*po.ProductSku,
+) error {
+	for _, skuPO := range skuPOMap {
+		if err := tx.Model(&po.ProductSku{}).Where("sku_id = ?", skuPO.SkuId).
+			Update("stock", skuPO.Stock).Error; err != nil {
+			return err
+		}
+	}
+	saved := make(map[int64]bool, len(productPOMap))
+	for _, line := range lines {
+		if saved[line.ProductId] {
+			continue
+		}
+		saved[line.ProductId] = true
+		productPO := productPOMap[line.ProductId]
+		// the version is bumped as saveStockRows does
+		productPO.Version++
+		if err := tx.Model(&po.Product{}).Where("product_id = ?", productPO.ProductId).
+			Updates(map[string]interface{}{
+				"stock":   productPO.Stock,
+				"version": productPO.Version,
+			}).Error; err != nil {
+			return err
+		}
+		// sync the new stock to es through the outbox
+		productDO, err := loadProductDO(ctx, tx, productPO)
+		if err != nil {
+			return err
+		}
+		if err := outbox.Append(tx, productDO); err != nil {
+			return err
+		}
+	}
+	return nil
+}
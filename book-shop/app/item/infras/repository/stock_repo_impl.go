@@ -19,21 +19,27 @@ import (
 	"context"
 	"errors"
 
+	"github.com/cloudwego/biz-demo/book-shop/app/item/common/constant"
+	"github.com/cloudwego/biz-demo/book-shop/app/item/common/entity"
 	"github.com/cloudwego/biz-demo/book-shop/app/item/common/po"
+	"github.com/cloudwego/biz-demo/book-shop/app/item/infras/outbox"
+	"github.com/cloudwego/biz-demo/book-shop/pkg/errno"
+	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 )
 
 type StockRepositoryImpl struct{}
 
-func (i StockRepositoryImpl) IncrStock(ctx context.Context, productId, stockNum int64) error {
-	return i.updateStock(ctx, productId, stockNum, "incr")
+func (i StockRepositoryImpl) IncrStock(ctx context.Context, productId, skuId, stockNum int64, idempotencyKey string) error {
+	return i.updateStock(ctx, productId, skuId, stockNum, constant.StockOpTypeIncr, idempotencyKey)
 }
 
-func (i StockRepositoryImpl) DecrStock(ctx context.Context, productId, stockNum int64) error {
-	return i.updateStock(ctx, productId, stockNum, "decr")
+func (i StockRepositoryImpl) DecrStock(ctx context.Context, productId, skuId, stockNum int64, idempotencyKey string) error {
+	return i.updateStock(ctx, productId, skuId, stockNum, constant.StockOpTypeDecr, idempotencyKey)
 }
 
-func (i StockRepositoryImpl) updateStock(ctx context.Context, productId, stockNum int64, updateType string) error {
+// updateStock an empty idempotencyKey disables the replay check
+func (i StockRepositoryImpl) updateStock(ctx context.Context, productId, skuId, stockNum int64, opType constant.StockOpType, idempotencyKey string) error {
 	productPOArr := make([]*po.Product, 0)
 
 	tx := DB.Begin().WithContext(ctx)
@@ -50,25 +56,106 @@ func (i StockRepositoryImpl) updateStock(ctx context.Context, productId, stockNu
 		return errors.New("item not found")
 	}
 
+	// the product row lock serializes the calls with the same key
+	if idempotencyKey != "" {
+		record, err := getIdempotencyKey(tx, idempotencyKey)
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+		if record != nil {
+			tx.Rollback()
+			return replayIdempotencyKey(record, productId, skuId, stockNum, opType)
+		}
+	}
+
 	productPO := productPOArr[0]
-	curStockNum := productPO.Stock
-	if updateType == "incr" {
-		curStockNum += stockNum
-	} else if updateType == "decr" {
-		curStockNum -= stockNum
+	skuPO, err := lockStockSku(tx, productId, skuId)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	delta := stockNum
+	if opType == constant.StockOpTypeDecr {
+		delta = -stockNum
+	}
+	// reserved stock is not available to direct decrements
+	if availableStock(productPO, skuPO)+delta < 0 {
+		shortageErr := errors.New("库存不足")
+		if idempotencyKey == "" {
+			tx.Rollback()
+			return shortageErr
+		}
+		// keep the failure so that a retry does not succeed once stock is refilled
+		if err := createIdempotencyKey(tx, idempotencyKey, productId, skuId, stockNum, opType, shortageErr.Error()); err != nil {
+			tx.Rollback()
+			return err
+		}
+		if err := tx.Commit().Error; err != nil {
+			return err
+		}
+		return shortageErr
 	}
-	if curStockNum < 0 {
+	beforeNum := availableStock(productPO, nil)
+	addStock(productPO, skuPO, delta, 0)
+	if err := saveStockRows(tx, productPO, skuPO); err != nil {
 		tx.Rollback()
-		return errors.New("库存不足")
+		return err
+	}
+	if idempotencyKey != "" {
+		if err := createIdempotencyKey(tx, idempotencyKey, productId, skuId, stockNum, opType, ""); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+	// sync the new stock to es through the outbox
+	productDO, err := loadProductDO(ctx, tx, productPO)
+	if err != nil {
+		tx.Rollback()
+		return err
 	}
-	if err := tx.Model(&po.Product{}).Where("product_id = ?", productId).
-		Updates(map[string]interface{}{
-			"stock": curStockNum,
-		}).Error; err != nil {
+	if err := outbox.Append(tx, productDO); err != nil {
 		tx.Rollback()
 		return err
 	}
 
-	tx.Commit()
+	if err := tx.Commit().Error; err != nil {
+		return err
+	}
+	notifyStockChanges(ctx, []*entity.StockChangeEntity{newStockChange(productPO, beforeNum)})
+	return nil
+}
+
+func getIdempotencyKey(tx *gorm.DB, idempotencyKey string) (*po.StockIdempotencyKey, error) {
+	records := make([]*po.StockIdempotencyKey, 0)
+	// locking read, so that a key committed after the snapshot is seen
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("idempotency_key = ?", idempotencyKey).Find(&records).Error; err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, nil
+	}
+	return records[0], nil
+}
+
+func createIdempotencyKey(tx *gorm.DB, idempotencyKey string, productId, skuId, stockNum int64, opType constant.StockOpType, errMsg string) error {
+	return tx.Create(&po.StockIdempotencyKey{
+		IdempotencyKey: idempotencyKey,
+		ProductId:      productId,
+		SkuId:          skuId,
+		StockNum:       stockNum,
+		OpType:         opType,
+		ErrMsg:         errMsg,
+	}).Error
+}
+
+// replayIdempotencyKey returns the result of the first call, a key reused for another operation is rejected
+func replayIdempotencyKey(record *po.StockIdempotencyKey, productId, skuId, stockNum int64, opType constant.StockOpType) error {
+	if record.ProductId != productId || record.SkuId != skuId || record.StockNum != stockNum || record.OpType != opType {
+		return errno.ParamErr.WithMessage("idempotency key is used by another stock operation")
+	}
+	if record.ErrMsg != "" {
+		return errors.New(record.ErrMsg)
+	}
 	return nil
 }
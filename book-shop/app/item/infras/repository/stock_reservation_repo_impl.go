@@ -0,0 +1,202 @@
+// Copyright 2023 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/cloudwego/biz-demo/book-shop/app/item/common/constant"
+	"github.com/cloudwego/biz-demo/book-shop/app/item/common/entity"
+	"github.com/cloudwego/biz-demo/book-shop/app/item/common/po"
+	"github.com/cloudwego/biz-demo/book-shop/app/item/infras/outbox"
+	"github.com/cloudwego/biz-demo/book-shop/pkg/errno"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+func (i StockRepositoryImpl) ReserveStock(ctx context.Context, reservation *entity.StockReservationEntity) error {
+	var change *entity.StockChangeEntity
+	err := DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		productPO, err := lockProduct(tx, reservation.ProductId)
+		if err != nil {
+			return err
+		}
+		skuPO, err := lockStockSku(tx, reservation.ProductId, reservation.SkuId)
+		if err != nil {
+			return err
+		}
+		if availableStock(productPO, skuPO) < reservation.StockNum {
+			return errors.New("库存不足")
+		}
+		beforeNum := availableStock(productPO, nil)
+		addStock(productPO, skuPO, 0, reservation.StockNum)
+		if err := saveStockRows(tx, productPO, skuPO); err != nil {
+			return err
+		}
+		change = newStockChange(productPO, beforeNum)
+		return tx.Create(&po.StockReservation{
+			ReservationId: reservation.ReservationId,
+			ProductId:     reservation.ProductId,
+			SkuId:         reservation.SkuId,
+			StockNum:      reservation.StockNum,
+			Status:        constant.StockReservationStatusReserved,
+			ExpireAt:      reservation.ExpireAt,
+		}).Error
+	})
+	if err != nil {
+		return err
+	}
+	notifyStockChanges(ctx, []*entity.StockChangeEntity{change})
+	return nil
+}
+
+func (i StockRepositoryImpl) ConfirmReservation(ctx context.Context, reservationId int64) error {
+	var changes []*entity.StockChangeEntity
+	err := DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		reservationPO, err := lockReservation(tx, reservationId)
+		if err != nil {
+			return err
+		}
+		switch reservationPO.Status {
+		case constant.StockReservationStatusConfirmed:
+			return nil
+		case constant.StockReservationStatusReleased:
+			return errors.New("预占已释放")
+		}
+		// an expired hold is left to the sweeper even if it has not been released yet
+		if !reservationPO.ExpireAt.After(time.Now()) {
+			return errors.New("预占已过期")
+		}
+
+		productPO, skuPO, err := lockReservedStock(tx, reservationPO)
+		if err != nil {
+			return err
+		}
+		beforeNum := availableStock(productPO, nil)
+		addStock(productPO, skuPO, -reservationPO.StockNum, -reservationPO.StockNum)
+		if err := saveStockRows(tx, productPO, skuPO); err != nil {
+			return err
+		}
+		changes = []*entity.StockChangeEntity{newStockChange(productPO, beforeNum)}
+		if err := updateReservationStatus(tx, reservationId, constant.StockReservationStatusConfirmed); err != nil {
+			return err
+		}
+		// sync the new stock to es through the outbox
+		productDO, err := loadProductDO(ctx, tx, productPO)
+		if err != nil {
+			return err
+		}
+		return outbox.Append(tx, productDO)
+	})
+	if err != nil {
+		return err
+	}
+	notifyStockChanges(ctx, changes)
+	return nil
+}
+
+func (i StockRepositoryImpl) ReleaseReservation(ctx context.Context, reservationId int64) error {
+	var changes []*entity.StockChangeEntity
+	err := DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		reservationPO, err := lockReservation(tx, reservationId)
+		if err != nil {
+			return err
+		}
+		switch reservationPO.Status {
+		case constant.StockReservationStatusReleased:
+			return nil
+		case constant.StockReservationStatusConfirmed:
+			return errno.ReservationConfirmedErr
+		}
+
+		productPO, skuPO, err := lockReservedStock(tx, reservationPO)
+		if err != nil {
+			return err
+		}
+		beforeNum := availableStock(productPO, nil)
+		addStock(productPO, skuPO, 0, -reservationPO.StockNum)
+		if err := saveStockRows(tx, productPO, skuPO); err != nil {
+			return err
+		}
+		changes = []*entity.StockChangeEntity{newStockChange(productPO, beforeNum)}
+		return updateReservationStatus(tx, reservationId, constant.StockReservationStatusReleased)
+	})
+	if err != nil {
+		return err
+	}
+	notifyStockChanges(ctx, changes)
+	return nil
+}
+
+func (i StockRepositoryImpl) ListExpiredReservations(ctx context.Context, now time.Time, limit int) ([]int64, error) {
+	ids := make([]int64, 0)
+	err := DB.WithContext(ctx).Model(&po.StockReservation{}).
+		Where("status = ? AND expire_at <= ?", constant.StockReservationStatusReserved, now).
+		Order("expire_at").Limit(limit).
+		Pluck("reservation_id", &ids).Error
+	if err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// lockProduct selects the product row for update, reservation rows are
+// always locked before the product row to keep a stable lock order
+func lockProduct(tx *gorm.DB, productId int64) (*po.Product, error) {
+	productPOArr := make([]*po.Product, 0)
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("product_id = ?", productId).Find(&productPOArr).Error; err != nil {
+		return nil, err
+	}
+	if len(productPOArr) == 0 {
+		return nil, errors.New("item not found")
+	}
+	return productPOArr[0], nil
+}
+
+// lockReservedStock locks the rows holding the reserved stock,
+// a reservation without sku only holds stock on the product row
+func lockReservedStock(tx *gorm.DB, reservationPO *po.StockReservation) (*po.Product, *po.ProductSku, error) {
+	productPO, err := lockProduct(tx, reservationPO.ProductId)
+	if err != nil {
+		return nil, nil, err
+	}
+	if reservationPO.SkuId == 0 {
+		return productPO, nil, nil
+	}
+	skuPO, err := lockStockSku(tx, reservationPO.ProductId, reservationPO.SkuId)
+	if err != nil {
+		return nil, nil, err
+	}
+	return productPO, skuPO, nil
+}
+
+func lockReservation(tx *gorm.DB, reservationId int64) (*po.StockReservation, error) {
+	reservationPOArr := make([]*po.StockReservation, 0)
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("reservation_id = ?", reservationId).Find(&reservationPOArr).Error; err != nil {
+		return nil, err
+	}
+	if len(reservationPOArr) == 0 {
+		return nil, errors.New("预占不存在")
+	}
+	return reservationPOArr[0], nil
+}
+
+func updateReservationStatus(tx *gorm.DB, reservationId int64, status constant.StockReservationStatus) error {
+	return tx.Model(&po.StockReservation{}).Where("reservation_id = ?", reservationId).
+		Update("status", status).Error
+}
@@ -0,0 +1,165 @@
+// Copyright 2023 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cloudwego/biz-demo/book-shop/app/item/common/constant"
+	"github.com/cloudwego/biz-demo/book-shop/app/item/common/entity"
+	"github.com/cloudwego/biz-demo/book-shop/app/item/common/po"
+	"github.com/cloudwego/biz-demo/book-shop/app/item/domain/repository"
+	"github.com/cloudwego/biz-demo/book-shop/pkg/conf"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+func (i StockRepositoryImpl) SetLowStockThreshold(ctx context.Context, productId, threshold int64) error {
+	return DB.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "product_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"threshold", "updated_at"}),
+	}).Create(&po.ProductStockThreshold{
+		ProductId: productId,
+		Threshold: threshold,
+	}).Error
+}
+
+func (i StockRepositoryImpl) ResetLowStockThreshold(ctx context.Context, productId int64) error {
+	// the row is removed for good, so that the product can be given a threshold again
+	return DB.WithContext(ctx).Unscoped().Where("product_id = ?", productId).
+		Delete(&po.ProductStockThreshold{}).Error
+}
+
+func (i StockRepositoryImpl) MGetLowStockThresholds(ctx context.Context, productIds []int64) (map[int64]int64, error) {
+	ret := make(map[int64]int64, len(productIds))
+	if len(productIds) == 0 {
+		return ret, nil
+	}
+	thresholdPOArr := make([]*po.ProductStockThreshold, 0, len(productIds))
+	if err := DB.WithContext(ctx).Where("product_id IN ?", productIds).Find(&thresholdPOArr).Error; err != nil {
+		return nil, err
+	}
+	for _, thresholdPO := range thresholdPOArr {
+		ret[thresholdPO.ProductId] = thresholdPO.Threshold
+	}
+	return ret, nil
+}
+
+func (i StockRepositoryImpl) ListLowStockProducts(ctx context.Context, defaultThreshold int64, limit, offset int,
+) ([]*entity.LowStockProductEntity, int64, error) {
+	db := DB.WithContext(ctx)
+	var total int64
+	if err := lowStockQuery(db, defaultThreshold).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	if total <= int64(offset) {
+		return []*entity.LowStockProductEntity{}, total, nil
+	}
+
+	rows := make([]*struct {
+		ProductId int64
+		Threshold int64
+	}, 0, limit)
+	err := lowStockQuery(db, defaultThreshold).
+		Select(fmt.Sprintf("%s.product_id, COALESCE(%s.threshold, ?) AS threshold",
+			conf.ProductTableName, conf.ProductStockThresholdTableName), defaultThreshold).
+		Order(fmt.Sprintf("%[1]s.stock - %[1]s.reserved_stock, %[1]s.product_id", conf.ProductTableName)).
+		Limit(limit).Offset(offset).Scan(&rows).Error
+	if err != nil {
+		return nil, 0, err
+	}
+	productIds := make([]int64, 0, len(rows))
+	for _, row := range rows {
+		productIds = append(productIds, row.ProductId)
+	}
+	productPOArr := make([]*po.Product, 0, len(rows))
+	if err := db.Where("product_id IN ?", productIds).Find(&productPOArr).Error; err != nil {
+		return nil, 0, err
+	}
+	products, err := LoadProductDOs(ctx, db, productPOArr)
+	if err != nil {
+		return nil, 0, err
+	}
+	productMap := make(map[int64]*entity.ProductEntity, len(products))
+	for _, product := range products {
+		productMap[product.ProductId] = product
+	}
+	stockMap := make(map[int64]int64, len(productPOArr))
+	for _, productPO := range productPOArr {
+		stockMap[productPO.ProductId] = availableStock(productPO, nil)
+	}
+
+	ret := make([]*entity.LowStockProductEntity, 0, len(rows))
+	for _, row := range rows {
+		product, ok := productMap[row.ProductId]
+		if !ok {
+			continue
+		}
+		ret = append(ret, &entity.LowStockProductEntity{
+			Product:        product,
+			AvailableStock: stockMap[row.ProductId],
+			Threshold:      row.Threshold,
+		})
+	}
+	return ret, total, nil
+}
+
+// lowStockQuery the products not deleted whose available stock is not above their threshold
+func lowStockQuery(db *gorm.DB, defaultThreshold int64) *gorm.DB {
+	return db.Model(&po.Product{}).
+		Joins(fmt.Sprintf("LEFT JOIN %[2]s ON %[2]s.product_id = %[1]s.product_id AND %[2]s.deleted_at IS NULL",
+			conf.ProductTableName, conf.ProductStockThresholdTableName)).
+		Where(fmt.Sprintf("%s.status <> ?", conf.ProductTableName), constant.ProductStatusDelete).
+		Where(fmt.Sprintf("%[1]s.stock - %[1]s.reserved_stock <= COALESCE(%[2]s.threshold, ?)",
+			conf.ProductTableName, conf.ProductStockThresholdTableName), defaultThreshold)
+}
+
+// snapshotStock the available stock of the locked products before they are changed
+func snapshotStock(productPOMap map[int64]*po.Product) map[int64]int64 {
+	ret := make(map[int64]int64, len(productPOMap))
+	for productId, productPO := range productPOMap {
+		ret[productId] = availableStock(productPO, nil)
+	}
+	return ret
+}
+
+func newStockChange(productPO *po.Product, beforeNum int64) *entity.StockChangeEntity {
+	return &entity.StockChangeEntity{
+		ProductId: productPO.ProductId,
+		Name:      productPO.Name,
+		BeforeNum: beforeNum,
+		AfterNum:  availableStock(productPO, nil),
+	}
+}
+
+func newStockChanges(productPOMap map[int64]*po.Product, snapshot map[int64]int64) []*entity.StockChangeEntity {
+	ret := make([]*entity.StockChangeEntity, 0, len(productPOMap))
+	for productId, productPO := range productPOMap {
+		ret = append(ret, newStockChange(productPO, snapshot[productId]))
+	}
+	return ret
+}
+
+// notifyStockChanges hands the committed changes to the listener, e.g. to raise low stock alerts,
+// it must only be called once the transaction is committed
+func notifyStockChanges(ctx context.Context, changes []*entity.StockChangeEntity) {
+	listener := repository.GetRegistry().GetStockChangeListener()
+	if listener == nil || len(changes) == 0 {
+		return
+	}
+	listener(ctx, changes)
+}
@@ -18,8 +18,12 @@ package main
 import (
 	"log"
 	"net"
+	"os"
 
+	"github.com/cloudwego/biz-demo/book-shop/app/item/domain/service"
 	"github.com/cloudwego/biz-demo/book-shop/app/item/infras"
+	"github.com/cloudwego/biz-demo/book-shop/app/item/infras/metadata"
+	"github.com/cloudwego/biz-demo/book-shop/app/item/infras/notifier"
 	item "github.com/cloudwego/biz-demo/book-shop/kitex_gen/cwg/bookshop/item/itemservice"
 	"github.com/cloudwego/biz-demo/book-shop/pkg/conf"
 	"github.com/cloudwego/kitex/pkg/rpcinfo"
@@ -28,10 +32,34 @@ import (
 )
 
 func Init() {
+	if err := service.GetProductStateService().LoadStateMachine(service.DefaultProductStateMachine()); err != nil {
+		panic(err)
+	}
 	infras.Init()
+	if conf.BookMetadataProvider == conf.BookMetadataProviderFile {
+		provider, err := metadata.NewFileProvider(conf.BookMetadataFile)
+		if err != nil {
+			panic(err)
+		}
+		service.GetBookMetadataServiceInstance().SetProvider(provider)
+	}
+	service.GetLowStockServiceInstance().Start(notifier.NewLowStockNotifier())
+	service.NewReservationSweeper().Start()
+	service.NewProductStateScheduler().Start()
+	service.NewProductImportWorker().Start()
+	service.NewProductPriceScheduler().Start()
+	service.NewProductMediaGC().Start()
 }
 
 func main() {
+	// admin sub commands, e.g. `item reindex`
+	if len(os.Args) > 1 {
+		if err := runCommand(os.Args[1], os.Args[2:]); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
 	Init()
 
 	r, err := etcd.NewEtcdRegistry([]string{conf.EtcdAddress})
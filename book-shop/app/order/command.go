@@ -0,0 +1,77 @@
+// Copyright 2023 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/cloudwego/biz-demo/book-shop/app/order/dal/client"
+	"github.com/cloudwego/biz-demo/book-shop/app/order/dal/db"
+	"github.com/cloudwego/biz-demo/book-shop/app/order/module"
+)
+
+// runCommand runs the admin sub commands of the order service
+//
+//	sagas [-limit n]      list the stuck sagas, which keep failing or are unfinished for long
+//	resume-saga <saga_id> run an unfinished saga now instead of waiting for its retry
+func runCommand(name string, args []string) error {
+	ctx := context.Background()
+	switch name {
+	case "sagas":
+		fs := flag.NewFlagSet(name, flag.ExitOnError)
+		limit := fs.Int("limit", 100, "how many sagas to list at most")
+		if err := fs.Parse(args); err != nil {
+			return err
+		}
+		db.Init()
+		sagas, err := module.ListStuckSagas(ctx, *limit)
+		if err != nil {
+			return err
+		}
+		for _, saga := range sagas {
+			logSaga(saga)
+		}
+		log.Printf("%d stuck sagas", len(sagas))
+		return nil
+	case "resume-saga":
+		if len(args) != 1 {
+			return fmt.Errorf("usage: resume-saga <saga_id>")
+		}
+		sagaId, err := strconv.ParseInt(args[0], 10, 64)
+		if err != nil {
+			return err
+		}
+		client.Init()
+		db.Init()
+		saga, err := module.ResumeSaga(ctx, sagaId)
+		if saga != nil {
+			logSaga(saga)
+		}
+		return err
+	default:
+		return fmt.Errorf("unknown command: %s", name)
+	}
+}
+
+func logSaga(saga *db.OrderSaga) {
+	log.Printf("saga_id=%d order_id=%d type=%s status=%d step=%d retries=%d next_retry_at=%s last_error=%q",
+		saga.SagaId, saga.OrderId, saga.Type, saga.Status, saga.CurrentStep, saga.Retries,
+		saga.NextRetryAt.Format("2006-01-02 15:04:05"), saga.LastError)
+}
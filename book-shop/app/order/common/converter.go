@@ -17,47 +17,130 @@ package common
 
 import (
 	"context"
+	"fmt"
+	"time"
 
+	"github.com/bytedance/sonic"
 	"github.com/cloudwego/biz-demo/book-shop/app/order/dal/client"
 	"github.com/cloudwego/biz-demo/book-shop/app/order/dal/db"
+	"github.com/cloudwego/biz-demo/book-shop/kitex_gen/cwg/bookshop/item"
 	"github.com/cloudwego/biz-demo/book-shop/kitex_gen/cwg/bookshop/order"
 	"github.com/cloudwego/biz-demo/book-shop/pkg/utils"
 )
 
-func ConvertCreateReq2PO(ctx context.Context, req *order.CreateOrderReq) (*db.Order, error) {
+// ConvertCreateReq2PO the pending order of the lines, every line is priced and snapshotted with its product as it is now
+func ConvertCreateReq2PO(ctx context.Context, req *order.CreateOrderReq, lines []*order.OrderLineReq) (*db.Order, error) {
 	orderId, err := utils.GenerateID()
 	if err != nil {
 		return nil, err
 	}
-	snapshot, err := client.GetProductSnapshot(ctx, req.GetProductId())
+	productIds := make([]int64, 0, len(lines))
+	for _, line := range lines {
+		productIds = append(productIds, line.ProductId)
+	}
+	products, err := client.GetProducts(ctx, productIds)
 	if err != nil {
 		return nil, err
 	}
 
 	ret := &db.Order{
-		OrderId:         orderId,
-		UserId:          req.UserId,
-		Address:         req.Address,
-		ProductId:       req.ProductId,
-		StockNum:        req.StockNum,
-		ProductSnapshot: snapshot,
-		Status:          int64(order.Status_Finish),
+		OrderId: orderId,
+		UserId:  req.UserId,
+		Address: req.Address,
+		Status:  int64(order.Status_Pending), // waits for the payment once the create saga holds the stock
+		Lines:   make([]*db.OrderLine, 0, len(lines)),
+	}
+	for i, line := range lines {
+		product, ok := products[line.ProductId]
+		if !ok || product.Status != item.Status_Online {
+			return nil, fmt.Errorf("商品 %d 不存在或已下架", line.ProductId)
+		}
+		price, err := LinePrice(product, line.GetSkuId())
+		if err != nil {
+			return nil, err
+		}
+		snapshot, err := sonic.MarshalString(product)
+		if err != nil {
+			return nil, err
+		}
+		ret.Lines = append(ret.Lines, &db.OrderLine{
+			OrderId:         orderId,
+			LineNo:          i,
+			ProductId:       line.ProductId,
+			SkuId:           line.GetSkuId(),
+			StockNum:        line.StockNum,
+			Price:           price,
+			ProductSnapshot: snapshot,
+		})
+		ret.TotalPrice += price * line.StockNum
 	}
 	return ret, nil
 }
 
+// LinePrice the unit price in effect of the sku, or of the product when skuId is 0,
+// which is the promotional price if there is one
+func LinePrice(product *item.Product, skuId int64) (int64, error) {
+	if skuId == 0 {
+		if len(product.Skus) > 0 {
+			return 0, fmt.Errorf("商品 %d 需选择规格", product.ProductId)
+		}
+		if product.PromoPrice != nil {
+			return *product.PromoPrice, nil
+		}
+		return product.Price, nil
+	}
+	sku := FindSku(product, skuId)
+	if sku == nil {
+		return 0, fmt.Errorf("商品 %d 没有规格 %d", product.ProductId, skuId)
+	}
+	if sku.PromoPrice != nil {
+		return *sku.PromoPrice, nil
+	}
+	return sku.Price, nil
+}
+
+func FindSku(product *item.Product, skuId int64) *item.Sku {
+	for _, sku := range product.Skus {
+		if sku.SkuId == skuId {
+			return sku
+		}
+	}
+	return nil
+}
+
+// ConvertPO2DTO the single product fields are filled from the first line
 func ConvertPO2DTO(ctx context.Context, po *db.Order) *order.OrderItem {
 	ret := &order.OrderItem{
-		OrderId:         po.OrderId,
-		UserId:          po.UserId,
-		UserName:        "",
-		Address:         po.Address,
-		ProductId:       po.ProductId,
-		StockNum:        po.StockNum,
-		ProductSnapshot: po.ProductSnapshot,
-		Status:          order.Status(po.Status),
-		CreateTime:      po.CreatedAt.Unix(),
-		UpdateTime:      po.UpdatedAt.Unix(),
+		OrderId:      po.OrderId,
+		UserId:       po.UserId,
+		UserName:     "",
+		Address:      po.Address,
+		Status:       order.Status(po.Status),
+		CreateTime:   po.CreatedAt.Unix(),
+		UpdateTime:   po.UpdatedAt.Unix(),
+		Lines:        make([]*order.OrderLine, 0, len(po.Lines)),
+		TotalPrice:   po.TotalPrice,
+		PayTime:      unixOrZero(po.PaidAt),
+		ShipTime:     unixOrZero(po.ShippedAt),
+		DeliverTime:  unixOrZero(po.DeliveredAt),
+		CompleteTime: unixOrZero(po.CompletedAt),
+		CancelTime:   unixOrZero(po.CancelledAt),
+		RefundTime:   unixOrZero(po.RefundedAt),
+	}
+	for _, line := range po.Lines {
+		ret.Lines = append(ret.Lines, &order.OrderLine{
+			ProductId:       line.ProductId,
+			SkuId:           line.SkuId,
+			StockNum:        line.StockNum,
+			Price:           line.Price,
+			ProductSnapshot: line.ProductSnapshot,
+		})
+	}
+	if len(po.Lines) > 0 {
+		ret.ProductId = po.Lines[0].ProductId
+		ret.StockNum = po.Lines[0].StockNum
+		ret.ProductSnapshot = po.Lines[0].ProductSnapshot
+		ret.Price = po.Lines[0].Price
 	}
 	userName, err := client.GetUserName(ctx, po.UserId)
 	if err == nil {
@@ -66,3 +149,10 @@ func ConvertPO2DTO(ctx context.Context, po *db.Order) *order.OrderItem {
 
 	return ret
 }
+
+func unixOrZero(t *time.Time) int64 {
+	if t == nil {
+		return 0
+	}
+	return t.Unix()
+}
@@ -17,10 +17,8 @@ package client
 
 import (
 	"context"
-	"errors"
 	"time"
 
-	"github.com/bytedance/sonic"
 	"github.com/cloudwego/biz-demo/book-shop/kitex_gen/cwg/bookshop/item"
 	"github.com/cloudwego/biz-demo/book-shop/kitex_gen/cwg/bookshop/item/itemservice"
 	"github.com/cloudwego/biz-demo/book-shop/pkg/conf"
@@ -51,10 +49,14 @@ func initItemRpc() {
 	itemClient = c
 }
 
-func DecreaseStock(ctx context.Context, productId, stockNum int64) error {
+func DecreaseStock(ctx context.Context, productId, skuId, stockNum int64, idempotencyKey string) error {
 	req := &item.DecrStockReq{
-		ProductId: productId,
-		StockNum:  stockNum,
+		ProductId:      productId,
+		StockNum:       stockNum,
+		IdempotencyKey: &idempotencyKey,
+	}
+	if skuId != 0 {
+		req.SkuId = &skuId
 	}
 	resp, err := itemClient.DecrStock(ctx, req)
 	if err != nil {
@@ -66,10 +68,14 @@ func DecreaseStock(ctx context.Context, productId, stockNum int64) error {
 	return nil
 }
 
-func DecreaseStockRevert(ctx context.Context, productId, stockNum int64) error {
+func DecreaseStockRevert(ctx context.Context, productId, skuId, stockNum int64, idempotencyKey string) error {
 	req := &item.DecrStockReq{
-		ProductId: productId,
-		StockNum:  stockNum,
+		ProductId:      productId,
+		StockNum:       stockNum,
+		IdempotencyKey: &idempotencyKey,
+	}
+	if skuId != 0 {
+		req.SkuId = &skuId
 	}
 	resp, err := itemClient.DecrStockRevert(ctx, req)
 	if err != nil {
@@ -81,21 +87,59 @@ func DecreaseStockRevert(ctx context.Context, productId, stockNum int64) error {
 	return nil
 }
 
-func GetProductSnapshot(ctx context.Context, productId int64) (string, error) {
-	req := &item.MGet2CReq{ProductIds: []int64{productId}}
-	resp, err := itemClient.MGet2C(ctx, req)
+// ReserveStock holds stock for the order, the hold is released by the item service
+// if it is not confirmed in time. skuId is 0 for a product without skus.
+func ReserveStock(ctx context.Context, productId, skuId, stockNum int64) (int64, error) {
+	req := &item.ReserveStockReq{
+		ProductId: productId,
+		StockNum:  stockNum,
+	}
+	if skuId != 0 {
+		req.SkuId = &skuId
+	}
+	resp, err := itemClient.ReserveStock(ctx, req)
 	if err != nil {
-		return "", err
+		return 0, err
 	}
 	if resp.BaseResp.StatusCode != 0 {
-		return "", errno.NewErrNo(int64(resp.BaseResp.StatusCode), resp.BaseResp.StatusMessage)
+		return 0, errno.NewErrNo(int64(resp.BaseResp.StatusCode), resp.BaseResp.StatusMessage)
 	}
-	if _, ok := resp.ProductMap[productId]; !ok {
-		return "", errors.New("该商品不存在")
+	return resp.ReservationId, nil
+}
+
+func ConfirmReservation(ctx context.Context, reservationId int64) error {
+	req := &item.ConfirmReservationReq{ReservationId: reservationId}
+	resp, err := itemClient.ConfirmReservation(ctx, req)
+	if err != nil {
+		return err
 	}
-	productStr, err := sonic.MarshalString(resp.ProductMap[productId])
+	if resp.BaseResp.StatusCode != 0 {
+		return errno.NewErrNo(int64(resp.BaseResp.StatusCode), resp.BaseResp.StatusMessage)
+	}
+	return nil
+}
+
+func ReleaseReservation(ctx context.Context, reservationId int64) error {
+	req := &item.ReleaseReservationReq{ReservationId: reservationId}
+	resp, err := itemClient.ReleaseReservation(ctx, req)
+	if err != nil {
+		return err
+	}
+	if resp.BaseResp.StatusCode != 0 {
+		return errno.NewErrNo(int64(resp.BaseResp.StatusCode), resp.BaseResp.StatusMessage)
+	}
+	return nil
+}
+
+// GetProducts the products found, the missing ones are left out of the map
+func GetProducts(ctx context.Context, productIds []int64) (map[int64]*item.Product, error) {
+	req := &item.MGet2CReq{ProductIds: productIds}
+	resp, err := itemClient.MGet2C(ctx, req)
 	if err != nil {
-		return "", err
+		return nil, err
+	}
+	if resp.BaseResp.StatusCode != 0 {
+		return nil, errno.NewErrNo(int64(resp.BaseResp.StatusCode), resp.BaseResp.StatusMessage)
 	}
-	return productStr, nil
+	return resp.ProductMap, nil
 }
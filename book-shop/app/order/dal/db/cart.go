@@ -0,0 +1,103 @@
+// Copyright 2023 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/cloudwego/biz-demo/book-shop/pkg/conf"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var ErrCartItemNotFound = errors.New("购物车中没有该商品")
+
+// CartItem a book in the cart of a user, skuId is 0 for a product without skus.
+// The lines are deleted for good, so that the book can be added again.
+type CartItem struct {
+	gorm.Model
+	UserId    int64 `json:"user_id"`
+	ProductId int64 `json:"product_id"`
+	SkuId     int64 `json:"sku_id"`
+	StockNum  int64 `json:"stock_num"`
+}
+
+func (c *CartItem) TableName() string {
+	return conf.CartItemTableName
+}
+
+// AddCartItem adds the stock num to the line of the book, a new line is added when there is none.
+// The user can have maxLines lines and maxStockNum of a book at most.
+func AddCartItem(ctx context.Context, item *CartItem, maxLines int, maxStockNum int64) error {
+	return DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		existing := make([]*CartItem, 0)
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("user_id = ? AND product_id = ? AND sku_id = ?", item.UserId, item.ProductId, item.SkuId).
+			Find(&existing).Error; err != nil {
+			return err
+		}
+		if len(existing) > 0 {
+			stockNum := existing[0].StockNum + item.StockNum
+			if stockNum > maxStockNum {
+				return fmt.Errorf("购物车中每种商品最多 %d 件", maxStockNum)
+			}
+			return tx.Model(&CartItem{}).Where("id = ?", existing[0].ID).Update("stock_num", stockNum).Error
+		}
+		var count int64
+		if err := tx.Model(&CartItem{}).Where("user_id = ?", item.UserId).Count(&count).Error; err != nil {
+			return err
+		}
+		if count >= int64(maxLines) {
+			return fmt.Errorf("购物车最多 %d 种商品", maxLines)
+		}
+		return tx.Create(item).Error
+	})
+}
+
+func UpdateCartItem(ctx context.Context, userId, productId, skuId, stockNum int64) error {
+	result := DB.WithContext(ctx).Model(&CartItem{}).
+		Where("user_id = ? AND product_id = ? AND sku_id = ?", userId, productId, skuId).
+		Update("stock_num", stockNum)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrCartItemNotFound
+	}
+	return nil
+}
+
+// RemoveCartItems removing a line which is not in the cart is not an error
+func RemoveCartItems(ctx context.Context, userId int64, items []*CartItem) error {
+	if len(items) == 0 {
+		return nil
+	}
+	db := DB.WithContext(ctx).Unscoped().Where("user_id = ?", userId)
+	conditions := DB.Where("product_id = ? AND sku_id = ?", items[0].ProductId, items[0].SkuId)
+	for _, item := range items[1:] {
+		conditions = conditions.Or("product_id = ? AND sku_id = ?", item.ProductId, item.SkuId)
+	}
+	return db.Where(conditions).Delete(&CartItem{}).Error
+}
+
+// ListCartItems the lines in the order they were added
+func ListCartItems(ctx context.Context, userId int64) ([]*CartItem, error) {
+	res := make([]*CartItem, 0)
+	err := DB.WithContext(ctx).Where("user_id = ?", userId).Order("id").Find(&res).Error
+	return res, err
+}
@@ -0,0 +1,100 @@
+// Copyright 2023 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/cloudwego/biz-demo/book-shop/pkg/conf"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	// DelayedTaskTypeCancelUnpaid cancels the order if it is still waiting for the payment
+	DelayedTaskTypeCancelUnpaid = "cancel_unpaid"
+)
+
+const (
+	DelayedTaskStatusPending int64 = 0
+	DelayedTaskStatusDone    int64 = 1
+)
+
+// DelayedTask a persisted timer of an order, fired at least once after ExecuteAt
+type DelayedTask struct {
+	gorm.Model
+	TaskId    int64     `json:"task_id"`
+	Type      string    `json:"type"`
+	OrderId   int64     `json:"order_id"`
+	ExecuteAt time.Time `json:"execute_at"` // due then, pushed back while the task is being fired and after a failure
+	Status    int64     `json:"status"`
+	Attempts  int       `json:"attempts"`
+	LastError string    `json:"last_error"`
+}
+
+func (t *DelayedTask) TableName() string {
+	return conf.OrderDelayedTaskTableName
+}
+
+// ClaimDueTasks takes the due tasks for lease. The rows are locked while they are pushed back, the ones
+// locked by another instance are skipped, so that a task is fired by one instance at a time.
+// A task whose firing crashed is due again when the lease is over.
+func ClaimDueTasks(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*DelayedTask, error) {
+	res := make([]*DelayedTask, 0)
+	err := DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("status = ? AND execute_at <= ?", DelayedTaskStatusPending, now).
+			Order("execute_at").Limit(limit).Find(&res).Error; err != nil {
+			return err
+		}
+		if len(res) == 0 {
+			return nil
+		}
+		taskIds := make([]int64, 0, len(res))
+		for _, task := range res {
+			task.Attempts++
+			taskIds = append(taskIds, task.TaskId)
+		}
+		return tx.Model(&DelayedTask{}).Where("task_id IN ?", taskIds).
+			Updates(map[string]interface{}{
+				"execute_at": now.Add(lease),
+				"attempts":   gorm.Expr("attempts + 1"),
+			}).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func FinishTask(ctx context.Context, taskId int64) error {
+	return DB.WithContext(ctx).Model(&DelayedTask{}).Where("task_id = ?", taskId).
+		Update("status", DelayedTaskStatusDone).Error
+}
+
+// RetryTaskAt the failed task is due again at executeAt
+func RetryTaskAt(ctx context.Context, taskId int64, executeAt time.Time, errMsg string) error {
+	if len(errMsg) > 255 {
+		errMsg = errMsg[:255]
+	}
+	return DB.WithContext(ctx).Model(&DelayedTask{}).
+		Where("task_id = ? AND status = ?", taskId, DelayedTaskStatusPending).
+		Updates(map[string]interface{}{
+			"execute_at": executeAt,
+			"last_error": errMsg,
+		}).Error
+}
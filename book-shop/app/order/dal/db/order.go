@@ -18,33 +18,136 @@ package db
 import (
 	"context"
 	"errors"
+	"time"
 
+	"github.com/cloudwego/biz-demo/book-shop/kitex_gen/cwg/bookshop/order"
 	"github.com/cloudwego/biz-demo/book-shop/pkg/conf"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type Order struct {
+	gorm.Model
+	OrderId    int64  `json:"order_id"`
+	UserId     int64  `json:"user_id"`
+	Address    string `json:"address"`
+	TotalPrice int64  `json:"total_price"` // sum of the line prices
+	Status     int64  `json:"status"`
+	// when the order got into the status, nil until it does
+	PaidAt      *time.Time `json:"paid_at"`
+	ShippedAt   *time.Time `json:"shipped_at"`
+	DeliveredAt *time.Time `json:"delivered_at"`
+	CompletedAt *time.Time `json:"completed_at"`
+	CancelledAt *time.Time `json:"cancelled_at"`
+	RefundedAt  *time.Time `json:"refunded_at"`
+	// RequestToken the idempotency token of the create request, unique per user, nil when none was given
+	RequestToken *string      `json:"request_token"`
+	Lines        []*OrderLine `json:"-" gorm:"-"` // loaded with the order
+}
+
+func (o *Order) TableName() string {
+	return conf.OrderTableName
+}
+
+// OrderLine a book of the order, with the product as it was when the order was created
+type OrderLine struct {
 	gorm.Model
 	OrderId         int64  `json:"order_id"`
-	UserId          int64  `json:"user_id"`
-	Address         string `json:"address"`
+	LineNo          int    `json:"line_no"`
 	ProductId       int64  `json:"product_id"`
+	SkuId           int64  `json:"sku_id"`
 	StockNum        int64  `json:"stock_num"`
+	Price           int64  `json:"price"` // unit price in effect when the order is created
 	ProductSnapshot string `json:"product_snapshot"`
-	Status          int64  `json:"status"`
 }
 
-func (o *Order) TableName() string {
-	return conf.OrderTableName
+func (l *OrderLine) TableName() string {
+	return conf.OrderLineTableName
+}
+
+var ErrOrderStatus = errors.New("订单当前状态不允许该操作")
+
+// statusTimeColumns the column recording when the order got into the status
+var statusTimeColumns = map[int64]string{
+	int64(order.Status_Paid):      "paid_at",
+	int64(order.Status_Shipped):   "shipped_at",
+	int64(order.Status_Delivered): "delivered_at",
+	int64(order.Status_Completed): "completed_at",
+	int64(order.Status_Cancelled): "cancelled_at",
+	int64(order.Status_Refunded):  "refunded_at",
+}
+
+func statusUpdates(to int64) map[string]interface{} {
+	updateMap := map[string]interface{}{
+		"status": to,
+	}
+	if column, ok := statusTimeColumns[to]; ok {
+		updateMap[column] = time.Now()
+	}
+	return updateMap
+}
+
+// TransitOrder moves the order from one of the from statuses to to, an order already in to is left as it is.
+// It is refused while a cancel or refund saga of the order is giving the stock back.
+func TransitOrder(ctx context.Context, orderId int64, from []int64, to int64) error {
+	return DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		o, err := lockOrder(tx, orderId)
+		if err != nil {
+			return err
+		}
+		if o.Status == to {
+			return nil
+		}
+		if !containsStatus(from, o.Status) {
+			return ErrOrderStatus
+		}
+		if err := checkNoStockReturnSaga(tx, orderId); err != nil {
+			return err
+		}
+		return tx.Model(&Order{}).Where("order_id = ?", orderId).Updates(statusUpdates(to)).Error
+	})
+}
+
+// SetOrderStatus the transition made by a saga step, which already holds the order through its saga.
+// A step run again after a crash finds the order in to and succeeds.
+func SetOrderStatus(ctx context.Context, orderId int64, from []int64, to int64) error {
+	result := DB.WithContext(ctx).Model(&Order{}).Where("order_id = ? AND status IN ?", orderId, from).
+		Updates(statusUpdates(to))
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected > 0 {
+		return nil
+	}
+	o, err := GetOrderById(ctx, orderId)
+	if err != nil {
+		return err
+	}
+	if o.Status != to {
+		return ErrOrderStatus
+	}
+	return nil
 }
 
-func CreateOrder(ctx context.Context, orders []*Order) error {
-	return DB.WithContext(ctx).Create(orders).Error
+func lockOrder(tx *gorm.DB, orderId int64) (*Order, error) {
+	orders := make([]*Order, 0)
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("order_id = ?", orderId).
+		Find(&orders).Error; err != nil {
+		return nil, err
+	}
+	if len(orders) == 0 {
+		return nil, errors.New("不存在该订单")
+	}
+	return orders[0], nil
 }
 
-func UpdateOrder(ctx context.Context, orderId int64, updateMap map[string]interface{}) error {
-	return DB.WithContext(ctx).Model(&Order{}).Where("order_id = ?", orderId).
-		Updates(updateMap).Error
+func containsStatus(statuses []int64, status int64) bool {
+	for _, s := range statuses {
+		if s == status {
+			return true
+		}
+	}
+	return false
 }
 
 func ListOrders(ctx context.Context, filterMap map[string]interface{}) ([]*Order, error) {
@@ -57,6 +160,9 @@ func ListOrders(ctx context.Context, filterMap map[string]interface{}) ([]*Order
 	if err != nil {
 		return nil, err
 	}
+	if err := loadOrderLines(DB.WithContext(ctx), res); err != nil {
+		return nil, err
+	}
 	return res, nil
 }
 
@@ -69,5 +175,45 @@ func GetOrderById(ctx context.Context, orderId int64) (*Order, error) {
 	if len(res) == 0 {
 		return nil, errors.New("不存在该订单")
 	}
+	if err := loadOrderLines(DB.WithContext(ctx), res); err != nil {
+		return nil, err
+	}
+	return res[0], nil
+}
+
+// GetOrderByToken the order created by the request with the token, nil when there is none
+func GetOrderByToken(ctx context.Context, userId int64, token string) (*Order, error) {
+	res := make([]*Order, 0)
+	if err := DB.WithContext(ctx).Where("user_id = ? AND request_token = ?", userId, token).
+		Find(&res).Error; err != nil {
+		return nil, err
+	}
+	if len(res) == 0 {
+		return nil, nil
+	}
 	return res[0], nil
 }
+
+// loadOrderLines attaches the lines to the orders in line order
+func loadOrderLines(db *gorm.DB, orders []*Order) error {
+	if len(orders) == 0 {
+		return nil
+	}
+	orderMap := make(map[int64]*Order, len(orders))
+	orderIds := make([]int64, 0, len(orders))
+	for _, o := range orders {
+		o.Lines = make([]*OrderLine, 0)
+		orderMap[o.OrderId] = o
+		orderIds = append(orderIds, o.OrderId)
+	}
+	lines := make([]*OrderLine, 0)
+	if err := db.Where("order_id IN ?", orderIds).Order("order_id, line_no").Find(&lines).Error; err != nil {
+		return err
+	}
+	for _, line := range lines {
+		if o, ok := orderMap[line.OrderId]; ok {
+			o.Lines = append(o.Lines, line)
+		}
+	}
+	return nil
+}
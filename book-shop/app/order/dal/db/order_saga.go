@@ -0,0 +1,209 @@
+// Copyright 2023 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package db
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/cloudwego/biz-demo/book-shop/kitex_gen/cwg/bookshop/order"
+	"github.com/cloudwego/biz-demo/book-shop/pkg/conf"
+	"gorm.io/gorm"
+)
+
+const (
+	SagaTypeCreate = "create"
+	SagaTypeCancel = "cancel"
+	SagaTypeRefund = "refund"
+)
+
+const (
+	SagaStatusRunning      int64 = 0 // the steps are running forward
+	SagaStatusCompensating int64 = 1 // a step failed, the done steps are being undone
+	SagaStatusSucceeded    int64 = 2
+	SagaStatusCompensated  int64 = 3
+)
+
+var (
+	ErrOrderCancelled  = errors.New("订单已取消")
+	ErrOrderCancelling = errors.New("订单正在取消")
+	ErrOrderRefunded   = errors.New("订单已退款")
+	ErrOrderRefunding  = errors.New("订单正在退款")
+	ErrOrderCreating   = errors.New("订单正在创建, 暂不可操作")
+	// ErrSagaTakenOver the lease of the run was over and the saga was claimed by another run
+	ErrSagaTakenOver = errors.New("saga 已被其他实例接管")
+)
+
+// stockReturnSagaTypes the sagas giving the stock of the order back, an order has a single one at a time
+var stockReturnSagaTypes = []string{SagaTypeCancel, SagaTypeRefund}
+
+// OrderSaga the persisted state of a create or cancel, so that it can be resumed after a crash
+type OrderSaga struct {
+	gorm.Model
+	SagaId      int64     `json:"saga_id"`
+	OrderId     int64     `json:"order_id"`
+	Type        string    `json:"type"`
+	Status      int64     `json:"status"`
+	CurrentStep int       `json:"current_step"` // the step to run, or to undo when compensating
+	Retries     int       `json:"retries"`      // failed attempts of the current step
+	NextRetryAt time.Time `json:"next_retry_at"`
+	LockedUntil time.Time `json:"locked_until"` // the saga is being run by someone until then
+	ClaimToken  int64     `json:"claim_token"`  // the run holding the saga, the saves of any other run are refused
+	LastError   string    `json:"last_error"`
+	Payload     string    `json:"payload"` // json of the data shared by the steps
+}
+
+func (s *OrderSaga) TableName() string {
+	return conf.OrderSagaTableName
+}
+
+// CreateOrderWithSaga the pending order with its lines, the saga completing it and its timers are saved together
+func CreateOrderWithSaga(ctx context.Context, po *Order, saga *OrderSaga, tasks ...*DelayedTask) error {
+	return DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(po).Error; err != nil {
+			return err
+		}
+		if err := tx.Create(po.Lines).Error; err != nil {
+			return err
+		}
+		if len(tasks) > 0 {
+			if err := tx.Create(tasks).Error; err != nil {
+				return err
+			}
+		}
+		return tx.Create(saga).Error
+	})
+}
+
+// CreateStockReturnSaga starts the cancel or refund saga of an order in the from status. The order row is locked,
+// so that an order gets a single saga giving its stock back at a time.
+func CreateStockReturnSaga(ctx context.Context, saga *OrderSaga, from int64) error {
+	return DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		o, err := lockOrder(tx, saga.OrderId)
+		if err != nil {
+			return err
+		}
+		switch {
+		case o.Status == int64(order.Status_Cancelled) && saga.Type == SagaTypeCancel:
+			return ErrOrderCancelled
+		case o.Status == int64(order.Status_Refunded) && saga.Type == SagaTypeRefund:
+			return ErrOrderRefunded
+		case o.Status == int64(order.Status_Pending):
+			return ErrOrderCreating
+		case o.Status != from:
+			return ErrOrderStatus
+		}
+		if err := checkNoStockReturnSaga(tx, saga.OrderId); err != nil {
+			return err
+		}
+		return tx.Create(saga).Error
+	})
+}
+
+func checkNoStockReturnSaga(tx *gorm.DB, orderId int64) error {
+	sagas := make([]*OrderSaga, 0)
+	if err := tx.Where("order_id = ? AND type IN ? AND status IN ?", orderId, stockReturnSagaTypes, unfinishedSagaStatus).
+		Limit(1).Find(&sagas).Error; err != nil {
+		return err
+	}
+	if len(sagas) == 0 {
+		return nil
+	}
+	if sagas[0].Type == SagaTypeRefund {
+		return ErrOrderRefunding
+	}
+	return ErrOrderCancelling
+}
+
+var unfinishedSagaStatus = []int64{SagaStatusRunning, SagaStatusCompensating}
+
+// SaveSaga saves the progress of the saga, ErrSagaTakenOver when saga.ClaimToken no longer holds it
+func SaveSaga(ctx context.Context, saga *OrderSaga) error {
+	result := DB.WithContext(ctx).Model(&OrderSaga{}).Where("saga_id = ? AND claim_token = ?", saga.SagaId, saga.ClaimToken).
+		Updates(map[string]interface{}{
+			"status":        saga.Status,
+			"current_step":  saga.CurrentStep,
+			"retries":       saga.Retries,
+			"next_retry_at": saga.NextRetryAt,
+			"locked_until":  saga.LockedUntil,
+			"last_error":    saga.LastError,
+			"payload":       saga.Payload,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrSagaTakenOver
+	}
+	return nil
+}
+
+// ClaimSaga takes the saga over with claimToken until lockedUntil, false when someone else is running it
+func ClaimSaga(ctx context.Context, sagaId, claimToken int64, now, lockedUntil time.Time) (bool, error) {
+	result := DB.WithContext(ctx).Model(&OrderSaga{}).
+		Where("saga_id = ? AND status IN ? AND locked_until <= ?", sagaId, unfinishedSagaStatus, now).
+		Updates(map[string]interface{}{
+			"locked_until": lockedUntil,
+			"claim_token":  claimToken,
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+func GetSagaById(ctx context.Context, sagaId int64) (*OrderSaga, error) {
+	res := make([]*OrderSaga, 0)
+	if err := DB.WithContext(ctx).Where("saga_id = ?", sagaId).Find(&res).Error; err != nil {
+		return nil, err
+	}
+	if len(res) == 0 {
+		return nil, errors.New("不存在该 saga")
+	}
+	return res[0], nil
+}
+
+// GetOrderSaga the latest saga of the type of the order
+func GetOrderSaga(ctx context.Context, orderId int64, sagaType string) (*OrderSaga, error) {
+	res := make([]*OrderSaga, 0)
+	if err := DB.WithContext(ctx).Where("order_id = ? AND type = ?", orderId, sagaType).
+		Order("id DESC").Limit(1).Find(&res).Error; err != nil {
+		return nil, err
+	}
+	if len(res) == 0 {
+		return nil, errors.New("不存在该 saga")
+	}
+	return res[0], nil
+}
+
+// ListDueSagas the unfinished sagas whose retry is due and which nobody is running
+func ListDueSagas(ctx context.Context, now time.Time, limit int) ([]*OrderSaga, error) {
+	res := make([]*OrderSaga, 0)
+	err := DB.WithContext(ctx).
+		Where("status IN ? AND next_retry_at <= ? AND locked_until <= ?", unfinishedSagaStatus, now, now).
+		Order("next_retry_at").Limit(limit).Find(&res).Error
+	return res, err
+}
+
+// ListStuckSagas the unfinished sagas which failed minRetries times in a row or were started before startedBefore
+func ListStuckSagas(ctx context.Context, minRetries int, startedBefore time.Time, limit int) ([]*OrderSaga, error) {
+	res := make([]*OrderSaga, 0)
+	err := DB.WithContext(ctx).
+		Where("status IN ? AND (retries >= ? OR created_at <= ?)", unfinishedSagaStatus, minRetries, startedBefore).
+		Order("created_at").Limit(limit).Find(&res).Error
+	return res, err
+}
@@ -31,11 +31,12 @@ type OrderServiceImpl struct{}
 func (s *OrderServiceImpl) CreateOrder(ctx context.Context, req *order.CreateOrderReq) (resp *order.CreateOrderResp, err error) {
 	resp = order.NewCreateOrderResp()
 	updateModule := module.NewUpdateModule(ctx)
-	err = updateModule.CreateOrder(req)
+	orderId, err := updateModule.CreateOrder(req)
 	if err != nil {
 		resp.BaseResp = errno.BuildBaseResp(err)
 		return resp, nil
 	}
+	resp.OrderId = orderId
 	resp.BaseResp = errno.BuildBaseResp(errno.Success)
 	return resp, nil
 }
@@ -53,6 +54,84 @@ func (s *OrderServiceImpl) CancelOrder(ctx context.Context, req *order.CancelOrd
 	return resp, nil
 }
 
+// PayOrder implements the OrderServiceImpl interface.
+func (s *OrderServiceImpl) PayOrder(ctx context.Context, req *order.PayOrderReq) (resp *order.PayOrderResp, err error) {
+	resp = order.NewPayOrderResp()
+	updateModule := module.NewUpdateModule(ctx)
+	err = updateModule.PayOrder(req)
+	if err != nil {
+		resp.BaseResp = errno.BuildBaseResp(err)
+		return resp, nil
+	}
+	resp.BaseResp = errno.BuildBaseResp(errno.Success)
+	return resp, nil
+}
+
+// ShipOrder implements the OrderServiceImpl interface.
+func (s *OrderServiceImpl) ShipOrder(ctx context.Context, req *order.ShipOrderReq) (resp *order.ShipOrderResp, err error) {
+	resp = order.NewShipOrderResp()
+	updateModule := module.NewUpdateModule(ctx)
+	err = updateModule.ShipOrder(req)
+	if err != nil {
+		resp.BaseResp = errno.BuildBaseResp(err)
+		return resp, nil
+	}
+	resp.BaseResp = errno.BuildBaseResp(errno.Success)
+	return resp, nil
+}
+
+// DeliverOrder implements the OrderServiceImpl interface.
+func (s *OrderServiceImpl) DeliverOrder(ctx context.Context, req *order.DeliverOrderReq) (resp *order.DeliverOrderResp, err error) {
+	resp = order.NewDeliverOrderResp()
+	updateModule := module.NewUpdateModule(ctx)
+	err = updateModule.DeliverOrder(req)
+	if err != nil {
+		resp.BaseResp = errno.BuildBaseResp(err)
+		return resp, nil
+	}
+	resp.BaseResp = errno.BuildBaseResp(errno.Success)
+	return resp, nil
+}
+
+// ConfirmReceipt implements the OrderServiceImpl interface.
+func (s *OrderServiceImpl) ConfirmReceipt(ctx context.Context, req *order.ConfirmReceiptReq) (resp *order.ConfirmReceiptResp, err error) {
+	resp = order.NewConfirmReceiptResp()
+	updateModule := module.NewUpdateModule(ctx)
+	err = updateModule.ConfirmReceipt(req)
+	if err != nil {
+		resp.BaseResp = errno.BuildBaseResp(err)
+		return resp, nil
+	}
+	resp.BaseResp = errno.BuildBaseResp(errno.Success)
+	return resp, nil
+}
+
+// RefundOrder implements the OrderServiceImpl interface.
+func (s *OrderServiceImpl) RefundOrder(ctx context.Context, req *order.RefundOrderReq) (resp *order.RefundOrderResp, err error) {
+	resp = order.NewRefundOrderResp()
+	updateModule := module.NewUpdateModule(ctx)
+	err = updateModule.RefundOrder(req)
+	if err != nil {
+		resp.BaseResp = errno.BuildBaseResp(err)
+		return resp, nil
+	}
+	resp.BaseResp = errno.BuildBaseResp(errno.Success)
+	return resp, nil
+}
+
+// ShopRefundOrder implements the OrderServiceImpl interface.
+func (s *OrderServiceImpl) ShopRefundOrder(ctx context.Context, req *order.ShopRefundOrderReq) (resp *order.ShopRefundOrderResp, err error) {
+	resp = order.NewShopRefundOrderResp()
+	updateModule := module.NewUpdateModule(ctx)
+	err = updateModule.ShopRefundOrder(req)
+	if err != nil {
+		resp.BaseResp = errno.BuildBaseResp(err)
+		return resp, nil
+	}
+	resp.BaseResp = errno.BuildBaseResp(errno.Success)
+	return resp, nil
+}
+
 // ListOrder implements the OrderServiceImpl interface.
 func (s *OrderServiceImpl) ListOrder(ctx context.Context, req *order.ListOrderReq) (resp *order.ListOrderResp, err error) {
 	resp = order.NewListOrderResp()
@@ -75,7 +154,7 @@ func (s *OrderServiceImpl) ListOrder(ctx context.Context, req *order.ListOrderRe
 func (s *OrderServiceImpl) GetOrderById(ctx context.Context, req *order.GetOrderByIdReq) (resp *order.GetOrderByIdResp, err error) {
 	resp = order.NewGetOrderByIdResp()
 	queryModule := module.NewQueryModule(ctx)
-	po, err := queryModule.GetOrderById(req.OrderId)
+	po, err := queryModule.GetOrderById(req.OrderId, req.UserId)
 	if err != nil {
 		resp.BaseResp = errno.BuildBaseResp(err)
 		return resp, nil
@@ -84,3 +163,57 @@ func (s *OrderServiceImpl) GetOrderById(ctx context.Context, req *order.GetOrder
 	resp.BaseResp = errno.BuildBaseResp(errno.Success)
 	return resp, nil
 }
+
+// AddCartItem implements the OrderServiceImpl interface.
+func (s *OrderServiceImpl) AddCartItem(ctx context.Context, req *order.AddCartItemReq) (resp *order.AddCartItemResp, err error) {
+	resp = order.NewAddCartItemResp()
+	cartModule := module.NewCartModule(ctx)
+	err = cartModule.AddItem(req)
+	if err != nil {
+		resp.BaseResp = errno.BuildBaseResp(err)
+		return resp, nil
+	}
+	resp.BaseResp = errno.BuildBaseResp(errno.Success)
+	return resp, nil
+}
+
+// UpdateCartItem implements the OrderServiceImpl interface.
+func (s *OrderServiceImpl) UpdateCartItem(ctx context.Context, req *order.UpdateCartItemReq) (resp *order.UpdateCartItemResp, err error) {
+	resp = order.NewUpdateCartItemResp()
+	cartModule := module.NewCartModule(ctx)
+	err = cartModule.UpdateItem(req)
+	if err != nil {
+		resp.BaseResp = errno.BuildBaseResp(err)
+		return resp, nil
+	}
+	resp.BaseResp = errno.BuildBaseResp(errno.Success)
+	return resp, nil
+}
+
+// RemoveCartItem implements the OrderServiceImpl interface.
+func (s *OrderServiceImpl) RemoveCartItem(ctx context.Context, req *order.RemoveCartItemReq) (resp *order.RemoveCartItemResp, err error) {
+	resp = order.NewRemoveCartItemResp()
+	cartModule := module.NewCartModule(ctx)
+	err = cartModule.RemoveItem(req)
+	if err != nil {
+		resp.BaseResp = errno.BuildBaseResp(err)
+		return resp, nil
+	}
+	resp.BaseResp = errno.BuildBaseResp(errno.Success)
+	return resp, nil
+}
+
+// GetCart implements the OrderServiceImpl interface.
+func (s *OrderServiceImpl) GetCart(ctx context.Context, req *order.GetCartReq) (resp *order.GetCartResp, err error) {
+	resp = order.NewGetCartResp()
+	cartModule := module.NewCartModule(ctx)
+	items, totalPrice, err := cartModule.GetCart(req.UserId)
+	if err != nil {
+		resp.BaseResp = errno.BuildBaseResp(err)
+		return resp, nil
+	}
+	resp.Items = items
+	resp.TotalPrice = totalPrice
+	resp.BaseResp = errno.BuildBaseResp(errno.Success)
+	return resp, nil
+}
@@ -16,10 +16,13 @@
 package main
 
 import (
+	"log"
 	"net"
+	"os"
 
 	"github.com/cloudwego/biz-demo/book-shop/app/order/dal/client"
 	"github.com/cloudwego/biz-demo/book-shop/app/order/dal/db"
+	"github.com/cloudwego/biz-demo/book-shop/app/order/module"
 	order "github.com/cloudwego/biz-demo/book-shop/kitex_gen/cwg/bookshop/order/orderservice"
 	"github.com/cloudwego/biz-demo/book-shop/pkg/conf"
 	"github.com/cloudwego/kitex/pkg/klog"
@@ -31,9 +34,19 @@ import (
 func Init() {
 	client.Init()
 	db.Init()
+	module.NewSagaRecoverer().Start()
+	module.NewDelayedTaskRunner().Start()
 }
 
 func main() {
+	// admin sub commands, e.g. `order sagas`
+	if len(os.Args) > 1 {
+		if err := runCommand(os.Args[1], os.Args[2:]); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
 	Init()
 	r, err := etcd.NewEtcdRegistry([]string{conf.EtcdAddress})
 	if err != nil {
@@ -0,0 +1,125 @@
+// Copyright 2023 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package module
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/cloudwego/biz-demo/book-shop/app/order/common"
+	"github.com/cloudwego/biz-demo/book-shop/app/order/dal/client"
+	"github.com/cloudwego/biz-demo/book-shop/app/order/dal/db"
+	"github.com/cloudwego/biz-demo/book-shop/kitex_gen/cwg/bookshop/item"
+	"github.com/cloudwego/biz-demo/book-shop/kitex_gen/cwg/bookshop/order"
+)
+
+const (
+	// CartMaxLines the max lines of a cart, which is also the max lines of an order
+	CartMaxLines = 50
+	// CartMaxStockNum the max stock num of a line of the cart
+	CartMaxStockNum = 99
+	OrderMaxLines   = CartMaxLines
+)
+
+type CartModule struct {
+	ctx context.Context
+}
+
+func NewCartModule(ctx context.Context) CartModule {
+	return CartModule{
+		ctx: ctx,
+	}
+}
+
+// AddItem adds to the stock num already in the cart, only an online product can be added
+func (m CartModule) AddItem(req *order.AddCartItemReq) error {
+	if req.StockNum <= 0 {
+		return errors.New("商品数量必须大于 0")
+	}
+	products, err := client.GetProducts(m.ctx, []int64{req.ProductId})
+	if err != nil {
+		return err
+	}
+	product, ok := products[req.ProductId]
+	if !ok || product.Status != item.Status_Online {
+		return errors.New("商品不存在或已下架")
+	}
+	// check the sku
+	if _, err := common.LinePrice(product, req.GetSkuId()); err != nil {
+		return err
+	}
+	return db.AddCartItem(m.ctx, &db.CartItem{
+		UserId:    req.UserId,
+		ProductId: req.ProductId,
+		SkuId:     req.GetSkuId(),
+		StockNum:  req.StockNum,
+	}, CartMaxLines, CartMaxStockNum)
+}
+
+func (m CartModule) UpdateItem(req *order.UpdateCartItemReq) error {
+	if req.StockNum <= 0 || req.StockNum > CartMaxStockNum {
+		return fmt.Errorf("商品数量必须在 1 到 %d 之间", CartMaxStockNum)
+	}
+	return db.UpdateCartItem(m.ctx, req.UserId, req.ProductId, req.GetSkuId(), req.StockNum)
+}
+
+func (m CartModule) RemoveItem(req *order.RemoveCartItemReq) error {
+	return db.RemoveCartItems(m.ctx, req.UserId, []*db.CartItem{{ProductId: req.ProductId, SkuId: req.GetSkuId()}})
+}
+
+// GetCart the items with their current price, the offline or deleted products are left out of the total price
+func (m CartModule) GetCart(userId int64) ([]*order.CartItem, int64, error) {
+	cartItems, err := db.ListCartItems(m.ctx, userId)
+	if err != nil {
+		return nil, 0, err
+	}
+	ret := make([]*order.CartItem, 0, len(cartItems))
+	if len(cartItems) == 0 {
+		return ret, 0, nil
+	}
+	productIds := make([]int64, 0, len(cartItems))
+	for _, cartItem := range cartItems {
+		productIds = append(productIds, cartItem.ProductId)
+	}
+	products, err := client.GetProducts(m.ctx, productIds)
+	if err != nil {
+		return nil, 0, err
+	}
+	var totalPrice int64
+	for _, cartItem := range cartItems {
+		dto := &order.CartItem{
+			ProductId: cartItem.ProductId,
+			SkuId:     cartItem.SkuId,
+			StockNum:  cartItem.StockNum,
+		}
+		if product, ok := products[cartItem.ProductId]; ok {
+			dto.Name = product.Name
+			dto.Pic = product.Pic
+			if sku := common.FindSku(product, cartItem.SkuId); sku != nil {
+				dto.Edition = sku.Edition
+			}
+			price, err := common.LinePrice(product, cartItem.SkuId)
+			if err == nil && product.Status == item.Status_Online {
+				dto.Price = price
+				dto.Available = true
+				totalPrice += price * cartItem.StockNum
+			}
+		}
+		ret = append(ret, dto)
+	}
+	return ret, totalPrice, nil
+}
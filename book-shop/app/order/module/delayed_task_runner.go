@@ -0,0 +1,117 @@
+// Copyright 2023 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package module
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cloudwego/biz-demo/book-shop/app/order/dal/db"
+	"github.com/cloudwego/biz-demo/book-shop/kitex_gen/cwg/bookshop/order"
+	"github.com/cloudwego/biz-demo/book-shop/pkg/utils"
+	"github.com/cloudwego/kitex/pkg/klog"
+)
+
+const (
+	delayedTaskInterval  = 5 * time.Second
+	delayedTaskBatchSize = 100
+	// delayedTaskLease how long a claimed task is not fired again, it is fired once more when its run crashed
+	delayedTaskLease = time.Minute
+	// delayedTaskRetryBase and delayedTaskRetryMax bound the backoff of the failed tasks
+	delayedTaskRetryBase = 10 * time.Second
+	delayedTaskRetryMax  = 10 * time.Minute
+)
+
+// delayedTaskHandlers fire the tasks by type, a handler may be called more than once for a task
+var delayedTaskHandlers = map[string]func(ctx context.Context, task *db.DelayedTask) error{
+	db.DelayedTaskTypeCancelUnpaid: cancelUnpaidOrder,
+}
+
+// DelayedTaskRunner fires the due delayed tasks of the orders. The tasks are claimed with their rows locked,
+// so that several order instances can run it, and a failed task is retried with backoff until it succeeds.
+type DelayedTaskRunner struct {
+	*utils.PeriodicRunner
+}
+
+func NewDelayedTaskRunner() *DelayedTaskRunner {
+	r := &DelayedTaskRunner{}
+	r.PeriodicRunner = utils.NewPeriodicRunner(delayedTaskInterval, r.runDue)
+	return r
+}
+
+func (r *DelayedTaskRunner) runDue(ctx context.Context) {
+	tasks, err := db.ClaimDueTasks(ctx, time.Now(), delayedTaskLease, delayedTaskBatchSize)
+	if err != nil {
+		klog.CtxErrorf(ctx, "claim due order tasks err: %v", err)
+		return
+	}
+	for _, task := range tasks {
+		if err := fireDelayedTask(ctx, task); err != nil {
+			klog.CtxWarnf(ctx, "order task %d %s of order %d err: %v", task.TaskId, task.Type, task.OrderId, err)
+			executeAt := time.Now().Add(retryBackoff(task.Attempts, delayedTaskRetryBase, delayedTaskRetryMax))
+			if err := db.RetryTaskAt(ctx, task.TaskId, executeAt, err.Error()); err != nil {
+				klog.CtxErrorf(ctx, "retry order task %d err: %v", task.TaskId, err)
+			}
+			continue
+		}
+		if err := db.FinishTask(ctx, task.TaskId); err != nil {
+			klog.CtxErrorf(ctx, "finish order task %d err: %v", task.TaskId, err)
+		}
+	}
+}
+
+func fireDelayedTask(ctx context.Context, task *db.DelayedTask) error {
+	handler, ok := delayedTaskHandlers[task.Type]
+	if !ok {
+		return fmt.Errorf("unknown order task type %q", task.Type)
+	}
+	return handler(ctx, task)
+}
+
+// newCancelUnpaidTask cancels the order if it is still unpaid after timeout
+func newCancelUnpaidTask(taskId, orderId int64, timeout time.Duration) *db.DelayedTask {
+	return &db.DelayedTask{
+		TaskId:    taskId,
+		Type:      db.DelayedTaskTypeCancelUnpaid,
+		OrderId:   orderId,
+		ExecuteAt: time.Now().Add(timeout),
+		Status:    db.DelayedTaskStatusPending,
+	}
+}
+
+// cancelUnpaidOrder cancels an order left unpaid the way the user cancels it, a paid or cancelled order is left as it is.
+// A pending order returns an error to be retried later.
+func cancelUnpaidOrder(ctx context.Context, task *db.DelayedTask) error {
+	orderPO, err := db.GetOrderById(ctx, task.OrderId)
+	if err != nil {
+		return err
+	}
+	switch orderPO.Status {
+	case int64(order.Status_Pending):
+		return db.ErrOrderCreating
+	case int64(order.Status_PendingPayment):
+	default:
+		return nil
+	}
+	err = NewUpdateModule(ctx).CancelOrder(&order.CancelOrderReq{OrderId: task.OrderId, UserId: orderPO.UserId})
+	// paid in the meantime, or being cancelled
+	if errors.Is(err, db.ErrOrderStatus) || errors.Is(err, db.ErrOrderCancelling) {
+		return nil
+	}
+	return err
+}
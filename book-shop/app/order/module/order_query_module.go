@@ -19,6 +19,7 @@ import (
 	"context"
 
 	"github.com/cloudwego/biz-demo/book-shop/app/order/dal/db"
+	"github.com/cloudwego/biz-demo/book-shop/pkg/errno"
 )
 
 type QueryModule struct {
@@ -41,7 +42,21 @@ func (m QueryModule) ListOrder(userId int64, status *int64) ([]*db.Order, error)
 	return res, err
 }
 
-func (m QueryModule) GetOrderById(orderId int64) (*db.Order, error) {
+// GetOrderById only the user who placed the order can read it
+func (m QueryModule) GetOrderById(orderId, userId int64) (*db.Order, error) {
 	po, err := db.GetOrderById(m.ctx, orderId)
-	return po, err
+	if err != nil {
+		return nil, err
+	}
+	if err := checkOrderOwner(po, userId); err != nil {
+		return nil, err
+	}
+	return po, nil
+}
+
+func checkOrderOwner(po *db.Order, userId int64) error {
+	if po.UserId != userId {
+		return errno.OrderPermissionErr
+	}
+	return nil
 }
@@ -0,0 +1,182 @@
+// Copyright 2023 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package module
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cloudwego/biz-demo/book-shop/app/order/dal/client"
+	"github.com/cloudwego/biz-demo/book-shop/app/order/dal/db"
+	"github.com/cloudwego/biz-demo/book-shop/kitex_gen/cwg/bookshop/order"
+	"github.com/cloudwego/biz-demo/book-shop/pkg/errno"
+)
+
+// createOrderSaga the order is saved as pending together with the saga, which then holds the stock
+// of every line and confirms it before the order waits for the payment. The order is cancelled
+// and the holds released when a line fails.
+var createOrderSaga = &sagaDefinition{
+	steps: []*sagaStep{
+		{
+			name: "create_order",
+			// done in the transaction starting the saga
+			action:     func(ctx context.Context, saga *db.OrderSaga, data *sagaData) error { return nil },
+			compensate: setOrderStatus(order.Status_Pending, order.Status_Cancelled),
+		},
+		{
+			name: "reserve_stock",
+			// the holds of a run which crashed before saving them are not known to the saga,
+			// the item service releases them when they expire
+			action: func(ctx context.Context, saga *db.OrderSaga, data *sagaData) error {
+				for _, line := range data.Lines {
+					if line.ReservationId != 0 {
+						continue
+					}
+					reservationId, err := client.ReserveStock(ctx, line.ProductId, line.SkuId, line.StockNum)
+					if err != nil {
+						return err
+					}
+					line.ReservationId = reservationId
+				}
+				return nil
+			},
+			// the confirmed holds are given back by the compensation of confirm_reservation. A hold whose
+			// confirm answer was lost is confirmed but not marked, its stock is reverted under the same key
+			// as in that compensation so that it is given back once.
+			compensate: func(ctx context.Context, saga *db.OrderSaga, data *sagaData) error {
+				for i, line := range data.Lines {
+					if line.ReservationId == 0 || line.Confirmed {
+						continue
+					}
+					err := client.ReleaseReservation(ctx, line.ReservationId)
+					if err != nil && errno.ConvertErr(err).ErrCode == errno.ReservationConfirmedErrCode {
+						err = client.DecreaseStockRevert(ctx, line.ProductId, line.SkuId, line.StockNum, lineRevertKey(saga, i))
+					}
+					if err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+		},
+		{
+			name: "confirm_reservation",
+			action: func(ctx context.Context, saga *db.OrderSaga, data *sagaData) error {
+				for _, line := range data.Lines {
+					if err := client.ConfirmReservation(ctx, line.ReservationId); err != nil {
+						return err
+					}
+					line.Confirmed = true
+				}
+				return nil
+			},
+			// a line may fail after the others were confirmed, their stock is given back
+			compensate: func(ctx context.Context, saga *db.OrderSaga, data *sagaData) error {
+				for i, line := range data.Lines {
+					if !line.Confirmed {
+						continue
+					}
+					if err := client.DecreaseStockRevert(ctx, line.ProductId, line.SkuId, line.StockNum, lineRevertKey(saga, i)); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+		},
+		{
+			name: "finish_order",
+			// the order leaves pending only once the stock of every line is taken
+			action: func(ctx context.Context, saga *db.OrderSaga, data *sagaData) error {
+				for _, line := range data.Lines {
+					if !line.Confirmed {
+						return fmt.Errorf("order %d has an unconfirmed line of product %d", saga.OrderId, line.ProductId)
+					}
+				}
+				if data.ClearCart {
+					if err := db.RemoveCartItems(ctx, data.UserId, cartItemsOf(data.Lines)); err != nil {
+						return err
+					}
+				}
+				return setOrderStatus(order.Status_Pending, order.Status_PendingPayment)(ctx, saga, data)
+			},
+		},
+	},
+	pivot: 2,
+}
+
+// createOrderFirstStep the step a create saga starts at
+const createOrderFirstStep = 1
+
+// lineRevertKey the idempotency key giving back the confirmed stock of line i of a create saga
+func lineRevertKey(saga *db.OrderSaga, i int) string {
+	return fmt.Sprintf("order-%d-saga-%d-%d-revert", saga.OrderId, saga.SagaId, i)
+}
+
+// revertStockStep gives the stock of every line back. Every line has an idempotency key of its own,
+// order-<id>-<operation>-<line>, so that a retry or a second saga of the order gives it back once.
+// The step is never undone, the stock once given back is not taken again.
+var revertStockStep = &sagaStep{
+	name: "revert_stock",
+	action: func(ctx context.Context, saga *db.OrderSaga, data *sagaData) error {
+		for i, line := range data.Lines {
+			key := fmt.Sprintf("%s-%d", data.IdempotencyKey, i)
+			if err := client.DecreaseStockRevert(ctx, line.ProductId, line.SkuId, line.StockNum, key); err != nil {
+				return err
+			}
+		}
+		return nil
+	},
+}
+
+// cancelOrderSaga the stock of an unpaid order is given back before the order is cancelled. It only moves
+// forward, the order is held by the saga so that the steps are retried until they succeed.
+var cancelOrderSaga = &sagaDefinition{
+	steps: []*sagaStep{
+		revertStockStep,
+		{
+			name:   "cancel_order",
+			action: setOrderStatus(order.Status_PendingPayment, order.Status_Cancelled),
+		},
+	},
+	pivot: -1,
+}
+
+// refundOrderSaga the stock of a paid order which is not shipped is given back before the order is refunded,
+// it only moves forward as cancelOrderSaga does
+var refundOrderSaga = &sagaDefinition{
+	steps: []*sagaStep{
+		revertStockStep,
+		{
+			name:   "refund_order",
+			action: setOrderStatus(order.Status_Paid, order.Status_Refunded),
+		},
+	},
+	pivot: -1,
+}
+
+func setOrderStatus(from, to order.Status) func(ctx context.Context, saga *db.OrderSaga, data *sagaData) error {
+	return func(ctx context.Context, saga *db.OrderSaga, data *sagaData) error {
+		return db.SetOrderStatus(ctx, saga.OrderId, []int64{int64(from)}, int64(to))
+	}
+}
+
+func cartItemsOf(lines []*sagaLine) []*db.CartItem {
+	ret := make([]*db.CartItem, 0, len(lines))
+	for _, line := range lines {
+		ret = append(ret, &db.CartItem{ProductId: line.ProductId, SkuId: line.SkuId})
+	}
+	return ret
+}
@@ -17,11 +17,15 @@ package module
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
 	"github.com/cloudwego/biz-demo/book-shop/app/order/common"
-	"github.com/cloudwego/biz-demo/book-shop/app/order/dal/client"
 	"github.com/cloudwego/biz-demo/book-shop/app/order/dal/db"
 	"github.com/cloudwego/biz-demo/book-shop/kitex_gen/cwg/bookshop/order"
+	"github.com/cloudwego/biz-demo/book-shop/pkg/conf"
+	"github.com/cloudwego/biz-demo/book-shop/pkg/errno"
+	"github.com/cloudwego/biz-demo/book-shop/pkg/utils"
 )
 
 type UpdateModule struct {
@@ -34,54 +38,270 @@ func NewUpdateModule(ctx context.Context) UpdateModule {
 	}
 }
 
-func (m UpdateModule) CreateOrder(req *order.CreateOrderReq) error {
-	po, err := common.ConvertCreateReq2PO(m.ctx, req)
+// orderRequestTokenMaxLen the max length of the idempotency token of a create
+const orderRequestTokenMaxLen = 64
+
+// CreateOrder the order is saved as pending together with its saga and the task cancelling it when unpaid,
+// it waits for the payment once the saga has reserved and confirmed the stock.
+// A repeated request with the same token gets the order created before, no stock is reserved again.
+func (m UpdateModule) CreateOrder(req *order.CreateOrderReq) (int64, error) {
+	token := req.GetRequestToken()
+	if len(token) > orderRequestTokenMaxLen {
+		return 0, errno.ParamErr.WithMessage(fmt.Sprintf("request token 最长 %d 个字符", orderRequestTokenMaxLen))
+	}
+	if token != "" {
+		if orderId, ok, err := m.replayCreateOrder(req.UserId, token); err != nil || ok {
+			return orderId, err
+		}
+	}
+	lines, err := m.orderLines(req)
 	if err != nil {
-		return err
+		return 0, err
 	}
-	// 扣减库存
-	err = client.DecreaseStock(m.ctx, req.ProductId, req.StockNum)
+	po, err := common.ConvertCreateReq2PO(m.ctx, req, lines)
 	if err != nil {
-		return err
+		return 0, err
 	}
-	poList := make([]*db.Order, 0)
-	poList = append(poList, po)
-	// 插入数据
-	err = db.CreateOrder(m.ctx, poList)
+	if token != "" {
+		po.RequestToken = &token
+	}
+	sagaId, err := utils.GenerateID()
 	if err != nil {
-		// 回滚
-		m.createRollback(req)
-		return err
+		return 0, err
 	}
-	return nil
+	saga, err := newSaga(sagaId, po.OrderId, db.SagaTypeCreate, createOrderFirstStep, &sagaData{
+		Lines:     newSagaLines(po.Lines),
+		UserId:    req.UserId,
+		ClearCart: req.GetFromCart(),
+	})
+	if err != nil {
+		return 0, err
+	}
+	taskId, err := utils.GenerateID()
+	if err != nil {
+		return 0, err
+	}
+	task := newCancelUnpaidTask(taskId, po.OrderId, conf.OrderPaymentTimeout)
+	if err := db.CreateOrderWithSaga(m.ctx, po, saga, task); err != nil {
+		// a concurrent request with the same token created the order first
+		if token != "" {
+			if orderId, ok, replayErr := m.replayCreateOrder(req.UserId, token); ok {
+				return orderId, replayErr
+			}
+		}
+		return 0, err
+	}
+	// the failed steps are compensated or retried later by the SagaRecoverer,
+	// a saga taken over is finished by the run which took it
+	if err := runSaga(m.ctx, saga); err != nil && !errors.Is(err, db.ErrSagaTakenOver) {
+		return 0, err
+	}
+	return po.OrderId, nil
 }
 
-func (m UpdateModule) createRollback(req *order.CreateOrderReq) {
-	_ = client.DecreaseStockRevert(m.ctx, req.ProductId, req.StockNum)
+// replayCreateOrder the order created with the token, the error of the create if it was rolled back
+func (m UpdateModule) replayCreateOrder(userId int64, token string) (int64, bool, error) {
+	orderPO, err := db.GetOrderByToken(m.ctx, userId, token)
+	if err != nil || orderPO == nil {
+		return 0, false, err
+	}
+	saga, err := db.GetOrderSaga(m.ctx, orderPO.OrderId, db.SagaTypeCreate)
+	if err != nil {
+		return 0, true, err
+	}
+	if saga.Status == db.SagaStatusCompensating || saga.Status == db.SagaStatusCompensated {
+		return 0, true, errors.New(saga.LastError)
+	}
+	return orderPO.OrderId, true, nil
 }
 
-func (m UpdateModule) CancelOrder(req *order.CancelOrderReq) error {
-	updateMap := map[string]interface{}{
-		"status": int64(order.Status_Cancel),
+// orderLines the books in the cart, the requested lines or the single product, the lines of a product and sku are merged
+func (m UpdateModule) orderLines(req *order.CreateOrderReq) ([]*order.OrderLineReq, error) {
+	var lines []*order.OrderLineReq
+	switch {
+	case req.GetFromCart():
+		items, err := db.ListCartItems(m.ctx, req.UserId)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range items {
+			lines = append(lines, newOrderLineReq(item.ProductId, item.SkuId, item.StockNum))
+		}
+	case len(req.Lines) > 0:
+		lines = req.Lines
+	case req.ProductId != nil:
+		lines = append(lines, newOrderLineReq(req.GetProductId(), 0, req.GetStockNum()))
 	}
-	orderPO, err := db.GetOrderById(m.ctx, req.OrderId)
+	if len(lines) == 0 {
+		return nil, errors.New("订单没有商品")
+	}
+	ret := make([]*order.OrderLineReq, 0, len(lines))
+	merged := make(map[[2]int64]*order.OrderLineReq, len(lines))
+	for _, line := range lines {
+		if line.StockNum <= 0 {
+			return nil, errors.New("商品数量必须大于 0")
+		}
+		key := [2]int64{line.ProductId, line.GetSkuId()}
+		if existing, ok := merged[key]; ok {
+			existing.StockNum += line.StockNum
+			continue
+		}
+		line = newOrderLineReq(line.ProductId, line.GetSkuId(), line.StockNum)
+		merged[key] = line
+		ret = append(ret, line)
+	}
+	if len(ret) > OrderMaxLines {
+		return nil, fmt.Errorf("订单最多 %d 种商品", OrderMaxLines)
+	}
+	return ret, nil
+}
+
+func newOrderLineReq(productId, skuId, stockNum int64) *order.OrderLineReq {
+	line := &order.OrderLineReq{
+		ProductId: productId,
+		StockNum:  stockNum,
+	}
+	if skuId != 0 {
+		line.SkuId = &skuId
+	}
+	return line
+}
+
+// orderTransitions the allowed moves of an order, the stock of an unpaid or an unshipped paid order is given back by a saga
+var orderTransitions = map[order.Status][]order.Status{
+	order.Status_Pending:        {order.Status_PendingPayment, order.Status_Cancelled},
+	order.Status_PendingPayment: {order.Status_Paid, order.Status_Cancelled},
+	order.Status_Paid:           {order.Status_Shipped, order.Status_Refunded},
+	order.Status_Shipped:        {order.Status_Delivered, order.Status_Completed, order.Status_Refunded},
+	order.Status_Delivered:      {order.Status_Completed, order.Status_Refunded},
+}
+
+// sourceStatuses the statuses which can move to to by a call, a pending order is only moved by its saga
+func sourceStatuses(to order.Status) []int64 {
+	ret := make([]int64, 0)
+	for from, tos := range orderTransitions {
+		if from == order.Status_Pending {
+			continue
+		}
+		for _, t := range tos {
+			if t == to {
+				ret = append(ret, int64(from))
+			}
+		}
+	}
+	return ret
+}
+
+// CancelOrder gives the stock back and cancels an unpaid order of the user, an order has a single stock return saga at a time
+func (m UpdateModule) CancelOrder(req *order.CancelOrderReq) error {
+	orderPO, err := m.getOwnOrder(req.OrderId, req.UserId)
 	if err != nil {
 		return err
 	}
-	// 库存返还
-	err = client.DecreaseStockRevert(m.ctx, orderPO.ProductId, orderPO.StockNum)
+	if orderPO.Status == int64(order.Status_Cancelled) {
+		return nil
+	}
+	err = m.returnStock(orderPO, db.SagaTypeCancel, order.Status_PendingPayment)
+	if errors.Is(err, db.ErrOrderCancelled) {
+		return nil
+	}
+	return err
+}
+
+func (m UpdateModule) PayOrder(req *order.PayOrderReq) error {
+	if _, err := m.getOwnOrder(req.OrderId, req.UserId); err != nil {
+		return err
+	}
+	return m.transit(req.OrderId, order.Status_Paid)
+}
+
+func (m UpdateModule) ShipOrder(req *order.ShipOrderReq) error {
+	return m.transit(req.OrderId, order.Status_Shipped)
+}
+
+func (m UpdateModule) DeliverOrder(req *order.DeliverOrderReq) error {
+	return m.transit(req.OrderId, order.Status_Delivered)
+}
+
+// ConfirmReceipt a shipped order which is not marked delivered can be confirmed as well
+func (m UpdateModule) ConfirmReceipt(req *order.ConfirmReceiptReq) error {
+	if _, err := m.getOwnOrder(req.OrderId, req.UserId); err != nil {
+		return err
+	}
+	return m.transit(req.OrderId, order.Status_Completed)
+}
+
+// RefundOrder the user refunds a paid order which is not shipped and its stock is given back, a shipped order is refunded by the shop
+func (m UpdateModule) RefundOrder(req *order.RefundOrderReq) error {
+	orderPO, err := m.getOwnOrder(req.OrderId, req.UserId)
 	if err != nil {
 		return err
 	}
-	// 修改状态
-	err = db.UpdateOrder(m.ctx, req.OrderId, updateMap)
+	switch orderPO.Status {
+	case int64(order.Status_Refunded):
+		return nil
+	case int64(order.Status_Paid):
+		return m.refundPaidOrder(orderPO)
+	}
+	return db.ErrOrderStatus
+}
+
+// ShopRefundOrder the stock of an order which is not shipped is given back, the shipped books do not come back to the stock
+func (m UpdateModule) ShopRefundOrder(req *order.ShopRefundOrderReq) error {
+	orderPO, err := db.GetOrderById(m.ctx, req.OrderId)
 	if err != nil {
-		m.cancelRollback(orderPO.ProductId, orderPO.StockNum)
 		return err
 	}
-	return nil
+	switch orderPO.Status {
+	case int64(order.Status_Refunded):
+		return nil
+	case int64(order.Status_Paid):
+		return m.refundPaidOrder(orderPO)
+	}
+	return m.transit(req.OrderId, order.Status_Refunded)
 }
 
-func (m UpdateModule) cancelRollback(productId, stockNum int64) {
-	_ = client.DecreaseStock(m.ctx, productId, stockNum)
+func (m UpdateModule) refundPaidOrder(orderPO *db.Order) error {
+	err := m.returnStock(orderPO, db.SagaTypeRefund, order.Status_Paid)
+	if errors.Is(err, db.ErrOrderRefunded) {
+		return nil
+	}
+	return err
+}
+
+// getOwnOrder the order must belong to the calling user
+func (m UpdateModule) getOwnOrder(orderId, userId int64) (*db.Order, error) {
+	orderPO, err := db.GetOrderById(m.ctx, orderId)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkOrderOwner(orderPO, userId); err != nil {
+		return nil, err
+	}
+	return orderPO, nil
+}
+
+func (m UpdateModule) transit(orderId int64, to order.Status) error {
+	return db.TransitOrder(m.ctx, orderId, sourceStatuses(to), int64(to))
+}
+
+// returnStock starts the saga giving the stock back, which moves the order on from the from status.
+// The idempotency key depends only on the order and the operation, so that a repeated or concurrent cancel
+// of an order gives the stock back once.
+func (m UpdateModule) returnStock(orderPO *db.Order, sagaType string, from order.Status) error {
+	sagaId, err := utils.GenerateID()
+	if err != nil {
+		return err
+	}
+	saga, err := newSaga(sagaId, orderPO.OrderId, sagaType, 0, &sagaData{
+		Lines:          newSagaLines(orderPO.Lines),
+		IdempotencyKey: fmt.Sprintf("order-%d-%s", orderPO.OrderId, sagaType),
+	})
+	if err != nil {
+		return err
+	}
+	if err := db.CreateStockReturnSaga(m.ctx, saga, int64(from)); err != nil {
+		return err
+	}
+	return runSaga(m.ctx, saga)
 }
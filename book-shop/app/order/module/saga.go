@@ -0,0 +1,264 @@
+// Copyright 2023 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package module
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/bytedance/sonic"
+	"github.com/cloudwego/biz-demo/book-shop/app/order/dal/db"
+	"github.com/cloudwego/biz-demo/book-shop/pkg/utils"
+	"github.com/cloudwego/kitex/pkg/klog"
+)
+
+const (
+	// sagaLease how long a run holds the saga, it is renewed on every saved step.
+	// A saga whose run crashed is taken over by the recoverer once the lease is over,
+	// a run still going after its lease is over stops at its next save.
+	sagaLease = 30 * time.Second
+	// sagaRetryBase and sagaRetryMax bound the backoff of the failed steps, which doubles on every retry
+	sagaRetryBase = 5 * time.Second
+	sagaRetryMax  = 10 * time.Minute
+
+	// SagaStuckRetries and SagaStuckAge tell the stuck sagas, which failed that many times in a row
+	// or are still unfinished that long after they were started
+	SagaStuckRetries = 5
+	SagaStuckAge     = 30 * time.Minute
+)
+
+var ErrSagaBusy = errors.New("saga 正在执行")
+
+// sagaStep a step of a saga. The action is run again when the saga is resumed after a crash,
+// so it has to be idempotent, and so has the compensation which is retried until it succeeds.
+// A failed action may have been done in part, the compensation undoes the part which was done.
+type sagaStep struct {
+	name       string
+	action     func(ctx context.Context, saga *db.OrderSaga, data *sagaData) error
+	compensate func(ctx context.Context, saga *db.OrderSaga, data *sagaData) error // nil when there is nothing to undo
+}
+
+type sagaDefinition struct {
+	steps []*sagaStep
+	// pivot the last step whose failure undoes the saga, the steps after it are retried until they succeed.
+	// -1 for a saga which is never undone.
+	pivot int
+}
+
+// sagaData what the steps share, saved as the payload of the saga
+type sagaData struct {
+	Lines          []*sagaLine `json:"lines"`
+	IdempotencyKey string      `json:"idempotency_key,omitempty"`
+	// ClearCart the ordered books are taken out of the cart of UserId when the order is finished
+	UserId    int64 `json:"user_id,omitempty"`
+	ClearCart bool  `json:"clear_cart,omitempty"`
+}
+
+// sagaLine a line of the order with how far the steps got with it
+type sagaLine struct {
+	ProductId     int64 `json:"product_id"`
+	SkuId         int64 `json:"sku_id,omitempty"`
+	StockNum      int64 `json:"stock_num"`
+	ReservationId int64 `json:"reservation_id,omitempty"`
+	Confirmed     bool  `json:"confirmed,omitempty"`
+}
+
+func newSagaLines(lines []*db.OrderLine) []*sagaLine {
+	ret := make([]*sagaLine, 0, len(lines))
+	for _, line := range lines {
+		ret = append(ret, &sagaLine{
+			ProductId: line.ProductId,
+			SkuId:     line.SkuId,
+			StockNum:  line.StockNum,
+		})
+	}
+	return ret
+}
+
+func getSagaDefinition(sagaType string) (*sagaDefinition, error) {
+	switch sagaType {
+	case db.SagaTypeCreate:
+		return createOrderSaga, nil
+	case db.SagaTypeCancel:
+		return cancelOrderSaga, nil
+	case db.SagaTypeRefund:
+		return refundOrderSaga, nil
+	}
+	return nil, fmt.Errorf("unknown saga type %q", sagaType)
+}
+
+// newSaga the saga starts at step and is held by the caller, who runs it right after saving it
+func newSaga(sagaId, orderId int64, sagaType string, step int, data *sagaData) (*db.OrderSaga, error) {
+	payload, err := sonic.MarshalString(data)
+	if err != nil {
+		return nil, err
+	}
+	claimToken, err := utils.GenerateID()
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	return &db.OrderSaga{
+		SagaId:      sagaId,
+		OrderId:     orderId,
+		Type:        sagaType,
+		Status:      db.SagaStatusRunning,
+		CurrentStep: step,
+		NextRetryAt: now,
+		LockedUntil: now.Add(sagaLease),
+		ClaimToken:  claimToken,
+		Payload:     payload,
+	}, nil
+}
+
+// runSaga runs the held saga from its current step, and saves it after every step.
+// A failed step up to the pivot turns the saga into compensation, the failed step and the done
+// steps are then undone in reverse order. A failed compensation, or a failed step after the pivot, is left to the
+// recoverer which retries it later. The error of the failed step is returned, nil when the saga
+// succeeded or only waits for a step after the pivot.
+func runSaga(ctx context.Context, saga *db.OrderSaga) error {
+	def, err := getSagaDefinition(saga.Type)
+	if err != nil {
+		return err
+	}
+	data := &sagaData{}
+	if err := sonic.UnmarshalString(saga.Payload, data); err != nil {
+		return err
+	}
+	var stepErr error
+	for saga.Status == db.SagaStatusRunning {
+		if saga.CurrentStep >= len(def.steps) {
+			saga.Status = db.SagaStatusSucceeded
+			break
+		}
+		step := def.steps[saga.CurrentStep]
+		if err := step.action(ctx, saga, data); err != nil {
+			klog.CtxWarnf(ctx, "order saga %d step %s err: %v", saga.SagaId, step.name, err)
+			if saga.CurrentStep > def.pivot {
+				return retrySagaLater(ctx, saga, data, err)
+			}
+			stepErr = err
+			saga.Status = db.SagaStatusCompensating
+			saga.LastError = err.Error()
+		} else {
+			saga.CurrentStep++
+		}
+		saga.Retries = 0
+		if err := saveSaga(ctx, saga, data, sagaLease); err != nil {
+			return err
+		}
+	}
+	for saga.Status == db.SagaStatusCompensating {
+		if saga.CurrentStep < 0 {
+			saga.Status = db.SagaStatusCompensated
+			break
+		}
+		step := def.steps[saga.CurrentStep]
+		if step.compensate != nil {
+			if err := step.compensate(ctx, saga, data); err != nil {
+				klog.CtxErrorf(ctx, "order saga %d compensate %s err: %v", saga.SagaId, step.name, err)
+				if retryErr := retrySagaLater(ctx, saga, data, err); retryErr != nil {
+					return retryErr
+				}
+				return stepErr
+			}
+		}
+		saga.CurrentStep--
+		saga.Retries = 0
+		if err := saveSaga(ctx, saga, data, sagaLease); err != nil {
+			return err
+		}
+	}
+	if err := saveSaga(ctx, saga, data, 0); err != nil {
+		return err
+	}
+	return stepErr
+}
+
+// retrySagaLater releases the saga with its current step due again after the backoff
+func retrySagaLater(ctx context.Context, saga *db.OrderSaga, data *sagaData, err error) error {
+	saga.Retries++
+	saga.LastError = err.Error()
+	saga.NextRetryAt = time.Now().Add(retryBackoff(saga.Retries, sagaRetryBase, sagaRetryMax))
+	return saveSaga(ctx, saga, data, 0)
+}
+
+// retryBackoff doubles from base on every retry up to max, retries counts from 1
+func retryBackoff(retries int, base, max time.Duration) time.Duration {
+	if retries > 16 {
+		return max
+	}
+	if d := base << (retries - 1); d < max {
+		return d
+	}
+	return max
+}
+
+// saveSaga keeps the saga held for lease more, a zero lease releases it
+func saveSaga(ctx context.Context, saga *db.OrderSaga, data *sagaData, lease time.Duration) error {
+	payload, err := sonic.MarshalString(data)
+	if err != nil {
+		return err
+	}
+	saga.Payload = payload
+	saga.LockedUntil = time.Now().Add(lease)
+	return db.SaveSaga(ctx, saga)
+}
+
+// ResumeSaga runs an unfinished saga now, whether its retry is due or not
+func ResumeSaga(ctx context.Context, sagaId int64) (*db.OrderSaga, error) {
+	saga, err := db.GetSagaById(ctx, sagaId)
+	if err != nil {
+		return nil, err
+	}
+	if saga.Status == db.SagaStatusSucceeded || saga.Status == db.SagaStatusCompensated {
+		return saga, nil
+	}
+	if err := claimSaga(ctx, saga); err != nil {
+		return nil, err
+	}
+	err = runSaga(ctx, saga)
+	return saga, err
+}
+
+// claimSaga holds the saga with a token of the run and reloads it, it may have moved on since it was read
+func claimSaga(ctx context.Context, saga *db.OrderSaga) error {
+	claimToken, err := utils.GenerateID()
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	ok, err := db.ClaimSaga(ctx, saga.SagaId, claimToken, now, now.Add(sagaLease))
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrSagaBusy
+	}
+	latest, err := db.GetSagaById(ctx, saga.SagaId)
+	if err != nil {
+		return err
+	}
+	*saga = *latest
+	return nil
+}
+
+// ListStuckSagas the sagas an admin should look into
+func ListStuckSagas(ctx context.Context, limit int) ([]*db.OrderSaga, error) {
+	return db.ListStuckSagas(ctx, SagaStuckRetries, time.Now().Add(-SagaStuckAge), limit)
+}
@@ -0,0 +1,60 @@
+// Copyright 2023 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package module
+
+import (
+	"context"
+	"time"
+
+	"github.com/cloudwego/biz-demo/book-shop/app/order/dal/db"
+	"github.com/cloudwego/biz-demo/book-shop/pkg/utils"
+	"github.com/cloudwego/kitex/pkg/klog"
+)
+
+const (
+	sagaRecoverInterval  = 10 * time.Second
+	sagaRecoverBatchSize = 100
+)
+
+// SagaRecoverer retries the failed compensations and the failed steps after the pivot once
+// they are due, and takes over the sagas whose run crashed once their lease is over.
+type SagaRecoverer struct {
+	*utils.PeriodicRunner
+}
+
+func NewSagaRecoverer() *SagaRecoverer {
+	r := &SagaRecoverer{}
+	r.PeriodicRunner = utils.NewPeriodicRunner(sagaRecoverInterval, r.recover)
+	return r
+}
+
+func (r *SagaRecoverer) recover(ctx context.Context) {
+	sagas, err := db.ListDueSagas(ctx, time.Now(), sagaRecoverBatchSize)
+	if err != nil {
+		klog.CtxErrorf(ctx, "list due order sagas err: %v", err)
+		return
+	}
+	// the sagas not done in this round are due again in the next one
+	for _, saga := range sagas {
+		// another instance may have taken the saga in between
+		if err := claimSaga(ctx, saga); err != nil {
+			continue
+		}
+		if err := runSaga(ctx, saga); err != nil {
+			klog.CtxWarnf(ctx, "order saga %d of order %d err: %v", saga.SagaId, saga.OrderId, err)
+		}
+	}
+}
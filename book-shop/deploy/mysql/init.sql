@@ -23,26 +23,353 @@ create table `t_product`
     `isbn`        varchar(255) NOT NULL DEFAULT '',
     `spu_name`    varchar(255) NOT NULL DEFAULT '',
     `spu_price`   int(11) NOT NULL DEFAULT '0',
+    `author`      varchar(255) NOT NULL DEFAULT '',
     `price`       int(11) NOT NULL DEFAULT '0',
-    `stock`       int(11) NOT NULL DEFAULT '0',
-    `status`      tinyint(4) NOT NULL DEFAULT '0',
+    `stock`          int(11) NOT NULL DEFAULT '0',
+    `reserved_stock` int(11) NOT NULL DEFAULT '0',
+    `status`         tinyint(4) NOT NULL DEFAULT '0',
+    `version`        bigint(20) NOT NULL DEFAULT '0',
     PRIMARY KEY (`id`),
-    KEY         `idx_product_id` (`product_id`) COMMENT 'product_id index'
+    KEY         `idx_product_id` (`product_id`) COMMENT 'product_id index',
+    KEY         `idx_isbn` (`isbn`) COMMENT 'isbn uniqueness check'
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci COMMENT='product table';
 
+create table `t_product_sku`
+(
+    `id`             bigint unsigned auto_increment,
+    `created_at`     datetime(3) NULL,
+    `updated_at`     datetime(3) NULL,
+    `deleted_at`     datetime(3) NULL,
+    `sku_id`         bigint(20) NOT NULL,
+    `product_id`     bigint(20) NOT NULL,
+    `edition`        varchar(64) NOT NULL DEFAULT '',
+    `attributes`     text NULL,
+    `isbn`           varchar(255) NOT NULL DEFAULT '',
+    `price`          int(11) NOT NULL DEFAULT '0',
+    `stock`          int(11) NOT NULL DEFAULT '0',
+    `reserved_stock` int(11) NOT NULL DEFAULT '0',
+    PRIMARY KEY (`id`),
+    UNIQUE KEY       `uniq_sku_id` (`sku_id`) COMMENT 'sku_id unique index',
+    KEY              `idx_product_id` (`product_id`) COMMENT 'product_id index',
+    KEY              `idx_isbn` (`isbn`) COMMENT 'isbn uniqueness check'
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci COMMENT='product sku table';
+
+create table `t_product_history`
+(
+    `id`             bigint unsigned auto_increment,
+    `created_at`     datetime(3) NULL,
+    `updated_at`     datetime(3) NULL,
+    `deleted_at`     datetime(3) NULL,
+    `product_id`     bigint(20) NOT NULL,
+    `operator`       varchar(255) NOT NULL DEFAULT '',
+    `operation_type` tinyint(4) NOT NULL DEFAULT '0',
+    `changes`        longtext NULL,
+    PRIMARY KEY (`id`),
+    KEY              `idx_product_id` (`product_id`) COMMENT 'product_id index'
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci COMMENT='product change history table';
+
+create table `t_product_state_schedule`
+(
+    `id`             bigint unsigned auto_increment,
+    `created_at`     datetime(3) NULL,
+    `updated_at`     datetime(3) NULL,
+    `deleted_at`     datetime(3) NULL,
+    `schedule_id`    bigint(20) NOT NULL,
+    `product_id`     bigint(20) NOT NULL,
+    `operation_type` tinyint(4) NOT NULL DEFAULT '0',
+    `execute_at`     datetime(3) NOT NULL,
+    `status`         tinyint(4) NOT NULL DEFAULT '0',
+    `operator`       varchar(255) NOT NULL DEFAULT '',
+    `err_msg`        varchar(255) NOT NULL DEFAULT '',
+    PRIMARY KEY (`id`),
+    UNIQUE KEY       `uniq_schedule_id` (`schedule_id`) COMMENT 'schedule_id unique index',
+    KEY              `idx_status_execute_at` (`status`, `execute_at`) COMMENT 'status execute_at index',
+    KEY              `idx_product_id` (`product_id`) COMMENT 'product_id index'
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci COMMENT='product scheduled state change table';
+
+create table `t_product_import_job`
+(
+    `id`           bigint unsigned auto_increment,
+    `created_at`   datetime(3) NULL,
+    `updated_at`   datetime(3) NULL,
+    `deleted_at`   datetime(3) NULL,
+    `job_id`       bigint(20) NOT NULL,
+    `format`       varchar(16) NOT NULL DEFAULT '',
+    `content`      longblob NULL,
+    `operator`     varchar(255) NOT NULL DEFAULT '',
+    `status`       tinyint(4) NOT NULL DEFAULT '0',
+    `total_rows`   int(11) NOT NULL DEFAULT '0',
+    `next_row`     int(11) NOT NULL DEFAULT '0',
+    `success_rows` int(11) NOT NULL DEFAULT '0',
+    `failed_rows`  int(11) NOT NULL DEFAULT '0',
+    `row_errors`   longtext NULL,
+    `product_ids`  longtext NULL,
+    `err_msg`      varchar(255) NOT NULL DEFAULT '',
+    `claim_token`  bigint(20) NOT NULL DEFAULT '0',
+    PRIMARY KEY (`id`),
+    UNIQUE KEY     `uniq_job_id` (`job_id`) COMMENT 'job_id unique index',
+    KEY            `idx_status_updated_at` (`status`, `updated_at`) COMMENT 'status updated_at index'
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci COMMENT='product import job table';
+
+create table `t_product_price`
+(
+    `id`             bigint unsigned auto_increment,
+    `created_at`     datetime(3) NULL,
+    `updated_at`     datetime(3) NULL,
+    `deleted_at`     datetime(3) NULL,
+    `price_id`       bigint(20) NOT NULL,
+    `product_id`     bigint(20) NOT NULL,
+    `sku_id`         bigint(20) NOT NULL DEFAULT '0',
+    `kind`           tinyint(4) NOT NULL DEFAULT '0',
+    `price`          int(11) NOT NULL DEFAULT '0',
+    `origin_price`   int(11) NOT NULL DEFAULT '0',
+    `effective_from` datetime(3) NOT NULL,
+    `effective_to`   datetime(3) NULL,
+    `status`         tinyint(4) NOT NULL DEFAULT '0',
+    `operator`       varchar(255) NOT NULL DEFAULT '',
+    `err_msg`        varchar(255) NOT NULL DEFAULT '',
+    PRIMARY KEY (`id`),
+    UNIQUE KEY       `uniq_price_id` (`price_id`) COMMENT 'price_id unique index',
+    KEY              `idx_product_id` (`product_id`) COMMENT 'product_id index',
+    KEY              `idx_status_effective_from` (`status`, `effective_from`) COMMENT 'status effective_from index',
+    KEY              `idx_status_effective_to` (`status`, `effective_to`) COMMENT 'status effective_to index'
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci COMMENT='product price history and scheduled price change table';
+
+create table `t_product_media`
+(
+    `id`            bigint unsigned auto_increment,
+    `created_at`    datetime(3) NULL,
+    `updated_at`    datetime(3) NULL,
+    `deleted_at`    datetime(3) NULL,
+    `media_id`      bigint(20) NOT NULL,
+    `product_id`    bigint(20) NOT NULL,
+    `blob_key`      varchar(255) NOT NULL DEFAULT '',
+    `thumbnail_key` varchar(255) NOT NULL DEFAULT '',
+    `url`           varchar(512) NOT NULL DEFAULT '',
+    `thumbnail_url` varchar(512) NOT NULL DEFAULT '',
+    `width`         int(11) NOT NULL DEFAULT '0',
+    `height`        int(11) NOT NULL DEFAULT '0',
+    `sort_order`    int(11) NOT NULL DEFAULT '0',
+    `is_primary`    tinyint(1) NOT NULL DEFAULT '0',
+    PRIMARY KEY (`id`),
+    UNIQUE KEY      `uniq_media_id` (`media_id`) COMMENT 'media_id unique index',
+    KEY             `idx_product_id` (`product_id`) COMMENT 'product_id index',
+    KEY             `idx_deleted_at` (`deleted_at`) COMMENT 'deleted_at index'
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci COMMENT='product image gallery table';
+
+create table `t_product_category`
+(
+    `id`          bigint unsigned auto_increment,
+    `created_at`  datetime(3) NULL,
+    `updated_at`  datetime(3) NULL,
+    `deleted_at`  datetime(3) NULL,
+    `category_id` bigint(20) NOT NULL,
+    `parent_id`   bigint(20) NOT NULL DEFAULT '0',
+    `name`        varchar(64) NOT NULL DEFAULT '',
+    `sort_order`  int(11) NOT NULL DEFAULT '0',
+    `path`        varchar(255) NOT NULL DEFAULT '',
+    PRIMARY KEY (`id`),
+    UNIQUE KEY    `uniq_category_id` (`category_id`) COMMENT 'category_id unique index',
+    UNIQUE KEY    `uniq_parent_id_name` (`parent_id`, `name`) COMMENT 'parent_id name unique index',
+    KEY           `idx_path` (`path`) COMMENT 'path index'
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci COMMENT='product category tree table';
+
+create table `t_product_category_rel`
+(
+    `id`          bigint unsigned auto_increment,
+    `created_at`  datetime(3) NULL,
+    `updated_at`  datetime(3) NULL,
+    `deleted_at`  datetime(3) NULL,
+    `product_id`  bigint(20) NOT NULL,
+    `category_id` bigint(20) NOT NULL,
+    PRIMARY KEY (`id`),
+    UNIQUE KEY    `uniq_product_id_category_id` (`product_id`, `category_id`) COMMENT 'product_id category_id unique index',
+    KEY           `idx_category_id` (`category_id`) COMMENT 'category_id index'
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci COMMENT='product category relation table';
+
+create table `t_product_tag`
+(
+    `id`         bigint unsigned auto_increment,
+    `created_at` datetime(3) NULL,
+    `updated_at` datetime(3) NULL,
+    `deleted_at` datetime(3) NULL,
+    `product_id` bigint(20) NOT NULL,
+    `tag`        varchar(128) NOT NULL DEFAULT '',
+    PRIMARY KEY (`id`),
+    UNIQUE KEY   `uniq_product_id_tag` (`product_id`, `tag`) COMMENT 'product_id tag unique index',
+    KEY          `idx_tag` (`tag`) COMMENT 'tag index'
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci COMMENT='product tag table';
+
+create table `t_product_stock_threshold`
+(
+    `id`         bigint unsigned auto_increment,
+    `created_at` datetime(3) NULL,
+    `updated_at` datetime(3) NULL,
+    `deleted_at` datetime(3) NULL,
+    `product_id` bigint(20) NOT NULL,
+    `threshold`  int(11) NOT NULL DEFAULT '0',
+    PRIMARY KEY (`id`),
+    UNIQUE KEY   `uniq_product_id` (`product_id`) COMMENT 'product_id unique index'
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci COMMENT='product low stock threshold table';
+
+create table `t_stock_reservation`
+(
+    `id`             bigint unsigned auto_increment,
+    `created_at`     datetime(3) NULL,
+    `updated_at`     datetime(3) NULL,
+    `deleted_at`     datetime(3) NULL,
+    `reservation_id` bigint(20) NOT NULL,
+    `product_id`     bigint(20) NOT NULL,
+    `sku_id`         bigint(20) NOT NULL DEFAULT '0',
+    `stock_num`      int(11) NOT NULL DEFAULT '0',
+    `status`         tinyint(4) NOT NULL DEFAULT '0',
+    `expire_at`      datetime(3) NOT NULL,
+    PRIMARY KEY (`id`),
+    UNIQUE KEY       `uniq_reservation_id` (`reservation_id`) COMMENT 'reservation_id unique index',
+    KEY              `idx_status_expire_at` (`status`, `expire_at`) COMMENT 'status expire_at index'
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci COMMENT='stock reservation table';
+
+create table `t_stock_idempotency_key`
+(
+    `id`              bigint unsigned auto_increment,
+    `created_at`      datetime(3) NULL,
+    `updated_at`      datetime(3) NULL,
+    `deleted_at`      datetime(3) NULL,
+    `idempotency_key` varchar(128) NOT NULL,
+    `product_id`      bigint(20) NOT NULL,
+    `sku_id`          bigint(20) NOT NULL DEFAULT '0',
+    `stock_num`       int(11) NOT NULL DEFAULT '0',
+    `op_type`         tinyint(4) NOT NULL DEFAULT '0',
+    `err_msg`         varchar(255) NOT NULL DEFAULT '',
+    `lines_digest`    varchar(64) NOT NULL DEFAULT '',
+    PRIMARY KEY (`id`),
+    UNIQUE KEY        `uniq_idempotency_key` (`idempotency_key`) COMMENT 'idempotency_key unique index'
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci COMMENT='stock operation idempotency key table';
+
+create table `t_product_outbox`
+(
+    `id`            bigint unsigned auto_increment,
+    `created_at`    datetime(3) NULL,
+    `updated_at`    datetime(3) NULL,
+    `deleted_at`    datetime(3) NULL,
+    `product_id`    bigint(20) NOT NULL,
+    `payload`       longtext NULL,
+    `retry_times`   int(11) NOT NULL DEFAULT '0',
+    `next_retry_at` datetime(3) NOT NULL,
+    `last_error`    text NULL,
+    PRIMARY KEY (`id`),
+    KEY             `idx_next_retry_at` (`next_retry_at`) COMMENT 'next_retry_at index',
+    KEY             `idx_product_id` (`product_id`) COMMENT 'product_id index'
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci COMMENT='product es sync outbox table';
+
+create table `t_product_outbox_dead_letter`
+(
+    `id`          bigint unsigned auto_increment,
+    `created_at`  datetime(3) NULL,
+    `updated_at`  datetime(3) NULL,
+    `deleted_at`  datetime(3) NULL,
+    `outbox_id`   bigint(20) NOT NULL,
+    `product_id`  bigint(20) NOT NULL,
+    `payload`     longtext NULL,
+    `retry_times` int(11) NOT NULL DEFAULT '0',
+    `last_error`  text NULL,
+    PRIMARY KEY (`id`),
+    KEY           `idx_product_id` (`product_id`) COMMENT 'product_id index'
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci COMMENT='product es sync dead letter table';
+
 create table `t_order`
+(
+    `id`            bigint unsigned auto_increment,
+    `created_at`    datetime(3) NULL,
+    `updated_at`    datetime(3) NULL,
+    `deleted_at`    datetime(3) NULL,
+    `order_id`      bigint(20) NOT NULL,
+    `user_id`       bigint NOT NULL,
+    `address`       text NULL,
+    `total_price`   bigint(20) NOT NULL DEFAULT '0',
+    `status`        tinyint(4) NOT NULL DEFAULT '0',
+    `paid_at`       datetime(3) NULL,
+    `shipped_at`    datetime(3) NULL,
+    `delivered_at`  datetime(3) NULL,
+    `completed_at`  datetime(3) NULL,
+    `cancelled_at`  datetime(3) NULL,
+    `refunded_at`   datetime(3) NULL,
+    `request_token` varchar(64) NULL,
+    PRIMARY KEY (`id`),
+    KEY            `idx_order_id` (`order_id`) COMMENT 'order_id index',
+    KEY            `idx_user_id` (`user_id`) COMMENT 'user_id index',
+    UNIQUE KEY     `uniq_user_id_request_token` (`user_id`, `request_token`) COMMENT 'idempotent order creation'
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci COMMENT='order table';
+
+create table `t_order_line`
 (
     `id`               bigint unsigned auto_increment,
     `created_at`       datetime(3) NULL,
     `updated_at`       datetime(3) NULL,
     `deleted_at`       datetime(3) NULL,
     `order_id`         bigint(20) NOT NULL,
-    `user_id`          bigint NOT NULL,
-    `address`          text NULL,
+    `line_no`          int(11) NOT NULL DEFAULT '0',
     `product_id`       bigint(20) NOT NULL,
+    `sku_id`           bigint(20) NOT NULL DEFAULT '0',
     `stock_num`        int(11) NOT NULL DEFAULT '0',
+    `price`            int(11) NOT NULL DEFAULT '0',
     `product_snapshot` longtext NULL,
-    `status`           tinyint(4) NOT NULL DEFAULT '0',
     PRIMARY KEY (`id`),
-    KEY              `idx_order_id` (`order_id`) COMMENT 'order_id index'
-) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci COMMENT='order table';
+    UNIQUE KEY         `uniq_order_id_line_no` (`order_id`, `line_no`) COMMENT 'order_id line_no unique index'
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci COMMENT='order line table';
+
+create table `t_cart_item`
+(
+    `id`         bigint unsigned auto_increment,
+    `created_at` datetime(3) NULL,
+    `updated_at` datetime(3) NULL,
+    `deleted_at` datetime(3) NULL,
+    `user_id`    bigint(20) NOT NULL,
+    `product_id` bigint(20) NOT NULL,
+    `sku_id`     bigint(20) NOT NULL DEFAULT '0',
+    `stock_num`  int(11) NOT NULL DEFAULT '0',
+    PRIMARY KEY (`id`),
+    UNIQUE KEY   `uniq_user_id_product_id_sku_id` (`user_id`, `product_id`, `sku_id`) COMMENT 'a line per book of a user'
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci COMMENT='shopping cart table';
+
+create table `t_order_saga`
+(
+    `id`            bigint unsigned auto_increment,
+    `created_at`    datetime(3) NULL,
+    `updated_at`    datetime(3) NULL,
+    `deleted_at`    datetime(3) NULL,
+    `saga_id`       bigint(20) NOT NULL,
+    `order_id`      bigint(20) NOT NULL,
+    `type`          varchar(16) NOT NULL DEFAULT '',
+    `status`        tinyint(4) NOT NULL DEFAULT '0',
+    `current_step`  int(11) NOT NULL DEFAULT '0',
+    `retries`       int(11) NOT NULL DEFAULT '0',
+    `next_retry_at` datetime(3) NOT NULL,
+    `locked_until`  datetime(3) NOT NULL,
+    `claim_token`   bigint(20) NOT NULL DEFAULT '0',
+    `last_error`    text NULL,
+    `payload`       text NULL,
+    PRIMARY KEY (`id`),
+    UNIQUE KEY      `uniq_saga_id` (`saga_id`) COMMENT 'saga_id unique index',
+    KEY             `idx_order_id` (`order_id`) COMMENT 'order_id index',
+    KEY             `idx_status_next_retry_at` (`status`, `next_retry_at`) COMMENT 'due sagas of the recoverer'
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci COMMENT='order saga table';
+
+create table `t_order_delayed_task`
+(
+    `id`          bigint unsigned auto_increment,
+    `created_at`  datetime(3) NULL,
+    `updated_at`  datetime(3) NULL,
+    `deleted_at`  datetime(3) NULL,
+    `task_id`     bigint(20) NOT NULL,
+    `type`        varchar(32) NOT NULL DEFAULT '',
+    `order_id`    bigint(20) NOT NULL,
+    `execute_at`  datetime(3) NOT NULL,
+    `status`      tinyint(4) NOT NULL DEFAULT '0',
+    `attempts`    int(11) NOT NULL DEFAULT '0',
+    `last_error`  varchar(255) NOT NULL DEFAULT '',
+    PRIMARY KEY (`id`),
+    UNIQUE KEY    `uniq_task_id` (`task_id`) COMMENT 'task_id unique index',
+    UNIQUE KEY    `uniq_type_order_id` (`type`, `order_id`) COMMENT 'one task of a type per order',
+    KEY           `idx_status_execute_at` (`status`, `execute_at`) COMMENT 'status execute_at index'
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci COMMENT='order delayed task table';
@@ -24,14 +24,14 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/item2b/add": {
+        "/cart/add": {
             "post": {
                 "security": [
                     {
                         "TokenAuth": []
                     }
                 ],
-                "description": "add product",
+                "description": "the stock num is added to the one already in the cart, only books on sale can be added",
                 "consumes": [
                     "application/json"
                 ],
@@ -39,17 +39,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "product module"
+                    "cart module"
                 ],
-                "summary": "add product",
+                "summary": "consumer adds a book to the cart",
                 "parameters": [
                     {
-                        "description": "request param of adding product",
-                        "name": "addProductRequest",
+                        "description": "request param to add a book to the cart",
+                        "name": "cartItemReq",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model.AddProductRequest"
+                            "$ref": "#/definitions/model.CartItemReq"
                         }
                     }
                 ],
@@ -63,14 +63,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/item2b/del": {
+        "/cart/checkout": {
             "post": {
                 "security": [
                     {
                         "TokenAuth": []
                     }
                 ],
-                "description": "delete product",
+                "description": "one order is created for all the books in the cart, they are taken out of the cart when the order is done",
                 "consumes": [
                     "application/json"
                 ],
@@ -78,17 +78,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "product module"
+                    "cart module"
                 ],
-                "summary": "delete product",
+                "summary": "consumer orders the books in the cart",
                 "parameters": [
                     {
-                        "description": "request param of operating product",
-                        "name": "delProductRequest",
+                        "description": "request param to order the cart",
+                        "name": "checkoutReq",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model.OperateProductReq"
+                            "$ref": "#/definitions/model.CheckoutReq"
                         }
                     }
                 ],
@@ -102,14 +102,39 @@ const docTemplate = `{
                 }
             }
         },
-        "/item2b/edit": {
+        "/cart/get": {
+            "get": {
+                "security": [
+                    {
+                        "TokenAuth": []
+                    }
+                ],
+                "description": "the books in the cart with their prices now, the books no longer on sale are not available",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "cart module"
+                ],
+                "summary": "consumer gets the cart",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/cart/remove": {
             "post": {
                 "security": [
                     {
                         "TokenAuth": []
                     }
                 ],
-                "description": "edit product",
+                "description": "consumer removes a book from the cart",
                 "consumes": [
                     "application/json"
                 ],
@@ -117,17 +142,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "product module"
+                    "cart module"
                 ],
-                "summary": "edit product",
+                "summary": "consumer removes a book from the cart",
                 "parameters": [
                     {
-                        "description": "request param of editing product",
-                        "name": "editProductRequest",
+                        "description": "request param to remove a book from the cart",
+                        "name": "removeCartItemReq",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model.EditProductRequest"
+                            "$ref": "#/definitions/model.RemoveCartItemReq"
                         }
                     }
                 ],
@@ -141,14 +166,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/item2b/get": {
-            "get": {
+        "/cart/update": {
+            "post": {
                 "security": [
                     {
                         "TokenAuth": []
                     }
                 ],
-                "description": "get product by product_id",
+                "description": "consumer changes the stock num of a book in the cart",
                 "consumes": [
                     "application/json"
                 ],
@@ -156,16 +181,18 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "product module"
+                    "cart module"
                 ],
-                "summary": "get product by product_id",
+                "summary": "consumer changes the stock num of a book in the cart",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "product_id",
-                        "name": "product_id",
-                        "in": "query",
-                        "required": true
+                        "description": "request param to change the stock num",
+                        "name": "cartItemReq",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.CartItemReq"
+                        }
                     }
                 ],
                 "responses": {
@@ -178,14 +205,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/item2b/list": {
+        "/item2b/add": {
             "post": {
                 "security": [
                     {
                         "TokenAuth": []
                     }
                 ],
-                "description": "get product list",
+                "description": "add product",
                 "consumes": [
                     "application/json"
                 ],
@@ -195,15 +222,15 @@ const docTemplate = `{
                 "tags": [
                     "product module"
                 ],
-                "summary": "get product list",
+                "summary": "add product",
                 "parameters": [
                     {
-                        "description": "request param of listing products",
-                        "name": "listProductReq",
+                        "description": "request param of adding product",
+                        "name": "addProductRequest",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model.ListProductReq"
+                            "$ref": "#/definitions/model.AddProductRequest"
                         }
                     }
                 ],
@@ -217,14 +244,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/item2b/offline": {
+        "/item2b/category/create": {
             "post": {
                 "security": [
                     {
                         "TokenAuth": []
                     }
                 ],
-                "description": "offline product",
+                "description": "create a category under parent_id, or a top level one without it. The names of the children of a category are unique.",
                 "consumes": [
                     "application/json"
                 ],
@@ -232,17 +259,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "product module"
+                    "category module"
                 ],
-                "summary": "offline product",
+                "summary": "create a category",
                 "parameters": [
                     {
-                        "description": "request param of operating product",
-                        "name": "offlineProductRequest",
+                        "description": "request param of creating category",
+                        "name": "createCategoryReq",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model.OperateProductReq"
+                            "$ref": "#/definitions/model.CreateCategoryReq"
                         }
                     }
                 ],
@@ -256,14 +283,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/item2b/online": {
+        "/item2b/category/delete": {
             "post": {
                 "security": [
                     {
                         "TokenAuth": []
                     }
                 ],
-                "description": "online product",
+                "description": "delete a category which has neither children nor products",
                 "consumes": [
                     "application/json"
                 ],
@@ -271,17 +298,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "product module"
+                    "category module"
                 ],
-                "summary": "online product",
+                "summary": "delete a category",
                 "parameters": [
                     {
-                        "description": "request param of operating product",
-                        "name": "onlineProductRequest",
+                        "description": "request param of deleting category",
+                        "name": "deleteCategoryReq",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model.OperateProductReq"
+                            "$ref": "#/definitions/model.DeleteCategoryReq"
                         }
                     }
                 ],
@@ -295,33 +322,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/item2c/mget": {
+        "/item2b/category/tree": {
             "get": {
                 "security": [
                     {
                         "TokenAuth": []
                     }
                 ],
-                "description": "batch get products by product_id (2C interface)",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "get the top level categories with their children, every level in sort order",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "product module(2C)"
-                ],
-                "summary": "batch get products by product_id (2C interface)",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "product-ids separated by commas",
-                        "name": "product_ids",
-                        "in": "query",
-                        "required": true
-                    }
+                    "category module"
                 ],
+                "summary": "get the category tree",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -332,14 +347,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/item2c/search": {
+        "/item2b/category/update": {
             "post": {
                 "security": [
                     {
                         "TokenAuth": []
                     }
                 ],
-                "description": "search products (2C interface)",
+                "description": "rename, reorder or move a category, a moved category takes its children along",
                 "consumes": [
                     "application/json"
                 ],
@@ -347,17 +362,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "product module(2C)"
+                    "category module"
                 ],
-                "summary": "search products (2C interface)",
+                "summary": "update a category",
                 "parameters": [
                     {
-                        "description": "request param of searching products",
-                        "name": "searchProductReq",
+                        "description": "request param of updating category",
+                        "name": "updateCategoryReq",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model.SearchProductReq"
+                            "$ref": "#/definitions/model.UpdateCategoryReq"
                         }
                     }
                 ],
@@ -371,14 +386,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/order/cancel": {
+        "/item2b/del": {
             "post": {
                 "security": [
                     {
                         "TokenAuth": []
                     }
                 ],
-                "description": "consumer cancels order",
+                "description": "delete product",
                 "consumes": [
                     "application/json"
                 ],
@@ -386,17 +401,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "order module"
+                    "product module"
                 ],
-                "summary": "consumer cancels order",
+                "summary": "delete product",
                 "parameters": [
                     {
-                        "description": "request param to cancel one order",
-                        "name": "cancelOrderReq",
+                        "description": "request param of operating product",
+                        "name": "delProductRequest",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model.CancelOrderReq"
+                            "$ref": "#/definitions/model.OperateProductReq"
                         }
                     }
                 ],
@@ -410,14 +425,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/order/create": {
+        "/item2b/edit": {
             "post": {
                 "security": [
                     {
                         "TokenAuth": []
                     }
                 ],
-                "description": "consumer creates order",
+                "description": "edit product",
                 "consumes": [
                     "application/json"
                 ],
@@ -425,17 +440,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "order module"
+                    "product module"
                 ],
-                "summary": "consumer creates order",
+                "summary": "edit product",
                 "parameters": [
                     {
-                        "description": "request param to create one order",
-                        "name": "createOrderReq",
+                        "description": "request param of editing product",
+                        "name": "editProductRequest",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model.CreateOrderReq"
+                            "$ref": "#/definitions/model.EditProductRequest"
                         }
                     }
                 ],
@@ -449,51 +464,66 @@ const docTemplate = `{
                 }
             }
         },
-        "/order/get": {
+        "/item2b/export": {
             "get": {
                 "security": [
                     {
                         "TokenAuth": []
                     }
                 ],
-                "description": "get order by order_id",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "stream all the products matching the filters of the list api as CSV or JSON Lines, the file can be imported again",
                 "produces": [
-                    "application/json"
+                    "text/csv",
+                    "application/x-ndjson"
                 ],
                 "tags": [
-                    "order module"
+                    "product module"
                 ],
-                "summary": "get order by order_id",
+                "summary": "export products to a file",
                 "parameters": [
+                    {
+                        "type": "string",
+                        "description": "csv or jsonl, csv by default",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "name",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "spu_name",
+                        "name": "spu_name",
+                        "in": "query"
+                    },
                     {
                         "type": "integer",
-                        "description": "order id",
-                        "name": "order_id",
-                        "in": "query",
-                        "required": true
+                        "description": "status",
+                        "name": "status",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.Response"
+                            "type": "file"
                         }
                     }
                 }
             }
         },
-        "/order/list": {
-            "post": {
+        "/item2b/get": {
+            "get": {
                 "security": [
                     {
                         "TokenAuth": []
                     }
                 ],
-                "description": "get order list of a consumer",
+                "description": "get product by product_id",
                 "consumes": [
                     "application/json"
                 ],
@@ -501,18 +531,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "order module"
+                    "product module"
                 ],
-                "summary": "get order list of a consumer",
+                "summary": "get product by product_id",
                 "parameters": [
                     {
-                        "description": "request param to get order list",
-                        "name": "listOrderReq",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/model.ListOrderReq"
-                        }
+                        "type": "integer",
+                        "description": "product_id",
+                        "name": "product_id",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -525,9 +553,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/shop/login": {
-            "post": {
-                "description": "shop login",
+        "/item2b/history": {
+            "get": {
+                "security": [
+                    {
+                        "TokenAuth": []
+                    }
+                ],
+                "description": "get change history of a product, newest first",
                 "consumes": [
                     "application/json"
                 ],
@@ -535,67 +568,91 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "shop module"
+                    "product module"
                 ],
-                "summary": "shop login",
+                "summary": "get change history of a product",
                 "parameters": [
                     {
-                        "description": "login param",
-                        "name": "userParam",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/model.UserParam"
-                        }
+                        "type": "integer",
+                        "description": "product_id",
+                        "name": "product_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "next_cursor of the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "page size, 20 by default",
+                        "name": "page_size",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.LoginResponse"
+                            "$ref": "#/definitions/model.Response"
                         }
                     }
                 }
             }
         },
-        "/user/login": {
+        "/item2b/import": {
             "post": {
-                "description": "user login",
+                "security": [
+                    {
+                        "TokenAuth": []
+                    }
+                ],
+                "description": "import products from a CSV or JSON Lines file in background, the returned job_id is used to query the result.\nThe columns are those of the export, product_id and status are ignored.",
                 "consumes": [
-                    "application/json"
+                    "multipart/form-data"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "user module"
+                    "product module"
                 ],
-                "summary": "user login",
+                "summary": "import products from a file",
                 "parameters": [
                     {
-                        "description": "login param",
-                        "name": "userParam",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/model.UserParam"
-                        }
+                        "type": "file",
+                        "description": "csv or jsonl file",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "csv or jsonl, taken from the file extension by default",
+                        "name": "format",
+                        "in": "formData"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.LoginResponse"
+                            "$ref": "#/definitions/model.Response"
                         }
                     }
                 }
             }
         },
-        "/user/register": {
-            "post": {
-                "description": "user register",
+        "/item2b/import/job": {
+            "get": {
+                "security": [
+                    {
+                        "TokenAuth": []
+                    }
+                ],
+                "description": "get the progress of an import job with the errors of the failed rows",
                 "consumes": [
                     "application/json"
                 ],
@@ -603,18 +660,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "user module"
+                    "product module"
                 ],
-                "summary": "user register",
+                "summary": "get an import job",
                 "parameters": [
                     {
-                        "description": "register param",
-                        "name": "userParam",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/model.UserParam"
-                        }
+                        "type": "integer",
+                        "description": "job_id",
+                        "name": "job_id",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -626,19 +681,981 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "model.AddProductRequest": {
-            "type": "object",
-            "properties": {
-                "description": {
+        },
+        "/item2b/list": {
+            "post": {
+                "security": [
+                    {
+                        "TokenAuth": []
+                    }
+                ],
+                "description": "get a page of the products matching the filters and the total count of them",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "product module"
+                ],
+                "summary": "get product list",
+                "parameters": [
+                    {
+                        "description": "request param of listing products",
+                        "name": "listProductReq",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.ListProductReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/item2b/low-stock": {
+            "get": {
+                "security": [
+                    {
+                        "TokenAuth": []
+                    }
+                ],
+                "description": "list the products whose available stock is not above their low stock threshold, lowest stock first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "product module"
+                ],
+                "summary": "list products low on stock",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "page size, 20 by default",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "offset",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/item2b/low-stock/threshold": {
+            "post": {
+                "security": [
+                    {
+                        "TokenAuth": []
+                    }
+                ],
+                "description": "set the low stock threshold of a product, the default threshold applies again when threshold is not passed",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "product module"
+                ],
+                "summary": "set the low stock threshold of a product",
+                "parameters": [
+                    {
+                        "description": "request param of setting low stock threshold",
+                        "name": "setLowStockThresholdReq",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.SetLowStockThresholdReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/item2b/media/gallery": {
+            "post": {
+                "security": [
+                    {
+                        "TokenAuth": []
+                    }
+                ],
+                "description": "order the gallery of a product and choose its primary image, the images which are not passed are deleted",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "product module"
+                ],
+                "summary": "save the gallery of a product",
+                "parameters": [
+                    {
+                        "description": "request param of saving product gallery",
+                        "name": "saveProductGalleryReq",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.SaveProductGalleryReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/item2b/media/upload": {
+            "post": {
+                "security": [
+                    {
+                        "TokenAuth": []
+                    }
+                ],
+                "description": "upload a jpeg, png or gif image to the gallery of a product, a thumbnail is generated for it.\nThe first image of a gallery is its primary image, which is returned as the pic of the product.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "product module"
+                ],
+                "summary": "upload a product image",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "product_id",
+                        "name": "product_id",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "image file",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "make it the primary image",
+                        "name": "is_primary",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/item2b/offline": {
+            "post": {
+                "security": [
+                    {
+                        "TokenAuth": []
+                    }
+                ],
+                "description": "offline product",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "product module"
+                ],
+                "summary": "offline product",
+                "parameters": [
+                    {
+                        "description": "request param of operating product",
+                        "name": "offlineProductRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.OperateProductReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/item2b/online": {
+            "post": {
+                "security": [
+                    {
+                        "TokenAuth": []
+                    }
+                ],
+                "description": "online product",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "product module"
+                ],
+                "summary": "online product",
+                "parameters": [
+                    {
+                        "description": "request param of operating product",
+                        "name": "onlineProductRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.OperateProductReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/item2b/operations": {
+            "get": {
+                "security": [
+                    {
+                        "TokenAuth": []
+                    }
+                ],
+                "description": "get the operations which are legal in the current product status",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "product module"
+                ],
+                "summary": "get operations of a product",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "product_id",
+                        "name": "product_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/item2b/review": {
+            "post": {
+                "security": [
+                    {
+                        "TokenAuth": []
+                    }
+                ],
+                "description": "approve a product pending review to put it online, or reject it to take it offline",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "product module"
+                ],
+                "summary": "review an edited product",
+                "parameters": [
+                    {
+                        "description": "request param of reviewing product",
+                        "name": "reviewProductReq",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.ReviewProductReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/item2c/category/tree": {
+            "get": {
+                "security": [
+                    {
+                        "TokenAuth": []
+                    }
+                ],
+                "description": "get the top level categories with their children, every level in sort order",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "category module"
+                ],
+                "summary": "get the category tree",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/item2c/mget": {
+            "get": {
+                "security": [
+                    {
+                        "TokenAuth": []
+                    }
+                ],
+                "description": "batch get products by product_id (2C interface)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "product module(2C)"
+                ],
+                "summary": "batch get products by product_id (2C interface)",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "product-ids separated by commas",
+                        "name": "product_ids",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/item2c/search": {
+            "post": {
+                "security": [
+                    {
+                        "TokenAuth": []
+                    }
+                ],
+                "description": "search products (2C interface)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "product module(2C)"
+                ],
+                "summary": "search products (2C interface)",
+                "parameters": [
+                    {
+                        "description": "request param of searching products",
+                        "name": "searchProductReq",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.SearchProductReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/order/cancel": {
+            "post": {
+                "security": [
+                    {
+                        "TokenAuth": []
+                    }
+                ],
+                "description": "consumer cancels order",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "order module"
+                ],
+                "summary": "consumer cancels order",
+                "parameters": [
+                    {
+                        "description": "request param to cancel one order",
+                        "name": "cancelOrderReq",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.CancelOrderReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/order/confirm": {
+            "post": {
+                "security": [
+                    {
+                        "TokenAuth": []
+                    }
+                ],
+                "description": "the shipped or delivered order is completed",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "order module"
+                ],
+                "summary": "consumer confirms receipt",
+                "parameters": [
+                    {
+                        "description": "request param to confirm the receipt of one order",
+                        "name": "confirmReceiptReq",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.ConfirmReceiptReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/order/create": {
+            "post": {
+                "security": [
+                    {
+                        "TokenAuth": []
+                    }
+                ],
+                "description": "consumer creates order of a single book, or of several books with lines, a resubmit with the same request_token returns the order already created",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "order module"
+                ],
+                "summary": "consumer creates order",
+                "parameters": [
+                    {
+                        "description": "request param to create one order",
+                        "name": "createOrderReq",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.CreateOrderReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/order/get": {
+            "get": {
+                "security": [
+                    {
+                        "TokenAuth": []
+                    }
+                ],
+                "description": "get order by order_id",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "order module"
+                ],
+                "summary": "get order by order_id",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "order id",
+                        "name": "order_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/order/list": {
+            "post": {
+                "security": [
+                    {
+                        "TokenAuth": []
+                    }
+                ],
+                "description": "get order list of a consumer",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "order module"
+                ],
+                "summary": "get order list of a consumer",
+                "parameters": [
+                    {
+                        "description": "request param to get order list",
+                        "name": "listOrderReq",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.ListOrderReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/order/pay": {
+            "post": {
+                "security": [
+                    {
+                        "TokenAuth": []
+                    }
+                ],
+                "description": "the order waiting for the payment is paid",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "order module"
+                ],
+                "summary": "consumer pays order",
+                "parameters": [
+                    {
+                        "description": "request param to pay one order",
+                        "name": "payOrderReq",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.PayOrderReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/order/refund": {
+            "post": {
+                "security": [
+                    {
+                        "TokenAuth": []
+                    }
+                ],
+                "description": "the paid order which is not shipped is refunded and its stock is given back, a shipped order is refunded by the shop",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "order module"
+                ],
+                "summary": "consumer refunds order",
+                "parameters": [
+                    {
+                        "description": "request param to refund one order",
+                        "name": "refundOrderReq",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.RefundOrderReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/order2b/deliver": {
+            "post": {
+                "security": [
+                    {
+                        "TokenAuth": []
+                    }
+                ],
+                "description": "the shipped order is delivered",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "order module"
+                ],
+                "summary": "shop marks order delivered",
+                "parameters": [
+                    {
+                        "description": "request param to mark one order delivered",
+                        "name": "deliverOrderReq",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.DeliverOrderReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/order2b/refund": {
+            "post": {
+                "security": [
+                    {
+                        "TokenAuth": []
+                    }
+                ],
+                "description": "the paid, shipped or delivered order is refunded, the stock is given back only when it is not shipped",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "order module"
+                ],
+                "summary": "shop refunds order",
+                "parameters": [
+                    {
+                        "description": "request param to refund one order",
+                        "name": "shopRefundOrderReq",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.ShopRefundOrderReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/order2b/ship": {
+            "post": {
+                "security": [
+                    {
+                        "TokenAuth": []
+                    }
+                ],
+                "description": "the paid order is shipped",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "order module"
+                ],
+                "summary": "shop ships order",
+                "parameters": [
+                    {
+                        "description": "request param to ship one order",
+                        "name": "shipOrderReq",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.ShipOrderReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/shop/login": {
+            "post": {
+                "description": "shop login",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "shop module"
+                ],
+                "summary": "shop login",
+                "parameters": [
+                    {
+                        "description": "login param",
+                        "name": "userParam",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.UserParam"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.LoginResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/login": {
+            "post": {
+                "description": "user login",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user module"
+                ],
+                "summary": "user login",
+                "parameters": [
+                    {
+                        "description": "login param",
+                        "name": "userParam",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.UserParam"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.LoginResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/register": {
+            "post": {
+                "description": "user register",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user module"
+                ],
+                "summary": "user register",
+                "parameters": [
+                    {
+                        "description": "register param",
+                        "name": "userParam",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.UserParam"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Response"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "model.AddProductRequest": {
+            "type": "object",
+            "properties": {
+                "author": {
+                    "type": "string"
+                },
+                "category_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "description": {
                     "type": "string"
                 },
                 "isbn": {
+                    "description": "ISBN-10 or ISBN-13, saved as ISBN-13",
                     "type": "string"
                 },
                 "name": {
+                    "description": "with an isbn, the empty name, spu_name, author and description are prefilled",
                     "type": "string"
                 },
                 "pic": {
@@ -647,6 +1664,13 @@ const docTemplate = `{
                 "price": {
                     "type": "integer"
                 },
+                "skus": {
+                    "description": "price and stock are summed up from the skus when given",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.SkuRequest"
+                    }
+                },
                 "spu_name": {
                     "type": "string"
                 },
@@ -655,6 +1679,12 @@ const docTemplate = `{
                 },
                 "stock": {
                     "type": "integer"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 }
             }
         },
@@ -666,13 +1696,76 @@ const docTemplate = `{
                 }
             }
         },
+        "model.CartItemReq": {
+            "type": "object",
+            "properties": {
+                "product_id": {
+                    "type": "string"
+                },
+                "sku_id": {
+                    "description": "required for a product with skus",
+                    "type": "string"
+                },
+                "stock_num": {
+                    "description": "added to the stock num in the cart, or the new one on update",
+                    "type": "integer"
+                }
+            }
+        },
+        "model.CheckoutReq": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "string"
+                },
+                "request_token": {
+                    "description": "the same as the one of CreateOrderReq",
+                    "type": "string"
+                }
+            }
+        },
+        "model.ConfirmReceiptReq": {
+            "type": "object",
+            "properties": {
+                "order_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.CreateCategoryReq": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "parent_id": {
+                    "description": "a top level category when it is not passed",
+                    "type": "string"
+                },
+                "sort_order": {
+                    "type": "integer"
+                }
+            }
+        },
         "model.CreateOrderReq": {
             "type": "object",
             "properties": {
                 "address": {
                     "type": "string"
                 },
+                "lines": {
+                    "description": "several books in one order",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.OrderLineReq"
+                    }
+                },
                 "product_id": {
+                    "description": "a single book, ignored when lines are given",
+                    "type": "string"
+                },
+                "request_token": {
+                    "description": "RequestToken generated by the client for each order, a resubmit with it returns the order already created",
                     "type": "string"
                 },
                 "stock_num": {
@@ -680,9 +1773,35 @@ const docTemplate = `{
                 }
             }
         },
+        "model.DeleteCategoryReq": {
+            "type": "object",
+            "properties": {
+                "category_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.DeliverOrderReq": {
+            "type": "object",
+            "properties": {
+                "order_id": {
+                    "type": "string"
+                }
+            }
+        },
         "model.EditProductRequest": {
             "type": "object",
             "properties": {
+                "author": {
+                    "type": "string"
+                },
+                "category_ids": {
+                    "description": "all the categories of the product, kept when not passed",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "description": {
                     "type": "string"
                 },
@@ -701,6 +1820,13 @@ const docTemplate = `{
                 "product_id": {
                     "type": "string"
                 },
+                "skus": {
+                    "description": "all the skus of the product, the missing ones are removed",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.SkuRequest"
+                    }
+                },
                 "spu_name": {
                     "type": "string"
                 },
@@ -709,6 +1835,17 @@ const docTemplate = `{
                 },
                 "stock": {
                     "type": "integer"
+                },
+                "tags": {
+                    "description": "all the tags of the product, kept when not passed",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "version": {
+                    "description": "version of the product when it was read",
+                    "type": "integer"
                 }
             }
         },
@@ -723,14 +1860,48 @@ const docTemplate = `{
         "model.ListProductReq": {
             "type": "object",
             "properties": {
+                "category_id": {
+                    "description": "the products of its descendants are matched as well",
+                    "type": "string"
+                },
+                "limit": {
+                    "description": "20 by default, 100 at most",
+                    "type": "integer"
+                },
+                "max_price": {
+                    "type": "integer"
+                },
+                "max_stock": {
+                    "type": "integer"
+                },
+                "min_price": {
+                    "type": "integer"
+                },
+                "min_stock": {
+                    "type": "integer"
+                },
                 "name": {
+                    "description": "fuzzy match",
                     "type": "string"
                 },
+                "offset": {
+                    "type": "integer"
+                },
+                "sort_desc": {
+                    "type": "boolean"
+                },
+                "sort_field": {
+                    "description": "0: create time, 1: price, 2: stock",
+                    "type": "integer"
+                },
                 "spu_name": {
                     "type": "string"
                 },
                 "status": {
                     "type": "integer"
+                },
+                "tag": {
+                    "type": "string"
                 }
             }
         },
@@ -756,6 +1927,48 @@ const docTemplate = `{
                 }
             }
         },
+        "model.OrderLineReq": {
+            "type": "object",
+            "properties": {
+                "product_id": {
+                    "type": "string"
+                },
+                "sku_id": {
+                    "description": "required for a product with skus",
+                    "type": "string"
+                },
+                "stock_num": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.PayOrderReq": {
+            "type": "object",
+            "properties": {
+                "order_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.RefundOrderReq": {
+            "type": "object",
+            "properties": {
+                "order_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.RemoveCartItemReq": {
+            "type": "object",
+            "properties": {
+                "product_id": {
+                    "type": "string"
+                },
+                "sku_id": {
+                    "type": "string"
+                }
+            }
+        },
         "model.Response": {
             "type": "object",
             "properties": {
@@ -768,17 +1981,148 @@ const docTemplate = `{
                 }
             }
         },
+        "model.ReviewProductReq": {
+            "type": "object",
+            "properties": {
+                "approved": {
+                    "description": "true to approve and put online, false to reject and take offline",
+                    "type": "boolean"
+                },
+                "product_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.SaveProductGalleryReq": {
+            "type": "object",
+            "properties": {
+                "media_ids": {
+                    "description": "all the images in order, the missing ones are removed",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "primary_media_id": {
+                    "description": "the first image by default",
+                    "type": "string"
+                },
+                "product_id": {
+                    "type": "string"
+                }
+            }
+        },
         "model.SearchProductReq": {
             "type": "object",
             "properties": {
+                "category_id": {
+                    "description": "the products of its descendants are matched as well",
+                    "type": "string"
+                },
+                "cursor": {
+                    "description": "next_cursor of the last page",
+                    "type": "string"
+                },
                 "description": {
                     "type": "string"
                 },
+                "max_price": {
+                    "type": "integer"
+                },
+                "min_price": {
+                    "type": "integer"
+                },
                 "name": {
                     "type": "string"
                 },
+                "page_size": {
+                    "type": "integer"
+                },
+                "sort_desc": {
+                    "type": "boolean"
+                },
+                "sort_field": {
+                    "description": "0: relevance, 1: price, 2: stock",
+                    "type": "integer"
+                },
                 "spu_name": {
                     "type": "string"
+                },
+                "tag": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.SetLowStockThresholdReq": {
+            "type": "object",
+            "properties": {
+                "product_id": {
+                    "type": "string"
+                },
+                "threshold": {
+                    "description": "the default threshold applies again when it is not passed",
+                    "type": "integer"
+                }
+            }
+        },
+        "model.ShipOrderReq": {
+            "type": "object",
+            "properties": {
+                "order_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.ShopRefundOrderReq": {
+            "type": "object",
+            "properties": {
+                "order_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.SkuRequest": {
+            "type": "object",
+            "properties": {
+                "attributes": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "edition": {
+                    "type": "string"
+                },
+                "isbn": {
+                    "type": "string"
+                },
+                "price": {
+                    "type": "integer"
+                },
+                "sku_id": {
+                    "description": "empty for a new sku",
+                    "type": "string"
+                },
+                "stock": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.UpdateCategoryReq": {
+            "type": "object",
+            "properties": {
+                "category_id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "parent_id": {
+                    "description": "\"0\" moves it to the top level",
+                    "type": "string"
+                },
+                "sort_order": {
+                    "type": "integer"
                 }
             }
         },
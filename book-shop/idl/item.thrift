@@ -20,23 +20,51 @@ enum Status {
     Online // 上架
     Offline // 下架
     Delete // 删除
+    PendingReview // 待审核
 }
 
 struct BookProperty {
-    1: string isbn // ISBN
+    1: string isbn // ISBN, 保存为 13 位 ISBN
     2: string spu_name // 书名
     3: i64 spu_price // 定价
+    4: string author // 作者
+}
+
+struct Sku {
+    1: i64 sku_id // 新增规格时不传
+    2: string edition // 版本, 如 精装 / 平装 / 电子书
+    3: map<string, string> attributes // 其他规格属性
+    4: string isbn // 该版本的 ISBN
+    5: i64 price // 价格
+    6: i64 stock // 库存
+    7: optional i64 promo_price // 当前生效的促销价, 编辑时忽略
+}
+
+struct ProductImage {
+    1: i64 media_id
+    2: string url // 原图地址
+    3: string thumbnail_url // 缩略图地址
+    4: i32 width
+    5: i32 height
+    6: bool is_primary // 是否主图
+    7: i32 sort_order // 图库中的顺序, 从 0 开始
 }
 
 struct Product {
     1: i64 product_id
     2: string name // 商品名
-    3: string pic // 主图
+    3: string pic // 主图, 有图库时为图库主图的地址
     4: string description // 详情
     5: BookProperty property // 属性
     6: i64 price // 价格
     7: i64 stock // 库存
     8: Status status // 商品状态
+    9: i64 version // 版本号, 编辑时需回传
+    10: list<Sku> skus // 规格, 有规格时商品价格为最低规格价格, 库存为规格库存之和
+    11: optional i64 promo_price // 当前生效的促销价, 有规格时为有促销时各规格实际价格中的最低价
+    12: list<ProductImage> gallery // 图库, 按顺序排列, 编辑时忽略
+    13: list<i64> category_ids // 所属类目
+    14: list<string> tags // 标签
 }
 
 struct AddReq {
@@ -46,6 +74,10 @@ struct AddReq {
     4: required BookProperty property // 属性
     5: required i64 price // 价格
     6: required i64 stock // 库存
+    7: optional string operator_name // 操作人
+    8: optional list<Sku> skus // 规格, 传入时忽略 price 与 stock
+    9: optional list<i64> category_ids // 所属类目
+    10: optional list<string> tags // 标签
 }
 
 struct AddResp {
@@ -61,6 +93,11 @@ struct EditReq {
     5: optional BookProperty property // 属性
     6: optional i64 price // 价格
     7: optional i64 stock // 库存
+    8: optional string operator_name // 操作人
+    9: required i64 version // 读取商品时的版本号, 商品已被修改时编辑失败
+    10: optional list<Sku> skus // 规格全量, 带 sku_id 的更新, 不带的新增, 未传入的删除
+    11: optional list<i64> category_ids // 所属类目全量
+    12: optional list<string> tags // 标签全量
 }
 
 struct EditResp {
@@ -69,6 +106,7 @@ struct EditResp {
 
 struct DeleteReq {
     1: required i64 product_id
+    2: optional string operator_name // 操作人
 }
 
 struct DeleteResp {
@@ -77,6 +115,7 @@ struct DeleteResp {
 
 struct OnlineReq {
     1: required i64 product_id
+    2: optional string operator_name // 操作人
 }
 
 struct OnlineResp {
@@ -85,6 +124,7 @@ struct OnlineResp {
 
 struct OfflineReq {
     1: required i64 product_id
+    2: optional string operator_name // 操作人
 }
 
 struct OfflineResp {
@@ -109,49 +149,424 @@ struct MGet2CResp {
     255: base.BaseResp BaseResp
 }
 
+enum SearchSortField {
+    Relevance // 相关度
+    Price // 价格
+    Stock // 库存
+}
+
 struct SearchReq {
     1: optional string name
     2: optional string description
     3: optional string spu_name
+    4: optional i64 min_price // 最低价格
+    5: optional i64 max_price // 最高价格
+    6: optional SearchSortField sort_field // 排序字段, 默认按相关度
+    7: optional bool sort_desc // 是否降序, 按相关度时固定降序
+    8: optional i32 page_size // 每页数量
+    9: optional string cursor // 翻页游标, 取上一页的 next_cursor
+    10: optional i64 category_id // 类目, 含子类目下的商品
+    11: optional string tag // 标签
+}
+
+struct PriceBucket {
+    1: optional i64 min_price // 区间下界(含)
+    2: optional i64 max_price // 区间上界(不含)
+    3: i64 count
+}
+
+struct FacetBucket {
+    1: string key
+    2: i64 count
+}
+
+struct CategoryBucket {
+    1: i64 category_id
+    2: string name
+    3: string path // 类目名称路径, 如 文学/小说
+    4: i64 count
 }
 
 struct SearchResp {
     1: list<Product> products
+    2: string next_cursor // 下一页游标
+    3: bool has_more // 是否还有下一页
+    4: i64 total // 命中总数
+    5: list<PriceBucket> price_buckets // 价格区间聚合
+    6: list<FacetBucket> spu_name_facets // 书名聚合
+    7: list<CategoryBucket> category_facets // 类目聚合, 商品同时计入各级上级类目
     255: base.BaseResp BaseResp
 }
 
+enum ListSortField {
+    CreateTime // 创建时间
+    Price // 价格
+    Stock // 库存
+}
+
 struct ListReq {
-    1: optional string name
+    1: optional string name // 商品名模糊匹配
     2: optional string spu_name
     3: optional Status status
+    4: optional i64 min_price // 最低价格(含)
+    5: optional i64 max_price // 最高价格(含)
+    6: optional i64 min_stock // 最低库存(含)
+    7: optional i64 max_stock // 最高库存(含)
+    8: optional ListSortField sort_field // 排序字段, 默认按创建时间
+    9: optional bool sort_desc // 是否降序
+    10: optional i32 limit // 每页数量, 默认 20, 最大 100
+    11: optional i32 offset
+    12: optional i64 category_id // 类目, 含子类目下的商品
+    13: optional string tag // 标签
 }
 
 struct ListResp {
     1: list<Product> products
+    2: i64 total // 满足条件的商品总数
+    255: base.BaseResp BaseResp
+}
+
+struct Category {
+    1: i64 category_id
+    2: i64 parent_id // 0 为一级类目
+    3: string name
+    4: i32 sort_order // 同级类目按升序排列
+    5: list<Category> children // 子类目
+}
+
+struct CreateCategoryReq {
+    1: optional i64 parent_id // 上级类目, 不传时为一级类目
+    2: required string name // 类目名, 同级类目不可重名
+    3: optional i32 sort_order
+}
+
+struct CreateCategoryResp {
+    1: i64 category_id
+    255: base.BaseResp BaseResp
+}
+
+struct UpdateCategoryReq {
+    1: required i64 category_id
+    2: optional string name
+    3: optional i64 parent_id // 移动到该类目下, 0 为一级类目, 子类目随之移动
+    4: optional i32 sort_order
+}
+
+struct UpdateCategoryResp {
+    255: base.BaseResp BaseResp
+}
+
+struct DeleteCategoryReq {
+    1: required i64 category_id // 有子类目或商品时不可删除
+}
+
+struct DeleteCategoryResp {
+    255: base.BaseResp BaseResp
+}
+
+struct GetCategoryTreeReq {
+}
+
+struct GetCategoryTreeResp {
+    1: list<Category> categories // 一级类目, 子类目在 children 中
     255: base.BaseResp BaseResp
 }
 
 struct DecrStockReq {
     1: required i64 product_id
     2: required i64 stock_num
+    3: optional string idempotency_key // 幂等键, 如 order_id + 操作, 重复请求返回首次结果
+    4: optional i64 sku_id // 规格, 有规格的商品必传
 }
 
 struct DecrStockResp {
     255: base.BaseResp BaseResp
 }
 
+struct ScheduleStateChangeReq {
+    1: required i64 product_id
+    2: required Status target_status // 仅支持 Online / Offline
+    3: required i64 execute_time // 执行时间, unix 秒
+    4: optional string operator_name // 操作人
+}
+
+struct ScheduleStateChangeResp {
+    1: i64 schedule_id
+    255: base.BaseResp BaseResp
+}
+
+struct CancelScheduledChangeReq {
+    1: required i64 schedule_id
+}
+
+struct CancelScheduledChangeResp {
+    255: base.BaseResp BaseResp
+}
+
+struct StateOperation {
+    1: i64 operation_type // 2 编辑 3 删除 4 下架 5 上架 6 审核通过 7 审核驳回
+    2: string name
+}
+
+struct GetLegalOperationsReq {
+    1: required i64 product_id
+}
+
+struct GetLegalOperationsResp {
+    1: list<StateOperation> operations // 商品当前状态下可执行的操作
+    255: base.BaseResp BaseResp
+}
+
+struct ReviewReq {
+    1: required i64 product_id
+    2: required bool approved // true 通过并上架, false 驳回并下架
+    3: optional string operator_name // 操作人
+}
+
+struct ReviewResp {
+    255: base.BaseResp BaseResp
+}
+
+struct UploadProductMediaReq {
+    1: required i64 product_id
+    2: required binary content // 图片内容, 支持 jpeg / png / gif
+    3: optional bool is_primary // 是否设为主图, 图库的第一张图总是主图
+}
+
+struct UploadProductMediaResp {
+    1: ProductImage image
+    255: base.BaseResp BaseResp
+}
+
+struct SaveProductGalleryReq {
+    1: required i64 product_id
+    2: required list<i64> media_ids // 图库全量, 按传入顺序排列, 未传入的图片被删除
+    3: optional i64 primary_media_id // 主图, 不传时为第一张
+}
+
+struct SaveProductGalleryResp {
+    1: list<ProductImage> gallery
+    255: base.BaseResp BaseResp
+}
+
+struct FieldChange {
+    1: string field
+    2: string before // 变更前
+    3: string after // 变更后
+}
+
+struct ProductHistory {
+    1: i64 id
+    2: i64 product_id
+    3: string operator_name // 操作人
+    4: i64 operation_type // 1 新增 2 编辑 3 删除 4 下架 5 上架
+    5: list<FieldChange> changes // 变更字段
+    6: i64 create_time // unix 秒
+}
+
+struct GetProductHistoryReq {
+    1: required i64 product_id
+    2: optional i32 page_size // 默认 20, 最大 100
+    3: optional i64 cursor // 上一页返回的 next_cursor
+}
+
+struct GetProductHistoryResp {
+    1: list<ProductHistory> histories // 按时间倒序
+    2: i64 next_cursor
+    3: bool has_more
+    255: base.BaseResp BaseResp
+}
+
+struct StockLine {
+    1: required i64 product_id
+    2: required i64 stock_num
+    3: optional i64 sku_id // 规格, 有规格的商品必传
+}
+
+struct BatchDecrStockReq {
+    1: required list<StockLine> lines
+    2: optional string idempotency_key // 幂等键, 重复请求返回首次结果, 不可用于其他批次
+}
+
+struct StockShortage {
+    1: i64 product_id
+    2: i64 stock_num // 请求数量
+    3: i64 available_num // 可用库存
+    4: i64 sku_id
+}
+
+struct BatchDecrStockResp {
+    1: list<StockShortage> shortages // 库存不足的商品, 此时所有商品均未扣减
+    255: base.BaseResp BaseResp
+}
+
+struct ReserveStockReq {
+    1: required i64 product_id
+    2: required i64 stock_num
+    3: optional i64 ttl_seconds // 预占有效期, 默认 15 分钟
+    4: optional i64 sku_id // 规格, 有规格的商品必传
+}
+
+struct ReserveStockResp {
+    1: i64 reservation_id
+    2: i64 expire_time // 过期时间, unix 秒
+    255: base.BaseResp BaseResp
+}
+
+struct ConfirmReservationReq {
+    1: required i64 reservation_id
+}
+
+struct ConfirmReservationResp {
+    255: base.BaseResp BaseResp
+}
+
+struct ReleaseReservationReq {
+    1: required i64 reservation_id
+}
+
+struct ReleaseReservationResp {
+    255: base.BaseResp BaseResp
+}
+
+struct LowStockProduct {
+    1: Product product
+    2: i64 available_stock // 可用库存, 不含预占
+    3: i64 threshold // 生效的低库存阈值
+}
+
+struct ListLowStockReq {
+    1: optional i32 limit // 每页数量, 默认 20, 最大 100
+    2: optional i32 offset
+}
+
+struct ListLowStockResp {
+    1: list<LowStockProduct> products // 可用库存不高于阈值的商品, 按可用库存升序
+    2: i64 total
+    255: base.BaseResp BaseResp
+}
+
+struct SetLowStockThresholdReq {
+    1: required i64 product_id
+    2: optional i64 threshold // 低库存阈值, 不传时恢复默认阈值
+}
+
+struct SetLowStockThresholdResp {
+    255: base.BaseResp BaseResp
+}
+
+struct SchedulePriceChangeReq {
+    1: required i64 product_id
+    2: optional i64 sku_id // 规格, 有规格的商品必传
+    3: required i64 price
+    4: required i64 effective_from // 生效时间, unix 秒
+    5: optional i64 effective_to // 促销结束时间, unix 秒, 传入时为促销价, 结束后自动恢复标价; 不传时为标价变更
+    6: optional string operator_name // 操作人
+}
+
+struct SchedulePriceChangeResp {
+    1: i64 price_id
+    255: base.BaseResp BaseResp
+}
+
+struct PriceChange {
+    1: i64 price_id
+    2: i64 product_id
+    3: i64 sku_id
+    4: i64 kind // 1 标价变更 2 促销
+    5: i64 price
+    6: i64 origin_price // 标价变更前的价格
+    7: i64 effective_from // unix 秒
+    8: optional i64 effective_to // unix 秒, 仅促销
+    9: i64 status // 1 待生效 2 执行中 3 已生效 4 促销中 5 促销结束 6 失败
+    10: string operator_name // 操作人
+    11: string err_msg
+    12: i64 create_time // unix 秒
+}
+
+struct GetPriceHistoryReq {
+    1: required i64 product_id
+    2: optional i32 limit // 默认 20, 最大 100
+}
+
+struct GetPriceHistoryResp {
+    1: list<PriceChange> changes // 按创建时间倒序
+    255: base.BaseResp BaseResp
+}
+
+struct ImportProductsReq {
+    1: required string format // csv 或 jsonl
+    2: required binary content // 文件内容
+    3: optional string operator_name // 操作人
+}
+
+struct ImportProductsResp {
+    1: i64 job_id
+    255: base.BaseResp BaseResp
+}
+
+struct ImportRowError {
+    1: i32 row // 数据行号, 从 1 开始, 不含表头
+    2: string message
+}
+
+struct ImportJob {
+    1: i64 job_id
+    2: string format
+    3: i64 status // 1 等待 2 执行中 3 完成 4 失败
+    4: i32 total_rows
+    5: i32 success_rows
+    6: i32 failed_rows
+    7: list<ImportRowError> row_errors // 失败的行, 最多保留 1000 条
+    8: list<i64> product_ids // 导入成功的商品
+    9: string err_msg // 任务失败原因
+    10: i64 create_time // unix 秒
+    11: i64 update_time // unix 秒
+}
+
+struct GetImportJobReq {
+    1: required i64 job_id
+}
+
+struct GetImportJobResp {
+    1: ImportJob job
+    255: base.BaseResp BaseResp
+}
+
 service ItemService {
     AddResp Add(1: AddReq req) // 添加商品
     EditResp Edit(1: EditReq req) // 编辑商品
     DeleteResp Delete(1: DeleteReq req) // 删除商品
     OnlineResp Online(1: OnlineReq req) // 上架商品
     OfflineResp Offline(1: OfflineReq req) // 下架商品
+    ScheduleStateChangeResp ScheduleStateChange(1: ScheduleStateChangeReq req) // 定时上下架
+    CancelScheduledChangeResp CancelScheduledChange(1: CancelScheduledChangeReq req) // 取消定时上下架
+    SchedulePriceChangeResp SchedulePriceChange(1: SchedulePriceChangeReq req) // 定时调价或促销
+    GetPriceHistoryResp GetPriceHistory(1: GetPriceHistoryReq req) // 价格变更记录 b端
+    ReviewResp Review(1: ReviewReq req) // 审核商品
+    GetLegalOperationsResp GetLegalOperations(1: GetLegalOperationsReq req) // 商品可执行的操作
+    UploadProductMediaResp UploadProductMedia(1: UploadProductMediaReq req) // 上传商品图片
+    SaveProductGalleryResp SaveProductGallery(1: SaveProductGalleryReq req) // 调整商品图库的顺序与主图, 删除图片
+    CreateCategoryResp CreateCategory(1: CreateCategoryReq req) // 新增类目
+    UpdateCategoryResp UpdateCategory(1: UpdateCategoryReq req) // 修改或移动类目
+    DeleteCategoryResp DeleteCategory(1: DeleteCategoryReq req) // 删除类目
+    GetCategoryTreeResp GetCategoryTree(1: GetCategoryTreeReq req) // 类目树
     GetResp Get(1: GetReq req) // 查询商品 2B
     MGet2CResp MGet2C(1: MGet2CReq req) // 批量查询商品 2C
     SearchResp Search(1: SearchReq req) // 搜索商品 c端
     ListResp List(1: ListReq req) // 商品列表 b端
+    GetProductHistoryResp GetProductHistory(1: GetProductHistoryReq req) // 商品变更记录 b端
+    ListLowStockResp ListLowStock(1: ListLowStockReq req) // 低库存商品列表 b端
+    SetLowStockThresholdResp SetLowStockThreshold(1: SetLowStockThresholdReq req) // 设置商品低库存阈值
+    ImportProductsResp ImportProducts(1: ImportProductsReq req) // 批量导入商品, 异步执行
+    GetImportJobResp GetImportJob(1: GetImportJobReq req) // 查询导入任务
     DecrStockResp DecrStock(1: DecrStockReq req) // 扣减库存
     DecrStockResp DecrStockRevert(1: DecrStockReq req) // 库存返还
+    BatchDecrStockResp BatchDecrStock(1: BatchDecrStockReq req) // 批量扣减库存, 全部成功或全部失败
+    BatchDecrStockResp BatchDecrStockRevert(1: BatchDecrStockReq req) // 批量库存返还
+    ReserveStockResp ReserveStock(1: ReserveStockReq req) // 预占库存
+    ConfirmReservationResp ConfirmReservation(1: ConfirmReservationReq req) // 确认预占, 扣减库存
+    ReleaseReservationResp ReleaseReservation(1: ReleaseReservationReq req) // 释放预占
 }
 
 
@@ -16,10 +16,25 @@
 include "base.thrift"
 namespace go cwg.bookshop.order
 
+// 订单状态: Pending -> PendingPayment -> Paid -> Shipped -> Delivered -> Completed,
+// 待支付的订单可取消, 已支付未完成的订单可退款. 前三个的值与旧的 Finish/Cancel/Pending 相同
 enum Status {
-    Finish
-    Cancel
-    Pending
+    Completed = 0 // 已完成
+    Cancelled = 1 // 已取消
+    Pending = 2 // 创建中, 库存尚未确认
+    PendingPayment = 3 // 待支付
+    Paid = 4 // 已支付
+    Shipped = 5 // 已发货
+    Delivered = 6 // 已送达
+    Refunded = 7 // 已退款
+}
+
+struct OrderLine {
+    1: i64 product_id
+    2: i64 sku_id // 0 为无规格
+    3: i64 stock_num
+    4: i64 price // 下单时的成交单价, 有促销时为促销价
+    5: string product_snapshot // 下单时的商品
 }
 
 struct OrderItem {
@@ -27,32 +42,104 @@ struct OrderItem {
     2: i64 user_id
     3: string user_name
     4: string address
-    5: i64 product_id
-    6: i64 stock_num
-    7: string product_snapshot
+    5: i64 product_id // 第一个订单行的商品, 兼容单商品订单
+    6: i64 stock_num // 同上
+    7: string product_snapshot // 同上
     8: Status status
     9: i64 create_time
     10: i64 update_time
+    11: i64 price // 同上
+    12: list<OrderLine> lines // 订单行
+    13: i64 total_price // 订单总价
+    14: i64 pay_time // 各状态的到达时间, 未到达时为 0
+    15: i64 ship_time
+    16: i64 deliver_time
+    17: i64 complete_time
+    18: i64 cancel_time
+    19: i64 refund_time
 }
+
+struct OrderLineReq {
+    1: required i64 product_id
+    2: optional i64 sku_id // 规格, 有规格的商品必传
+    3: required i64 stock_num
+}
+
 struct CreateOrderReq {
     1: required i64 user_id
     2: required string address
-    3: required i64 product_id
-    4: required i64 stock_num
+    3: optional i64 product_id // 单商品下单, 传 lines 时忽略
+    4: optional i64 stock_num
+    5: optional list<OrderLineReq> lines // 多商品下单, 同一商品规格的多行合并
+    6: optional bool from_cart // 结算购物车, 忽略 product_id 与 lines, 下单成功后移出购物车
+    7: optional string request_token // 客户端生成的幂等 token, 同一用户重复提交同一 token 返回已创建的订单
 }
 
 struct CreateOrderResp {
+    1: i64 order_id
     255: base.BaseResp BaseResp
 }
 
 struct CancelOrderReq {
     1: required i64 order_id
+    2: required i64 user_id // 调用者, 须为下单用户
 }
 
 struct CancelOrderResp {
     255: base.BaseResp BaseResp
 }
 
+struct PayOrderReq {
+    1: required i64 order_id
+    2: required i64 user_id // 调用者, 须为下单用户
+}
+
+struct PayOrderResp {
+    255: base.BaseResp BaseResp
+}
+
+struct ShipOrderReq {
+    1: required i64 order_id
+}
+
+struct ShipOrderResp {
+    255: base.BaseResp BaseResp
+}
+
+struct DeliverOrderReq {
+    1: required i64 order_id
+}
+
+struct DeliverOrderResp {
+    255: base.BaseResp BaseResp
+}
+
+struct ConfirmReceiptReq {
+    1: required i64 order_id
+    2: required i64 user_id // 调用者, 须为下单用户
+}
+
+struct ConfirmReceiptResp {
+    255: base.BaseResp BaseResp
+}
+
+struct RefundOrderReq {
+    1: required i64 order_id
+    2: required i64 user_id // 调用者, 须为下单用户
+}
+
+struct RefundOrderResp {
+    255: base.BaseResp BaseResp
+}
+
+struct ShopRefundOrderReq {
+    1: required i64 order_id
+}
+
+struct ShopRefundOrderResp {
+    255: base.BaseResp BaseResp
+}
+
 struct ListOrderReq {
     1: required i64 user_id
     2: optional Status status
@@ -65,6 +152,7 @@ struct ListOrderResp {
 
 struct GetOrderByIdReq {
     1: required i64 order_id
+    2: required i64 user_id // 调用者, 须为下单用户
 }
 
 struct GetOrderByIdResp {
@@ -72,9 +160,72 @@ struct GetOrderByIdResp {
     255: base.BaseResp BaseResp
 }
 
+struct CartItem {
+    1: i64 product_id
+    2: i64 sku_id // 0 为无规格
+    3: i64 stock_num
+    4: string name // 商品名
+    5: string pic
+    6: string edition // 规格的版本
+    7: i64 price // 当前单价, 有促销时为促销价
+    8: bool available // 商品已下架或删除时为 false, 不参与结算
+}
+
+struct AddCartItemReq {
+    1: required i64 user_id
+    2: required i64 product_id
+    3: optional i64 sku_id // 规格, 有规格的商品必传
+    4: required i64 stock_num // 在购物车已有数量上增加
+}
+
+struct AddCartItemResp {
+    255: base.BaseResp BaseResp
+}
+
+struct UpdateCartItemReq {
+    1: required i64 user_id
+    2: required i64 product_id
+    3: optional i64 sku_id
+    4: required i64 stock_num // 修改后的数量
+}
+
+struct UpdateCartItemResp {
+    255: base.BaseResp BaseResp
+}
+
+struct RemoveCartItemReq {
+    1: required i64 user_id
+    2: required i64 product_id
+    3: optional i64 sku_id
+}
+
+struct RemoveCartItemResp {
+    255: base.BaseResp BaseResp
+}
+
+struct GetCartReq {
+    1: required i64 user_id
+}
+
+struct GetCartResp {
+    1: list<CartItem> items
+    2: i64 total_price // 可结算商品的总价
+    255: base.BaseResp BaseResp
+}
+
 service OrderService {
     CreateOrderResp CreateOrder(1: CreateOrderReq req) // 创建订单
-    CancelOrderResp CancelOrder(1: CancelOrderReq req) // 取消订单
+    CancelOrderResp CancelOrder(1: CancelOrderReq req) // 取消待支付的订单, 返还库存
+    PayOrderResp PayOrder(1: PayOrderReq req) // 支付订单
+    ShipOrderResp ShipOrder(1: ShipOrderReq req) // 商家发货
+    DeliverOrderResp DeliverOrder(1: DeliverOrderReq req) // 商家标记送达
+    ConfirmReceiptResp ConfirmReceipt(1: ConfirmReceiptReq req) // 用户确认收货, 订单完成
+    RefundOrderResp RefundOrder(1: RefundOrderReq req) // 用户退款, 只限已支付未发货的订单, 返还库存
+    ShopRefundOrderResp ShopRefundOrder(1: ShopRefundOrderReq req) // 商家退款, 已发货或已送达的订单也可退款
     ListOrderResp ListOrder(1: ListOrderReq req) // 订单列表
     GetOrderByIdResp GetOrderById(1: GetOrderByIdReq req) // 订单详情
+    AddCartItemResp AddCartItem(1: AddCartItemReq req) // 加入购物车
+    UpdateCartItemResp UpdateCartItem(1: UpdateCartItemReq req) // 修改购物车数量
+    RemoveCartItemResp RemoveCartItem(1: RemoveCartItemReq req) // 移出购物车
+    GetCartResp GetCart(1: GetCartReq req) // 购物车
 }
@@ -1,4 +1,4 @@
-// Code generated by thriftgo (0.1.7). DO NOT EDIT.
+// Code generated by thriftgo (0.2.11). DO NOT EDIT.
 
 package base
 
@@ -9,9 +9,9 @@ import (
 )
 
 type BaseResp struct {
-	StatusMessage string            `thrift:"StatusMessage,1" json:"StatusMessage"`
-	StatusCode    int32             `thrift:"StatusCode,2" json:"StatusCode"`
-	Extra         map[string]string `thrift:"Extra,3" json:"Extra,omitempty"`
+	StatusMessage string            `thrift:"StatusMessage,1" frugal:"1,default,string" json:"StatusMessage"`
+	StatusCode    int32             `thrift:"StatusCode,2" frugal:"2,default,i32" json:"StatusCode"`
+	Extra         map[string]string `thrift:"Extra,3,optional" frugal:"3,optional,map<string:string>" json:"Extra,omitempty"`
 }
 
 func NewBaseResp() *BaseResp {
@@ -22,6 +22,14 @@ func NewBaseResp() *BaseResp {
 	}
 }
 
+func (p *BaseResp) InitDefault() {
+	*p = BaseResp{
+
+		StatusMessage: "",
+		StatusCode:    0,
+	}
+}
+
 func (p *BaseResp) GetStatusMessage() (v string) {
 	return p.StatusMessage
 }
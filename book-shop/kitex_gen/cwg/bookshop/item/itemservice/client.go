@@ -1,4 +1,4 @@
-// Code generated by Kitex v0.4.3. DO NOT EDIT.
+// Code generated by Kitex v0.6.1. DO NOT EDIT.
 
 package itemservice
 
@@ -16,12 +16,34 @@ type Client interface {
 	Delete(ctx context.Context, req *item.DeleteReq, callOptions ...callopt.Option) (r *item.DeleteResp, err error)
 	Online(ctx context.Context, req *item.OnlineReq, callOptions ...callopt.Option) (r *item.OnlineResp, err error)
 	Offline(ctx context.Context, req *item.OfflineReq, callOptions ...callopt.Option) (r *item.OfflineResp, err error)
+	ScheduleStateChange(ctx context.Context, req *item.ScheduleStateChangeReq, callOptions ...callopt.Option) (r *item.ScheduleStateChangeResp, err error)
+	CancelScheduledChange(ctx context.Context, req *item.CancelScheduledChangeReq, callOptions ...callopt.Option) (r *item.CancelScheduledChangeResp, err error)
+	SchedulePriceChange(ctx context.Context, req *item.SchedulePriceChangeReq, callOptions ...callopt.Option) (r *item.SchedulePriceChangeResp, err error)
+	GetPriceHistory(ctx context.Context, req *item.GetPriceHistoryReq, callOptions ...callopt.Option) (r *item.GetPriceHistoryResp, err error)
+	Review(ctx context.Context, req *item.ReviewReq, callOptions ...callopt.Option) (r *item.ReviewResp, err error)
+	GetLegalOperations(ctx context.Context, req *item.GetLegalOperationsReq, callOptions ...callopt.Option) (r *item.GetLegalOperationsResp, err error)
+	UploadProductMedia(ctx context.Context, req *item.UploadProductMediaReq, callOptions ...callopt.Option) (r *item.UploadProductMediaResp, err error)
+	SaveProductGallery(ctx context.Context, req *item.SaveProductGalleryReq, callOptions ...callopt.Option) (r *item.SaveProductGalleryResp, err error)
+	CreateCategory(ctx context.Context, req *item.CreateCategoryReq, callOptions ...callopt.Option) (r *item.CreateCategoryResp, err error)
+	UpdateCategory(ctx context.Context, req *item.UpdateCategoryReq, callOptions ...callopt.Option) (r *item.UpdateCategoryResp, err error)
+	DeleteCategory(ctx context.Context, req *item.DeleteCategoryReq, callOptions ...callopt.Option) (r *item.DeleteCategoryResp, err error)
+	GetCategoryTree(ctx context.Context, req *item.GetCategoryTreeReq, callOptions ...callopt.Option) (r *item.GetCategoryTreeResp, err error)
 	Get(ctx context.Context, req *item.GetReq, callOptions ...callopt.Option) (r *item.GetResp, err error)
 	MGet2C(ctx context.Context, req *item.MGet2CReq, callOptions ...callopt.Option) (r *item.MGet2CResp, err error)
 	Search(ctx context.Context, req *item.SearchReq, callOptions ...callopt.Option) (r *item.SearchResp, err error)
 	List(ctx context.Context, req *item.ListReq, callOptions ...callopt.Option) (r *item.ListResp, err error)
+	GetProductHistory(ctx context.Context, req *item.GetProductHistoryReq, callOptions ...callopt.Option) (r *item.GetProductHistoryResp, err error)
+	ListLowStock(ctx context.Context, req *item.ListLowStockReq, callOptions ...callopt.Option) (r *item.ListLowStockResp, err error)
+	SetLowStockThreshold(ctx context.Context, req *item.SetLowStockThresholdReq, callOptions ...callopt.Option) (r *item.SetLowStockThresholdResp, err error)
+	ImportProducts(ctx context.Context, req *item.ImportProductsReq, callOptions ...callopt.Option) (r *item.ImportProductsResp, err error)
+	GetImportJob(ctx context.Context, req *item.GetImportJobReq, callOptions ...callopt.Option) (r *item.GetImportJobResp, err error)
 	DecrStock(ctx context.Context, req *item.DecrStockReq, callOptions ...callopt.Option) (r *item.DecrStockResp, err error)
 	DecrStockRevert(ctx context.Context, req *item.DecrStockReq, callOptions ...callopt.Option) (r *item.DecrStockResp, err error)
+	BatchDecrStock(ctx context.Context, req *item.BatchDecrStockReq, callOptions ...callopt.Option) (r *item.BatchDecrStockResp, err error)
+	BatchDecrStockRevert(ctx context.Context, req *item.BatchDecrStockReq, callOptions ...callopt.Option) (r *item.BatchDecrStockResp, err error)
+	ReserveStock(ctx context.Context, req *item.ReserveStockReq, callOptions ...callopt.Option) (r *item.ReserveStockResp, err error)
+	ConfirmReservation(ctx context.Context, req *item.ConfirmReservationReq, callOptions ...callopt.Option) (r *item.ConfirmReservationResp, err error)
+	ReleaseReservation(ctx context.Context, req *item.ReleaseReservationReq, callOptions ...callopt.Option) (r *item.ReleaseReservationResp, err error)
 }
 
 // NewClient creates a client for the service defined in IDL.
@@ -78,6 +100,66 @@ func (p *kItemServiceClient) Offline(ctx context.Context, req *item.OfflineReq,
 	return p.kClient.Offline(ctx, req)
 }
 
+func (p *kItemServiceClient) ScheduleStateChange(ctx context.Context, req *item.ScheduleStateChangeReq, callOptions ...callopt.Option) (r *item.ScheduleStateChangeResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ScheduleStateChange(ctx, req)
+}
+
+func (p *kItemServiceClient) CancelScheduledChange(ctx context.Context, req *item.CancelScheduledChangeReq, callOptions ...callopt.Option) (r *item.CancelScheduledChangeResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CancelScheduledChange(ctx, req)
+}
+
+func (p *kItemServiceClient) SchedulePriceChange(ctx context.Context, req *item.SchedulePriceChangeReq, callOptions ...callopt.Option) (r *item.SchedulePriceChangeResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.SchedulePriceChange(ctx, req)
+}
+
+func (p *kItemServiceClient) GetPriceHistory(ctx context.Context, req *item.GetPriceHistoryReq, callOptions ...callopt.Option) (r *item.GetPriceHistoryResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetPriceHistory(ctx, req)
+}
+
+func (p *kItemServiceClient) Review(ctx context.Context, req *item.ReviewReq, callOptions ...callopt.Option) (r *item.ReviewResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.Review(ctx, req)
+}
+
+func (p *kItemServiceClient) GetLegalOperations(ctx context.Context, req *item.GetLegalOperationsReq, callOptions ...callopt.Option) (r *item.GetLegalOperationsResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetLegalOperations(ctx, req)
+}
+
+func (p *kItemServiceClient) UploadProductMedia(ctx context.Context, req *item.UploadProductMediaReq, callOptions ...callopt.Option) (r *item.UploadProductMediaResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.UploadProductMedia(ctx, req)
+}
+
+func (p *kItemServiceClient) SaveProductGallery(ctx context.Context, req *item.SaveProductGalleryReq, callOptions ...callopt.Option) (r *item.SaveProductGalleryResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.SaveProductGallery(ctx, req)
+}
+
+func (p *kItemServiceClient) CreateCategory(ctx context.Context, req *item.CreateCategoryReq, callOptions ...callopt.Option) (r *item.CreateCategoryResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CreateCategory(ctx, req)
+}
+
+func (p *kItemServiceClient) UpdateCategory(ctx context.Context, req *item.UpdateCategoryReq, callOptions ...callopt.Option) (r *item.UpdateCategoryResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.UpdateCategory(ctx, req)
+}
+
+func (p *kItemServiceClient) DeleteCategory(ctx context.Context, req *item.DeleteCategoryReq, callOptions ...callopt.Option) (r *item.DeleteCategoryResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.DeleteCategory(ctx, req)
+}
+
+func (p *kItemServiceClient) GetCategoryTree(ctx context.Context, req *item.GetCategoryTreeReq, callOptions ...callopt.Option) (r *item.GetCategoryTreeResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetCategoryTree(ctx, req)
+}
+
 func (p *kItemServiceClient) Get(ctx context.Context, req *item.GetReq, callOptions ...callopt.Option) (r *item.GetResp, err error) {
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.Get(ctx, req)
@@ -98,6 +180,31 @@ func (p *kItemServiceClient) List(ctx context.Context, req *item.ListReq, callOp
 	return p.kClient.List(ctx, req)
 }
 
+func (p *kItemServiceClient) GetProductHistory(ctx context.Context, req *item.GetProductHistoryReq, callOptions ...callopt.Option) (r *item.GetProductHistoryResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetProductHistory(ctx, req)
+}
+
+func (p *kItemServiceClient) ListLowStock(ctx context.Context, req *item.ListLowStockReq, callOptions ...callopt.Option) (r *item.ListLowStockResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListLowStock(ctx, req)
+}
+
+func (p *kItemServiceClient) SetLowStockThreshold(ctx context.Context, req *item.SetLowStockThresholdReq, callOptions ...callopt.Option) (r *item.SetLowStockThresholdResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.SetLowStockThreshold(ctx, req)
+}
+
+func (p *kItemServiceClient) ImportProducts(ctx context.Context, req *item.ImportProductsReq, callOptions ...callopt.Option) (r *item.ImportProductsResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ImportProducts(ctx, req)
+}
+
+func (p *kItemServiceClient) GetImportJob(ctx context.Context, req *item.GetImportJobReq, callOptions ...callopt.Option) (r *item.GetImportJobResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetImportJob(ctx, req)
+}
+
 func (p *kItemServiceClient) DecrStock(ctx context.Context, req *item.DecrStockReq, callOptions ...callopt.Option) (r *item.DecrStockResp, err error) {
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.DecrStock(ctx, req)
@@ -107,3 +214,28 @@ func (p *kItemServiceClient) DecrStockRevert(ctx context.Context, req *item.Decr
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.DecrStockRevert(ctx, req)
 }
+
+func (p *kItemServiceClient) BatchDecrStock(ctx context.Context, req *item.BatchDecrStockReq, callOptions ...callopt.Option) (r *item.BatchDecrStockResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.BatchDecrStock(ctx, req)
+}
+
+func (p *kItemServiceClient) BatchDecrStockRevert(ctx context.Context, req *item.BatchDecrStockReq, callOptions ...callopt.Option) (r *item.BatchDecrStockResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.BatchDecrStockRevert(ctx, req)
+}
+
+func (p *kItemServiceClient) ReserveStock(ctx context.Context, req *item.ReserveStockReq, callOptions ...callopt.Option) (r *item.ReserveStockResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ReserveStock(ctx, req)
+}
+
+func (p *kItemServiceClient) ConfirmReservation(ctx context.Context, req *item.ConfirmReservationReq, callOptions ...callopt.Option) (r *item.ConfirmReservationResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ConfirmReservation(ctx, req)
+}
+
+func (p *kItemServiceClient) ReleaseReservation(ctx context.Context, req *item.ReleaseReservationReq, callOptions ...callopt.Option) (r *item.ReleaseReservationResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ReleaseReservation(ctx, req)
+}
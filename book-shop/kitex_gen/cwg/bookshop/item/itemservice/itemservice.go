@@ -1,4 +1,4 @@
-// Code generated by Kitex v0.4.3. DO NOT EDIT.
+// Code generated by Kitex v0.6.1. DO NOT EDIT.
 
 package itemservice
 
@@ -19,17 +19,39 @@ func NewServiceInfo() *kitex.ServiceInfo {
 	serviceName := "ItemService"
 	handlerType := (*item.ItemService)(nil)
 	methods := map[string]kitex.MethodInfo{
-		"Add":             kitex.NewMethodInfo(addHandler, newItemServiceAddArgs, newItemServiceAddResult, false),
-		"Edit":            kitex.NewMethodInfo(editHandler, newItemServiceEditArgs, newItemServiceEditResult, false),
-		"Delete":          kitex.NewMethodInfo(deleteHandler, newItemServiceDeleteArgs, newItemServiceDeleteResult, false),
-		"Online":          kitex.NewMethodInfo(onlineHandler, newItemServiceOnlineArgs, newItemServiceOnlineResult, false),
-		"Offline":         kitex.NewMethodInfo(offlineHandler, newItemServiceOfflineArgs, newItemServiceOfflineResult, false),
-		"Get":             kitex.NewMethodInfo(getHandler, newItemServiceGetArgs, newItemServiceGetResult, false),
-		"MGet2C":          kitex.NewMethodInfo(mGet2CHandler, newItemServiceMGet2CArgs, newItemServiceMGet2CResult, false),
-		"Search":          kitex.NewMethodInfo(searchHandler, newItemServiceSearchArgs, newItemServiceSearchResult, false),
-		"List":            kitex.NewMethodInfo(listHandler, newItemServiceListArgs, newItemServiceListResult, false),
-		"DecrStock":       kitex.NewMethodInfo(decrStockHandler, newItemServiceDecrStockArgs, newItemServiceDecrStockResult, false),
-		"DecrStockRevert": kitex.NewMethodInfo(decrStockRevertHandler, newItemServiceDecrStockRevertArgs, newItemServiceDecrStockRevertResult, false),
+		"Add":                   kitex.NewMethodInfo(addHandler, newItemServiceAddArgs, newItemServiceAddResult, false),
+		"Edit":                  kitex.NewMethodInfo(editHandler, newItemServiceEditArgs, newItemServiceEditResult, false),
+		"Delete":                kitex.NewMethodInfo(deleteHandler, newItemServiceDeleteArgs, newItemServiceDeleteResult, false),
+		"Online":                kitex.NewMethodInfo(onlineHandler, newItemServiceOnlineArgs, newItemServiceOnlineResult, false),
+		"Offline":               kitex.NewMethodInfo(offlineHandler, newItemServiceOfflineArgs, newItemServiceOfflineResult, false),
+		"ScheduleStateChange":   kitex.NewMethodInfo(scheduleStateChangeHandler, newItemServiceScheduleStateChangeArgs, newItemServiceScheduleStateChangeResult, false),
+		"CancelScheduledChange": kitex.NewMethodInfo(cancelScheduledChangeHandler, newItemServiceCancelScheduledChangeArgs, newItemServiceCancelScheduledChangeResult, false),
+		"SchedulePriceChange":   kitex.NewMethodInfo(schedulePriceChangeHandler, newItemServiceSchedulePriceChangeArgs, newItemServiceSchedulePriceChangeResult, false),
+		"GetPriceHistory":       kitex.NewMethodInfo(getPriceHistoryHandler, newItemServiceGetPriceHistoryArgs, newItemServiceGetPriceHistoryResult, false),
+		"Review":                kitex.NewMethodInfo(reviewHandler, newItemServiceReviewArgs, newItemServiceReviewResult, false),
+		"GetLegalOperations":    kitex.NewMethodInfo(getLegalOperationsHandler, newItemServiceGetLegalOperationsArgs, newItemServiceGetLegalOperationsResult, false),
+		"UploadProductMedia":    kitex.NewMethodInfo(uploadProductMediaHandler, newItemServiceUploadProductMediaArgs, newItemServiceUploadProductMediaResult, false),
+		"SaveProductGallery":    kitex.NewMethodInfo(saveProductGalleryHandler, newItemServiceSaveProductGalleryArgs, newItemServiceSaveProductGalleryResult, false),
+		"CreateCategory":        kitex.NewMethodInfo(createCategoryHandler, newItemServiceCreateCategoryArgs, newItemServiceCreateCategoryResult, false),
+		"UpdateCategory":        kitex.NewMethodInfo(updateCategoryHandler, newItemServiceUpdateCategoryArgs, newItemServiceUpdateCategoryResult, false),
+		"DeleteCategory":        kitex.NewMethodInfo(deleteCategoryHandler, newItemServiceDeleteCategoryArgs, newItemServiceDeleteCategoryResult, false),
+		"GetCategoryTree":       kitex.NewMethodInfo(getCategoryTreeHandler, newItemServiceGetCategoryTreeArgs, newItemServiceGetCategoryTreeResult, false),
+		"Get":                   kitex.NewMethodInfo(getHandler, newItemServiceGetArgs, newItemServiceGetResult, false),
+		"MGet2C":                kitex.NewMethodInfo(mGet2CHandler, newItemServiceMGet2CArgs, newItemServiceMGet2CResult, false),
+		"Search":                kitex.NewMethodInfo(searchHandler, newItemServiceSearchArgs, newItemServiceSearchResult, false),
+		"List":                  kitex.NewMethodInfo(listHandler, newItemServiceListArgs, newItemServiceListResult, false),
+		"GetProductHistory":     kitex.NewMethodInfo(getProductHistoryHandler, newItemServiceGetProductHistoryArgs, newItemServiceGetProductHistoryResult, false),
+		"ListLowStock":          kitex.NewMethodInfo(listLowStockHandler, newItemServiceListLowStockArgs, newItemServiceListLowStockResult, false),
+		"SetLowStockThreshold":  kitex.NewMethodInfo(setLowStockThresholdHandler, newItemServiceSetLowStockThresholdArgs, newItemServiceSetLowStockThresholdResult, false),
+		"ImportProducts":        kitex.NewMethodInfo(importProductsHandler, newItemServiceImportProductsArgs, newItemServiceImportProductsResult, false),
+		"GetImportJob":          kitex.NewMethodInfo(getImportJobHandler, newItemServiceGetImportJobArgs, newItemServiceGetImportJobResult, false),
+		"DecrStock":             kitex.NewMethodInfo(decrStockHandler, newItemServiceDecrStockArgs, newItemServiceDecrStockResult, false),
+		"DecrStockRevert":       kitex.NewMethodInfo(decrStockRevertHandler, newItemServiceDecrStockRevertArgs, newItemServiceDecrStockRevertResult, false),
+		"BatchDecrStock":        kitex.NewMethodInfo(batchDecrStockHandler, newItemServiceBatchDecrStockArgs, newItemServiceBatchDecrStockResult, false),
+		"BatchDecrStockRevert":  kitex.NewMethodInfo(batchDecrStockRevertHandler, newItemServiceBatchDecrStockRevertArgs, newItemServiceBatchDecrStockRevertResult, false),
+		"ReserveStock":          kitex.NewMethodInfo(reserveStockHandler, newItemServiceReserveStockArgs, newItemServiceReserveStockResult, false),
+		"ConfirmReservation":    kitex.NewMethodInfo(confirmReservationHandler, newItemServiceConfirmReservationArgs, newItemServiceConfirmReservationResult, false),
+		"ReleaseReservation":    kitex.NewMethodInfo(releaseReservationHandler, newItemServiceReleaseReservationArgs, newItemServiceReleaseReservationResult, false),
 	}
 	extra := map[string]interface{}{
 		"PackageName": "item",
@@ -39,7 +61,7 @@ func NewServiceInfo() *kitex.ServiceInfo {
 		HandlerType:     handlerType,
 		Methods:         methods,
 		PayloadCodec:    kitex.Thrift,
-		KiteXGenVersion: "v0.4.3",
+		KiteXGenVersion: "v0.6.1",
 		Extra:           extra,
 	}
 	return svcInfo
@@ -135,6 +157,222 @@ func newItemServiceOfflineResult() interface{} {
 	return item.NewItemServiceOfflineResult()
 }
 
+func scheduleStateChangeHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*item.ItemServiceScheduleStateChangeArgs)
+	realResult := result.(*item.ItemServiceScheduleStateChangeResult)
+	success, err := handler.(item.ItemService).ScheduleStateChange(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newItemServiceScheduleStateChangeArgs() interface{} {
+	return item.NewItemServiceScheduleStateChangeArgs()
+}
+
+func newItemServiceScheduleStateChangeResult() interface{} {
+	return item.NewItemServiceScheduleStateChangeResult()
+}
+
+func cancelScheduledChangeHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*item.ItemServiceCancelScheduledChangeArgs)
+	realResult := result.(*item.ItemServiceCancelScheduledChangeResult)
+	success, err := handler.(item.ItemService).CancelScheduledChange(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newItemServiceCancelScheduledChangeArgs() interface{} {
+	return item.NewItemServiceCancelScheduledChangeArgs()
+}
+
+func newItemServiceCancelScheduledChangeResult() interface{} {
+	return item.NewItemServiceCancelScheduledChangeResult()
+}
+
+func schedulePriceChangeHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*item.ItemServiceSchedulePriceChangeArgs)
+	realResult := result.(*item.ItemServiceSchedulePriceChangeResult)
+	success, err := handler.(item.ItemService).SchedulePriceChange(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newItemServiceSchedulePriceChangeArgs() interface{} {
+	return item.NewItemServiceSchedulePriceChangeArgs()
+}
+
+func newItemServiceSchedulePriceChangeResult() interface{} {
+	return item.NewItemServiceSchedulePriceChangeResult()
+}
+
+func getPriceHistoryHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*item.ItemServiceGetPriceHistoryArgs)
+	realResult := result.(*item.ItemServiceGetPriceHistoryResult)
+	success, err := handler.(item.ItemService).GetPriceHistory(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newItemServiceGetPriceHistoryArgs() interface{} {
+	return item.NewItemServiceGetPriceHistoryArgs()
+}
+
+func newItemServiceGetPriceHistoryResult() interface{} {
+	return item.NewItemServiceGetPriceHistoryResult()
+}
+
+func reviewHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*item.ItemServiceReviewArgs)
+	realResult := result.(*item.ItemServiceReviewResult)
+	success, err := handler.(item.ItemService).Review(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newItemServiceReviewArgs() interface{} {
+	return item.NewItemServiceReviewArgs()
+}
+
+func newItemServiceReviewResult() interface{} {
+	return item.NewItemServiceReviewResult()
+}
+
+func getLegalOperationsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*item.ItemServiceGetLegalOperationsArgs)
+	realResult := result.(*item.ItemServiceGetLegalOperationsResult)
+	success, err := handler.(item.ItemService).GetLegalOperations(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newItemServiceGetLegalOperationsArgs() interface{} {
+	return item.NewItemServiceGetLegalOperationsArgs()
+}
+
+func newItemServiceGetLegalOperationsResult() interface{} {
+	return item.NewItemServiceGetLegalOperationsResult()
+}
+
+func uploadProductMediaHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*item.ItemServiceUploadProductMediaArgs)
+	realResult := result.(*item.ItemServiceUploadProductMediaResult)
+	success, err := handler.(item.ItemService).UploadProductMedia(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newItemServiceUploadProductMediaArgs() interface{} {
+	return item.NewItemServiceUploadProductMediaArgs()
+}
+
+func newItemServiceUploadProductMediaResult() interface{} {
+	return item.NewItemServiceUploadProductMediaResult()
+}
+
+func saveProductGalleryHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*item.ItemServiceSaveProductGalleryArgs)
+	realResult := result.(*item.ItemServiceSaveProductGalleryResult)
+	success, err := handler.(item.ItemService).SaveProductGallery(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newItemServiceSaveProductGalleryArgs() interface{} {
+	return item.NewItemServiceSaveProductGalleryArgs()
+}
+
+func newItemServiceSaveProductGalleryResult() interface{} {
+	return item.NewItemServiceSaveProductGalleryResult()
+}
+
+func createCategoryHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*item.ItemServiceCreateCategoryArgs)
+	realResult := result.(*item.ItemServiceCreateCategoryResult)
+	success, err := handler.(item.ItemService).CreateCategory(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newItemServiceCreateCategoryArgs() interface{} {
+	return item.NewItemServiceCreateCategoryArgs()
+}
+
+func newItemServiceCreateCategoryResult() interface{} {
+	return item.NewItemServiceCreateCategoryResult()
+}
+
+func updateCategoryHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*item.ItemServiceUpdateCategoryArgs)
+	realResult := result.(*item.ItemServiceUpdateCategoryResult)
+	success, err := handler.(item.ItemService).UpdateCategory(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newItemServiceUpdateCategoryArgs() interface{} {
+	return item.NewItemServiceUpdateCategoryArgs()
+}
+
+func newItemServiceUpdateCategoryResult() interface{} {
+	return item.NewItemServiceUpdateCategoryResult()
+}
+
+func deleteCategoryHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*item.ItemServiceDeleteCategoryArgs)
+	realResult := result.(*item.ItemServiceDeleteCategoryResult)
+	success, err := handler.(item.ItemService).DeleteCategory(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newItemServiceDeleteCategoryArgs() interface{} {
+	return item.NewItemServiceDeleteCategoryArgs()
+}
+
+func newItemServiceDeleteCategoryResult() interface{} {
+	return item.NewItemServiceDeleteCategoryResult()
+}
+
+func getCategoryTreeHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*item.ItemServiceGetCategoryTreeArgs)
+	realResult := result.(*item.ItemServiceGetCategoryTreeResult)
+	success, err := handler.(item.ItemService).GetCategoryTree(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newItemServiceGetCategoryTreeArgs() interface{} {
+	return item.NewItemServiceGetCategoryTreeArgs()
+}
+
+func newItemServiceGetCategoryTreeResult() interface{} {
+	return item.NewItemServiceGetCategoryTreeResult()
+}
+
 func getHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	realArg := arg.(*item.ItemServiceGetArgs)
 	realResult := result.(*item.ItemServiceGetResult)
@@ -207,6 +445,96 @@ func newItemServiceListResult() interface{} {
 	return item.NewItemServiceListResult()
 }
 
+func getProductHistoryHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*item.ItemServiceGetProductHistoryArgs)
+	realResult := result.(*item.ItemServiceGetProductHistoryResult)
+	success, err := handler.(item.ItemService).GetProductHistory(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newItemServiceGetProductHistoryArgs() interface{} {
+	return item.NewItemServiceGetProductHistoryArgs()
+}
+
+func newItemServiceGetProductHistoryResult() interface{} {
+	return item.NewItemServiceGetProductHistoryResult()
+}
+
+func listLowStockHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*item.ItemServiceListLowStockArgs)
+	realResult := result.(*item.ItemServiceListLowStockResult)
+	success, err := handler.(item.ItemService).ListLowStock(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newItemServiceListLowStockArgs() interface{} {
+	return item.NewItemServiceListLowStockArgs()
+}
+
+func newItemServiceListLowStockResult() interface{} {
+	return item.NewItemServiceListLowStockResult()
+}
+
+func setLowStockThresholdHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*item.ItemServiceSetLowStockThresholdArgs)
+	realResult := result.(*item.ItemServiceSetLowStockThresholdResult)
+	success, err := handler.(item.ItemService).SetLowStockThreshold(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newItemServiceSetLowStockThresholdArgs() interface{} {
+	return item.NewItemServiceSetLowStockThresholdArgs()
+}
+
+func newItemServiceSetLowStockThresholdResult() interface{} {
+	return item.NewItemServiceSetLowStockThresholdResult()
+}
+
+func importProductsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*item.ItemServiceImportProductsArgs)
+	realResult := result.(*item.ItemServiceImportProductsResult)
+	success, err := handler.(item.ItemService).ImportProducts(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newItemServiceImportProductsArgs() interface{} {
+	return item.NewItemServiceImportProductsArgs()
+}
+
+func newItemServiceImportProductsResult() interface{} {
+	return item.NewItemServiceImportProductsResult()
+}
+
+func getImportJobHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*item.ItemServiceGetImportJobArgs)
+	realResult := result.(*item.ItemServiceGetImportJobResult)
+	success, err := handler.(item.ItemService).GetImportJob(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newItemServiceGetImportJobArgs() interface{} {
+	return item.NewItemServiceGetImportJobArgs()
+}
+
+func newItemServiceGetImportJobResult() interface{} {
+	return item.NewItemServiceGetImportJobResult()
+}
+
 func decrStockHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	realArg := arg.(*item.ItemServiceDecrStockArgs)
 	realResult := result.(*item.ItemServiceDecrStockResult)
@@ -243,6 +571,96 @@ func newItemServiceDecrStockRevertResult() interface{} {
 	return item.NewItemServiceDecrStockRevertResult()
 }
 
+func batchDecrStockHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*item.ItemServiceBatchDecrStockArgs)
+	realResult := result.(*item.ItemServiceBatchDecrStockResult)
+	success, err := handler.(item.ItemService).BatchDecrStock(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newItemServiceBatchDecrStockArgs() interface{} {
+	return item.NewItemServiceBatchDecrStockArgs()
+}
+
+func newItemServiceBatchDecrStockResult() interface{} {
+	return item.NewItemServiceBatchDecrStockResult()
+}
+
+func batchDecrStockRevertHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*item.ItemServiceBatchDecrStockRevertArgs)
+	realResult := result.(*item.ItemServiceBatchDecrStockRevertResult)
+	success, err := handler.(item.ItemService).BatchDecrStockRevert(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newItemServiceBatchDecrStockRevertArgs() interface{} {
+	return item.NewItemServiceBatchDecrStockRevertArgs()
+}
+
+func newItemServiceBatchDecrStockRevertResult() interface{} {
+	return item.NewItemServiceBatchDecrStockRevertResult()
+}
+
+func reserveStockHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*item.ItemServiceReserveStockArgs)
+	realResult := result.(*item.ItemServiceReserveStockResult)
+	success, err := handler.(item.ItemService).ReserveStock(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newItemServiceReserveStockArgs() interface{} {
+	return item.NewItemServiceReserveStockArgs()
+}
+
+func newItemServiceReserveStockResult() interface{} {
+	return item.NewItemServiceReserveStockResult()
+}
+
+func confirmReservationHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*item.ItemServiceConfirmReservationArgs)
+	realResult := result.(*item.ItemServiceConfirmReservationResult)
+	success, err := handler.(item.ItemService).ConfirmReservation(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newItemServiceConfirmReservationArgs() interface{} {
+	return item.NewItemServiceConfirmReservationArgs()
+}
+
+func newItemServiceConfirmReservationResult() interface{} {
+	return item.NewItemServiceConfirmReservationResult()
+}
+
+func releaseReservationHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*item.ItemServiceReleaseReservationArgs)
+	realResult := result.(*item.ItemServiceReleaseReservationResult)
+	success, err := handler.(item.ItemService).ReleaseReservation(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newItemServiceReleaseReservationArgs() interface{} {
+	return item.NewItemServiceReleaseReservationArgs()
+}
+
+func newItemServiceReleaseReservationResult() interface{} {
+	return item.NewItemServiceReleaseReservationResult()
+}
+
 type kClient struct {
 	c client.Client
 }
@@ -303,6 +721,126 @@ func (p *kClient) Offline(ctx context.Context, req *item.OfflineReq) (r *item.Of
 	return _result.GetSuccess(), nil
 }
 
+func (p *kClient) ScheduleStateChange(ctx context.Context, req *item.ScheduleStateChangeReq) (r *item.ScheduleStateChangeResp, err error) {
+	var _args item.ItemServiceScheduleStateChangeArgs
+	_args.Req = req
+	var _result item.ItemServiceScheduleStateChangeResult
+	if err = p.c.Call(ctx, "ScheduleStateChange", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) CancelScheduledChange(ctx context.Context, req *item.CancelScheduledChangeReq) (r *item.CancelScheduledChangeResp, err error) {
+	var _args item.ItemServiceCancelScheduledChangeArgs
+	_args.Req = req
+	var _result item.ItemServiceCancelScheduledChangeResult
+	if err = p.c.Call(ctx, "CancelScheduledChange", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) SchedulePriceChange(ctx context.Context, req *item.SchedulePriceChangeReq) (r *item.SchedulePriceChangeResp, err error) {
+	var _args item.ItemServiceSchedulePriceChangeArgs
+	_args.Req = req
+	var _result item.ItemServiceSchedulePriceChangeResult
+	if err = p.c.Call(ctx, "SchedulePriceChange", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetPriceHistory(ctx context.Context, req *item.GetPriceHistoryReq) (r *item.GetPriceHistoryResp, err error) {
+	var _args item.ItemServiceGetPriceHistoryArgs
+	_args.Req = req
+	var _result item.ItemServiceGetPriceHistoryResult
+	if err = p.c.Call(ctx, "GetPriceHistory", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) Review(ctx context.Context, req *item.ReviewReq) (r *item.ReviewResp, err error) {
+	var _args item.ItemServiceReviewArgs
+	_args.Req = req
+	var _result item.ItemServiceReviewResult
+	if err = p.c.Call(ctx, "Review", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetLegalOperations(ctx context.Context, req *item.GetLegalOperationsReq) (r *item.GetLegalOperationsResp, err error) {
+	var _args item.ItemServiceGetLegalOperationsArgs
+	_args.Req = req
+	var _result item.ItemServiceGetLegalOperationsResult
+	if err = p.c.Call(ctx, "GetLegalOperations", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) UploadProductMedia(ctx context.Context, req *item.UploadProductMediaReq) (r *item.UploadProductMediaResp, err error) {
+	var _args item.ItemServiceUploadProductMediaArgs
+	_args.Req = req
+	var _result item.ItemServiceUploadProductMediaResult
+	if err = p.c.Call(ctx, "UploadProductMedia", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) SaveProductGallery(ctx context.Context, req *item.SaveProductGalleryReq) (r *item.SaveProductGalleryResp, err error) {
+	var _args item.ItemServiceSaveProductGalleryArgs
+	_args.Req = req
+	var _result item.ItemServiceSaveProductGalleryResult
+	if err = p.c.Call(ctx, "SaveProductGallery", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) CreateCategory(ctx context.Context, req *item.CreateCategoryReq) (r *item.CreateCategoryResp, err error) {
+	var _args item.ItemServiceCreateCategoryArgs
+	_args.Req = req
+	var _result item.ItemServiceCreateCategoryResult
+	if err = p.c.Call(ctx, "CreateCategory", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) UpdateCategory(ctx context.Context, req *item.UpdateCategoryReq) (r *item.UpdateCategoryResp, err error) {
+	var _args item.ItemServiceUpdateCategoryArgs
+	_args.Req = req
+	var _result item.ItemServiceUpdateCategoryResult
+	if err = p.c.Call(ctx, "UpdateCategory", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) DeleteCategory(ctx context.Context, req *item.DeleteCategoryReq) (r *item.DeleteCategoryResp, err error) {
+	var _args item.ItemServiceDeleteCategoryArgs
+	_args.Req = req
+	var _result item.ItemServiceDeleteCategoryResult
+	if err = p.c.Call(ctx, "DeleteCategory", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetCategoryTree(ctx context.Context, req *item.GetCategoryTreeReq) (r *item.GetCategoryTreeResp, err error) {
+	var _args item.ItemServiceGetCategoryTreeArgs
+	_args.Req = req
+	var _result item.ItemServiceGetCategoryTreeResult
+	if err = p.c.Call(ctx, "GetCategoryTree", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
 func (p *kClient) Get(ctx context.Context, req *item.GetReq) (r *item.GetResp, err error) {
 	var _args item.ItemServiceGetArgs
 	_args.Req = req
@@ -343,6 +881,56 @@ func (p *kClient) List(ctx context.Context, req *item.ListReq) (r *item.ListResp
 	return _result.GetSuccess(), nil
 }
 
+func (p *kClient) GetProductHistory(ctx context.Context, req *item.GetProductHistoryReq) (r *item.GetProductHistoryResp, err error) {
+	var _args item.ItemServiceGetProductHistoryArgs
+	_args.Req = req
+	var _result item.ItemServiceGetProductHistoryResult
+	if err = p.c.Call(ctx, "GetProductHistory", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListLowStock(ctx context.Context, req *item.ListLowStockReq) (r *item.ListLowStockResp, err error) {
+	var _args item.ItemServiceListLowStockArgs
+	_args.Req = req
+	var _result item.ItemServiceListLowStockResult
+	if err = p.c.Call(ctx, "ListLowStock", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) SetLowStockThreshold(ctx context.Context, req *item.SetLowStockThresholdReq) (r *item.SetLowStockThresholdResp, err error) {
+	var _args item.ItemServiceSetLowStockThresholdArgs
+	_args.Req = req
+	var _result item.ItemServiceSetLowStockThresholdResult
+	if err = p.c.Call(ctx, "SetLowStockThreshold", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ImportProducts(ctx context.Context, req *item.ImportProductsReq) (r *item.ImportProductsResp, err error) {
+	var _args item.ItemServiceImportProductsArgs
+	_args.Req = req
+	var _result item.ItemServiceImportProductsResult
+	if err = p.c.Call(ctx, "ImportProducts", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetImportJob(ctx context.Context, req *item.GetImportJobReq) (r *item.GetImportJobResp, err error) {
+	var _args item.ItemServiceGetImportJobArgs
+	_args.Req = req
+	var _result item.ItemServiceGetImportJobResult
+	if err = p.c.Call(ctx, "GetImportJob", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
 func (p *kClient) DecrStock(ctx context.Context, req *item.DecrStockReq) (r *item.DecrStockResp, err error) {
 	var _args item.ItemServiceDecrStockArgs
 	_args.Req = req
@@ -362,3 +950,53 @@ func (p *kClient) DecrStockRevert(ctx context.Context, req *item.DecrStockReq) (
 	}
 	return _result.GetSuccess(), nil
 }
+
+func (p *kClient) BatchDecrStock(ctx context.Context, req *item.BatchDecrStockReq) (r *item.BatchDecrStockResp, err error) {
+	var _args item.ItemServiceBatchDecrStockArgs
+	_args.Req = req
+	var _result item.ItemServiceBatchDecrStockResult
+	if err = p.c.Call(ctx, "BatchDecrStock", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) BatchDecrStockRevert(ctx context.Context, req *item.BatchDecrStockReq) (r *item.BatchDecrStockResp, err error) {
+	var _args item.ItemServiceBatchDecrStockRevertArgs
+	_args.Req = req
+	var _result item.ItemServiceBatchDecrStockRevertResult
+	if err = p.c.Call(ctx, "BatchDecrStockRevert", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ReserveStock(ctx context.Context, req *item.ReserveStockReq) (r *item.ReserveStockResp, err error) {
+	var _args item.ItemServiceReserveStockArgs
+	_args.Req = req
+	var _result item.ItemServiceReserveStockResult
+	if err = p.c.Call(ctx, "ReserveStock", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ConfirmReservation(ctx context.Context, req *item.ConfirmReservationReq) (r *item.ConfirmReservationResp, err error) {
+	var _args item.ItemServiceConfirmReservationArgs
+	_args.Req = req
+	var _result item.ItemServiceConfirmReservationResult
+	if err = p.c.Call(ctx, "ConfirmReservation", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ReleaseReservation(ctx context.Context, req *item.ReleaseReservationReq) (r *item.ReleaseReservationResp, err error) {
+	var _args item.ItemServiceReleaseReservationArgs
+	_args.Req = req
+	var _result item.ItemServiceReleaseReservationResult
+	if err = p.c.Call(ctx, "ReleaseReservation", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
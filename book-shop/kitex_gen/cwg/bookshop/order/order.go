@@ -1,4 +1,4 @@
-// Code generated by thriftgo (0.1.7). DO NOT EDIT.
+// Code generated by thriftgo (0.2.11). DO NOT EDIT.
 
 package order
 
@@ -15,37 +15,61 @@ import (
 type Status int64
 
 const (
-	Status_Finish  Status = 0
-	Status_Cancel  Status = 1
-	Status_Pending Status = 2
+	Status_Completed      Status = 0
+	Status_Cancelled      Status = 1
+	Status_Pending        Status = 2
+	Status_PendingPayment Status = 3
+	Status_Paid           Status = 4
+	Status_Shipped        Status = 5
+	Status_Delivered      Status = 6
+	Status_Refunded       Status = 7
 )
 
 func (p Status) String() string {
 	switch p {
-	case Status_Finish:
-		return "Finish"
-	case Status_Cancel:
-		return "Cancel"
+	case Status_Completed:
+		return "Completed"
+	case Status_Cancelled:
+		return "Cancelled"
 	case Status_Pending:
 		return "Pending"
+	case Status_PendingPayment:
+		return "PendingPayment"
+	case Status_Paid:
+		return "Paid"
+	case Status_Shipped:
+		return "Shipped"
+	case Status_Delivered:
+		return "Delivered"
+	case Status_Refunded:
+		return "Refunded"
 	}
 	return "<UNSET>"
 }
 
 func StatusFromString(s string) (Status, error) {
 	switch s {
-	case "Finish":
-		return Status_Finish, nil
-	case "Cancel":
-		return Status_Cancel, nil
+	case "Completed":
+		return Status_Completed, nil
+	case "Cancelled":
+		return Status_Cancelled, nil
 	case "Pending":
 		return Status_Pending, nil
+	case "PendingPayment":
+		return Status_PendingPayment, nil
+	case "Paid":
+		return Status_Paid, nil
+	case "Shipped":
+		return Status_Shipped, nil
+	case "Delivered":
+		return Status_Delivered, nil
+	case "Refunded":
+		return Status_Refunded, nil
 	}
 	return Status(0), fmt.Errorf("not a valid Status string")
 }
 
 func StatusPtr(v Status) *Status { return &v }
-
 func (p *Status) Scan(value interface{}) (err error) {
 	var result sql.NullInt64
 	err = result.Scan(value)
@@ -60,107 +84,66 @@ func (p *Status) Value() (driver.Value, error) {
 	return int64(*p), nil
 }
 
-type OrderItem struct {
-	OrderId         int64  `thrift:"order_id,1" json:"order_id"`
-	UserId          int64  `thrift:"user_id,2" json:"user_id"`
-	UserName        string `thrift:"user_name,3" json:"user_name"`
-	Address         string `thrift:"address,4" json:"address"`
-	ProductId       int64  `thrift:"product_id,5" json:"product_id"`
-	StockNum        int64  `thrift:"stock_num,6" json:"stock_num"`
-	ProductSnapshot string `thrift:"product_snapshot,7" json:"product_snapshot"`
-	Status          Status `thrift:"status,8" json:"status"`
-	CreateTime      int64  `thrift:"create_time,9" json:"create_time"`
-	UpdateTime      int64  `thrift:"update_time,10" json:"update_time"`
-}
-
-func NewOrderItem() *OrderItem {
-	return &OrderItem{}
-}
-
-func (p *OrderItem) GetOrderId() (v int64) {
-	return p.OrderId
-}
-
-func (p *OrderItem) GetUserId() (v int64) {
-	return p.UserId
+type OrderLine struct {
+	ProductId       int64  `thrift:"product_id,1" frugal:"1,default,i64" json:"product_id"`
+	SkuId           int64  `thrift:"sku_id,2" frugal:"2,default,i64" json:"sku_id"`
+	StockNum        int64  `thrift:"stock_num,3" frugal:"3,default,i64" json:"stock_num"`
+	Price           int64  `thrift:"price,4" frugal:"4,default,i64" json:"price"`
+	ProductSnapshot string `thrift:"product_snapshot,5" frugal:"5,default,string" json:"product_snapshot"`
 }
 
-func (p *OrderItem) GetUserName() (v string) {
-	return p.UserName
+func NewOrderLine() *OrderLine {
+	return &OrderLine{}
 }
 
-func (p *OrderItem) GetAddress() (v string) {
-	return p.Address
+func (p *OrderLine) InitDefault() {
+	*p = OrderLine{}
 }
 
-func (p *OrderItem) GetProductId() (v int64) {
+func (p *OrderLine) GetProductId() (v int64) {
 	return p.ProductId
 }
 
-func (p *OrderItem) GetStockNum() (v int64) {
-	return p.StockNum
-}
-
-func (p *OrderItem) GetProductSnapshot() (v string) {
-	return p.ProductSnapshot
+func (p *OrderLine) GetSkuId() (v int64) {
+	return p.SkuId
 }
 
-func (p *OrderItem) GetStatus() (v Status) {
-	return p.Status
+func (p *OrderLine) GetStockNum() (v int64) {
+	return p.StockNum
 }
 
-func (p *OrderItem) GetCreateTime() (v int64) {
-	return p.CreateTime
+func (p *OrderLine) GetPrice() (v int64) {
+	return p.Price
 }
 
-func (p *OrderItem) GetUpdateTime() (v int64) {
-	return p.UpdateTime
-}
-func (p *OrderItem) SetOrderId(val int64) {
-	p.OrderId = val
-}
-func (p *OrderItem) SetUserId(val int64) {
-	p.UserId = val
-}
-func (p *OrderItem) SetUserName(val string) {
-	p.UserName = val
-}
-func (p *OrderItem) SetAddress(val string) {
-	p.Address = val
+func (p *OrderLine) GetProductSnapshot() (v string) {
+	return p.ProductSnapshot
 }
-func (p *OrderItem) SetProductId(val int64) {
+func (p *OrderLine) SetProductId(val int64) {
 	p.ProductId = val
 }
-func (p *OrderItem) SetStockNum(val int64) {
-	p.StockNum = val
-}
-func (p *OrderItem) SetProductSnapshot(val string) {
-	p.ProductSnapshot = val
+func (p *OrderLine) SetSkuId(val int64) {
+	p.SkuId = val
 }
-func (p *OrderItem) SetStatus(val Status) {
-	p.Status = val
+func (p *OrderLine) SetStockNum(val int64) {
+	p.StockNum = val
 }
-func (p *OrderItem) SetCreateTime(val int64) {
-	p.CreateTime = val
+func (p *OrderLine) SetPrice(val int64) {
+	p.Price = val
 }
-func (p *OrderItem) SetUpdateTime(val int64) {
-	p.UpdateTime = val
+func (p *OrderLine) SetProductSnapshot(val string) {
+	p.ProductSnapshot = val
 }
 
-var fieldIDToName_OrderItem = map[int16]string{
-	1:  "order_id",
-	2:  "user_id",
-	3:  "user_name",
-	4:  "address",
-	5:  "product_id",
-	6:  "stock_num",
-	7:  "product_snapshot",
-	8:  "status",
-	9:  "create_time",
-	10: "update_time",
+var fieldIDToName_OrderLine = map[int16]string{
+	1: "product_id",
+	2: "sku_id",
+	3: "stock_num",
+	4: "price",
+	5: "product_snapshot",
 }
 
-func (p *OrderItem) Read(iprot thrift.TProtocol) (err error) {
+func (p *OrderLine) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -200,7 +183,7 @@ func (p *OrderItem) Read(iprot thrift.TProtocol) (err error) {
 				}
 			}
 		case 3:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -210,7 +193,7 @@ func (p *OrderItem) Read(iprot thrift.TProtocol) (err error) {
 				}
 			}
 		case 4:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField4(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -220,58 +203,8 @@ func (p *OrderItem) Read(iprot thrift.TProtocol) (err error) {
 				}
 			}
 		case 5:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField5(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 6:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField6(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 7:
 			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField7(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 8:
-			if fieldTypeId == thrift.I32 {
-				if err = p.ReadField8(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 9:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField9(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 10:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField10(iprot); err != nil {
+				if err = p.ReadField5(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else {
@@ -299,7 +232,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderItem[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderLine[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -309,61 +242,43 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *OrderItem) ReadField1(iprot thrift.TProtocol) error {
+func (p *OrderLine) ReadField1(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
-		p.OrderId = v
+		p.ProductId = v
 	}
 	return nil
 }
 
-func (p *OrderItem) ReadField2(iprot thrift.TProtocol) error {
+func (p *OrderLine) ReadField2(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
-		p.UserId = v
-	}
-	return nil
-}
-
-func (p *OrderItem) ReadField3(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		p.UserName = v
-	}
-	return nil
-}
-
-func (p *OrderItem) ReadField4(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		p.Address = v
+		p.SkuId = v
 	}
 	return nil
 }
 
-func (p *OrderItem) ReadField5(iprot thrift.TProtocol) error {
+func (p *OrderLine) ReadField3(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
-		p.ProductId = v
+		p.StockNum = v
 	}
 	return nil
 }
 
-func (p *OrderItem) ReadField6(iprot thrift.TProtocol) error {
+func (p *OrderLine) ReadField4(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
-		p.StockNum = v
+		p.Price = v
 	}
 	return nil
 }
 
-func (p *OrderItem) ReadField7(iprot thrift.TProtocol) error {
+func (p *OrderLine) ReadField5(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
@@ -372,36 +287,9 @@ func (p *OrderItem) ReadField7(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *OrderItem) ReadField8(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(); err != nil {
-		return err
-	} else {
-		p.Status = Status(v)
-	}
-	return nil
-}
-
-func (p *OrderItem) ReadField9(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		p.CreateTime = v
-	}
-	return nil
-}
-
-func (p *OrderItem) ReadField10(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		p.UpdateTime = v
-	}
-	return nil
-}
-
-func (p *OrderItem) Write(oprot thrift.TProtocol) (err error) {
+func (p *OrderLine) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("OrderItem"); err != nil {
+	if err = oprot.WriteStructBegin("OrderLine"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -425,26 +313,6 @@ func (p *OrderItem) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 5
 			goto WriteFieldError
 		}
-		if err = p.writeField6(oprot); err != nil {
-			fieldId = 6
-			goto WriteFieldError
-		}
-		if err = p.writeField7(oprot); err != nil {
-			fieldId = 7
-			goto WriteFieldError
-		}
-		if err = p.writeField8(oprot); err != nil {
-			fieldId = 8
-			goto WriteFieldError
-		}
-		if err = p.writeField9(oprot); err != nil {
-			fieldId = 9
-			goto WriteFieldError
-		}
-		if err = p.writeField10(oprot); err != nil {
-			fieldId = 10
-			goto WriteFieldError
-		}
 
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
@@ -464,11 +332,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *OrderItem) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("order_id", thrift.I64, 1); err != nil {
+func (p *OrderLine) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("product_id", thrift.I64, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.OrderId); err != nil {
+	if err := oprot.WriteI64(p.ProductId); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -481,11 +349,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *OrderItem) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("user_id", thrift.I64, 2); err != nil {
+func (p *OrderLine) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("sku_id", thrift.I64, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.UserId); err != nil {
+	if err := oprot.WriteI64(p.SkuId); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -498,11 +366,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *OrderItem) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("user_name", thrift.STRING, 3); err != nil {
+func (p *OrderLine) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("stock_num", thrift.I64, 3); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.UserName); err != nil {
+	if err := oprot.WriteI64(p.StockNum); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -515,11 +383,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *OrderItem) writeField4(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("address", thrift.STRING, 4); err != nil {
+func (p *OrderLine) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("price", thrift.I64, 4); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.Address); err != nil {
+	if err := oprot.WriteI64(p.Price); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -532,11 +400,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
 
-func (p *OrderItem) writeField5(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("product_id", thrift.I64, 5); err != nil {
+func (p *OrderLine) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("product_snapshot", thrift.STRING, 5); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.ProductId); err != nil {
+	if err := oprot.WriteString(p.ProductSnapshot); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -549,262 +417,262 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
 
-func (p *OrderItem) writeField6(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("stock_num", thrift.I64, 6); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.StockNum); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+func (p *OrderLine) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+	return fmt.Sprintf("OrderLine(%+v)", *p)
 }
 
-func (p *OrderItem) writeField7(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("product_snapshot", thrift.STRING, 7); err != nil {
-		goto WriteFieldBeginError
+func (p *OrderLine) DeepEqual(ano *OrderLine) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
 	}
-	if err := oprot.WriteString(p.ProductSnapshot); err != nil {
-		return err
+	if !p.Field1DeepEqual(ano.ProductId) {
+		return false
 	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+	if !p.Field2DeepEqual(ano.SkuId) {
+		return false
 	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
-}
-
-func (p *OrderItem) writeField8(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("status", thrift.I32, 8); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI32(int32(p.Status)); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
-}
-
-func (p *OrderItem) writeField9(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("create_time", thrift.I64, 9); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.CreateTime); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
-}
-
-func (p *OrderItem) writeField10(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("update_time", thrift.I64, 10); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.UpdateTime); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
-}
-
-func (p *OrderItem) String() string {
-	if p == nil {
-		return "<nil>"
-	}
-	return fmt.Sprintf("OrderItem(%+v)", *p)
-}
-
-func (p *OrderItem) DeepEqual(ano *OrderItem) bool {
-	if p == ano {
-		return true
-	} else if p == nil || ano == nil {
-		return false
-	}
-	if !p.Field1DeepEqual(ano.OrderId) {
-		return false
-	}
-	if !p.Field2DeepEqual(ano.UserId) {
-		return false
-	}
-	if !p.Field3DeepEqual(ano.UserName) {
-		return false
-	}
-	if !p.Field4DeepEqual(ano.Address) {
-		return false
-	}
-	if !p.Field5DeepEqual(ano.ProductId) {
-		return false
-	}
-	if !p.Field6DeepEqual(ano.StockNum) {
-		return false
-	}
-	if !p.Field7DeepEqual(ano.ProductSnapshot) {
-		return false
-	}
-	if !p.Field8DeepEqual(ano.Status) {
-		return false
-	}
-	if !p.Field9DeepEqual(ano.CreateTime) {
+	if !p.Field3DeepEqual(ano.StockNum) {
 		return false
 	}
-	if !p.Field10DeepEqual(ano.UpdateTime) {
+	if !p.Field4DeepEqual(ano.Price) {
 		return false
 	}
-	return true
-}
-
-func (p *OrderItem) Field1DeepEqual(src int64) bool {
-
-	if p.OrderId != src {
+	if !p.Field5DeepEqual(ano.ProductSnapshot) {
 		return false
 	}
 	return true
 }
-func (p *OrderItem) Field2DeepEqual(src int64) bool {
 
-	if p.UserId != src {
-		return false
-	}
-	return true
-}
-func (p *OrderItem) Field3DeepEqual(src string) bool {
+func (p *OrderLine) Field1DeepEqual(src int64) bool {
 
-	if strings.Compare(p.UserName, src) != 0 {
+	if p.ProductId != src {
 		return false
 	}
 	return true
 }
-func (p *OrderItem) Field4DeepEqual(src string) bool {
+func (p *OrderLine) Field2DeepEqual(src int64) bool {
 
-	if strings.Compare(p.Address, src) != 0 {
+	if p.SkuId != src {
 		return false
 	}
 	return true
 }
-func (p *OrderItem) Field5DeepEqual(src int64) bool {
+func (p *OrderLine) Field3DeepEqual(src int64) bool {
 
-	if p.ProductId != src {
+	if p.StockNum != src {
 		return false
 	}
 	return true
 }
-func (p *OrderItem) Field6DeepEqual(src int64) bool {
+func (p *OrderLine) Field4DeepEqual(src int64) bool {
 
-	if p.StockNum != src {
+	if p.Price != src {
 		return false
 	}
 	return true
 }
-func (p *OrderItem) Field7DeepEqual(src string) bool {
+func (p *OrderLine) Field5DeepEqual(src string) bool {
 
 	if strings.Compare(p.ProductSnapshot, src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *OrderItem) Field8DeepEqual(src Status) bool {
 
-	if p.Status != src {
-		return false
-	}
-	return true
+type OrderItem struct {
+	OrderId         int64        `thrift:"order_id,1" frugal:"1,default,i64" json:"order_id"`
+	UserId          int64        `thrift:"user_id,2" frugal:"2,default,i64" json:"user_id"`
+	UserName        string       `thrift:"user_name,3" frugal:"3,default,string" json:"user_name"`
+	Address         string       `thrift:"address,4" frugal:"4,default,string" json:"address"`
+	ProductId       int64        `thrift:"product_id,5" frugal:"5,default,i64" json:"product_id"`
+	StockNum        int64        `thrift:"stock_num,6" frugal:"6,default,i64" json:"stock_num"`
+	ProductSnapshot string       `thrift:"product_snapshot,7" frugal:"7,default,string" json:"product_snapshot"`
+	Status          Status       `thrift:"status,8" frugal:"8,default,Status" json:"status"`
+	CreateTime      int64        `thrift:"create_time,9" frugal:"9,default,i64" json:"create_time"`
+	UpdateTime      int64        `thrift:"update_time,10" frugal:"10,default,i64" json:"update_time"`
+	Price           int64        `thrift:"price,11" frugal:"11,default,i64" json:"price"`
+	Lines           []*OrderLine `thrift:"lines,12" frugal:"12,default,list<OrderLine>" json:"lines"`
+	TotalPrice      int64        `thrift:"total_price,13" frugal:"13,default,i64" json:"total_price"`
+	PayTime         int64        `thrift:"pay_time,14" frugal:"14,default,i64" json:"pay_time"`
+	ShipTime        int64        `thrift:"ship_time,15" frugal:"15,default,i64" json:"ship_time"`
+	DeliverTime     int64        `thrift:"deliver_time,16" frugal:"16,default,i64" json:"deliver_time"`
+	CompleteTime    int64        `thrift:"complete_time,17" frugal:"17,default,i64" json:"complete_time"`
+	CancelTime      int64        `thrift:"cancel_time,18" frugal:"18,default,i64" json:"cancel_time"`
+	RefundTime      int64        `thrift:"refund_time,19" frugal:"19,default,i64" json:"refund_time"`
 }
-func (p *OrderItem) Field9DeepEqual(src int64) bool {
 
-	if p.CreateTime != src {
-		return false
-	}
-	return true
+func NewOrderItem() *OrderItem {
+	return &OrderItem{}
 }
-func (p *OrderItem) Field10DeepEqual(src int64) bool {
 
-	if p.UpdateTime != src {
-		return false
-	}
-	return true
+func (p *OrderItem) InitDefault() {
+	*p = OrderItem{}
 }
 
-type CreateOrderReq struct {
-	UserId    int64  `thrift:"user_id,1,required" json:"user_id"`
-	Address   string `thrift:"address,2,required" json:"address"`
-	ProductId int64  `thrift:"product_id,3,required" json:"product_id"`
-	StockNum  int64  `thrift:"stock_num,4,required" json:"stock_num"`
+func (p *OrderItem) GetOrderId() (v int64) {
+	return p.OrderId
 }
 
-func NewCreateOrderReq() *CreateOrderReq {
-	return &CreateOrderReq{}
+func (p *OrderItem) GetUserId() (v int64) {
+	return p.UserId
 }
 
-func (p *CreateOrderReq) GetUserId() (v int64) {
-	return p.UserId
+func (p *OrderItem) GetUserName() (v string) {
+	return p.UserName
 }
 
-func (p *CreateOrderReq) GetAddress() (v string) {
+func (p *OrderItem) GetAddress() (v string) {
 	return p.Address
 }
 
-func (p *CreateOrderReq) GetProductId() (v int64) {
+func (p *OrderItem) GetProductId() (v int64) {
 	return p.ProductId
 }
 
-func (p *CreateOrderReq) GetStockNum() (v int64) {
+func (p *OrderItem) GetStockNum() (v int64) {
 	return p.StockNum
 }
-func (p *CreateOrderReq) SetUserId(val int64) {
+
+func (p *OrderItem) GetProductSnapshot() (v string) {
+	return p.ProductSnapshot
+}
+
+func (p *OrderItem) GetStatus() (v Status) {
+	return p.Status
+}
+
+func (p *OrderItem) GetCreateTime() (v int64) {
+	return p.CreateTime
+}
+
+func (p *OrderItem) GetUpdateTime() (v int64) {
+	return p.UpdateTime
+}
+
+func (p *OrderItem) GetPrice() (v int64) {
+	return p.Price
+}
+
+func (p *OrderItem) GetLines() (v []*OrderLine) {
+	return p.Lines
+}
+
+func (p *OrderItem) GetTotalPrice() (v int64) {
+	return p.TotalPrice
+}
+
+func (p *OrderItem) GetPayTime() (v int64) {
+	return p.PayTime
+}
+
+func (p *OrderItem) GetShipTime() (v int64) {
+	return p.ShipTime
+}
+
+func (p *OrderItem) GetDeliverTime() (v int64) {
+	return p.DeliverTime
+}
+
+func (p *OrderItem) GetCompleteTime() (v int64) {
+	return p.CompleteTime
+}
+
+func (p *OrderItem) GetCancelTime() (v int64) {
+	return p.CancelTime
+}
+
+func (p *OrderItem) GetRefundTime() (v int64) {
+	return p.RefundTime
+}
+func (p *OrderItem) SetOrderId(val int64) {
+	p.OrderId = val
+}
+func (p *OrderItem) SetUserId(val int64) {
 	p.UserId = val
 }
-func (p *CreateOrderReq) SetAddress(val string) {
+func (p *OrderItem) SetUserName(val string) {
+	p.UserName = val
+}
+func (p *OrderItem) SetAddress(val string) {
 	p.Address = val
 }
-func (p *CreateOrderReq) SetProductId(val int64) {
+func (p *OrderItem) SetProductId(val int64) {
 	p.ProductId = val
 }
-func (p *CreateOrderReq) SetStockNum(val int64) {
+func (p *OrderItem) SetStockNum(val int64) {
 	p.StockNum = val
 }
+func (p *OrderItem) SetProductSnapshot(val string) {
+	p.ProductSnapshot = val
+}
+func (p *OrderItem) SetStatus(val Status) {
+	p.Status = val
+}
+func (p *OrderItem) SetCreateTime(val int64) {
+	p.CreateTime = val
+}
+func (p *OrderItem) SetUpdateTime(val int64) {
+	p.UpdateTime = val
+}
+func (p *OrderItem) SetPrice(val int64) {
+	p.Price = val
+}
+func (p *OrderItem) SetLines(val []*OrderLine) {
+	p.Lines = val
+}
+func (p *OrderItem) SetTotalPrice(val int64) {
+	p.TotalPrice = val
+}
+func (p *OrderItem) SetPayTime(val int64) {
+	p.PayTime = val
+}
+func (p *OrderItem) SetShipTime(val int64) {
+	p.ShipTime = val
+}
+func (p *OrderItem) SetDeliverTime(val int64) {
+	p.DeliverTime = val
+}
+func (p *OrderItem) SetCompleteTime(val int64) {
+	p.CompleteTime = val
+}
+func (p *OrderItem) SetCancelTime(val int64) {
+	p.CancelTime = val
+}
+func (p *OrderItem) SetRefundTime(val int64) {
+	p.RefundTime = val
+}
 
-var fieldIDToName_CreateOrderReq = map[int16]string{
-	1: "user_id",
-	2: "address",
-	3: "product_id",
-	4: "stock_num",
+var fieldIDToName_OrderItem = map[int16]string{
+	1:  "order_id",
+	2:  "user_id",
+	3:  "user_name",
+	4:  "address",
+	5:  "product_id",
+	6:  "stock_num",
+	7:  "product_snapshot",
+	8:  "status",
+	9:  "create_time",
+	10: "update_time",
+	11: "price",
+	12: "lines",
+	13: "total_price",
+	14: "pay_time",
+	15: "ship_time",
+	16: "deliver_time",
+	17: "complete_time",
+	18: "cancel_time",
+	19: "refund_time",
 }
 
-func (p *CreateOrderReq) Read(iprot thrift.TProtocol) (err error) {
+func (p *OrderItem) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetUserId bool = false
-	var issetAddress bool = false
-	var issetProductId bool = false
-	var issetStockNum bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -825,50 +693,196 @@ func (p *CreateOrderReq) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetUserId = true
 			} else {
 				if err = iprot.Skip(fieldTypeId); err != nil {
 					goto SkipFieldError
 				}
 			}
 		case 2:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetAddress = true
 			} else {
 				if err = iprot.Skip(fieldTypeId); err != nil {
 					goto SkipFieldError
 				}
 			}
 		case 3:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetProductId = true
 			} else {
 				if err = iprot.Skip(fieldTypeId); err != nil {
 					goto SkipFieldError
 				}
 			}
 		case 4:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField4(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetStockNum = true
 			} else {
 				if err = iprot.Skip(fieldTypeId); err != nil {
 					goto SkipFieldError
 				}
 			}
-		default:
-			if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		}
+		case 5:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 6:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 7:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 8:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 9:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField9(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 10:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField10(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 11:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField11(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 12:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField12(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 13:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField13(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 14:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField14(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 15:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField15(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 16:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField16(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 17:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField17(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 18:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField18(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 19:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField19(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
 
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
@@ -878,32 +892,13 @@ func (p *CreateOrderReq) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
-	if !issetUserId {
-		fieldId = 1
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetAddress {
-		fieldId = 2
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetProductId {
-		fieldId = 3
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetStockNum {
-		fieldId = 4
-		goto RequiredFieldNotSetError
-	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CreateOrderReq[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderItem[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -911,11 +906,18 @@ ReadFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
-RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_CreateOrderReq[fieldId]))
 }
 
-func (p *CreateOrderReq) ReadField1(iprot thrift.TProtocol) error {
+func (p *OrderItem) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		p.OrderId = v
+	}
+	return nil
+}
+
+func (p *OrderItem) ReadField2(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
@@ -924,7 +926,16 @@ func (p *CreateOrderReq) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *CreateOrderReq) ReadField2(iprot thrift.TProtocol) error {
+func (p *OrderItem) ReadField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.UserName = v
+	}
+	return nil
+}
+
+func (p *OrderItem) ReadField4(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
@@ -933,7 +944,7 @@ func (p *CreateOrderReq) ReadField2(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *CreateOrderReq) ReadField3(iprot thrift.TProtocol) error {
+func (p *OrderItem) ReadField5(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
@@ -942,7 +953,7 @@ func (p *CreateOrderReq) ReadField3(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *CreateOrderReq) ReadField4(iprot thrift.TProtocol) error {
+func (p *OrderItem) ReadField6(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
@@ -951,275 +962,214 @@ func (p *CreateOrderReq) ReadField4(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *CreateOrderReq) Write(oprot thrift.TProtocol) (err error) {
-	var fieldId int16
-	if err = oprot.WriteStructBegin("CreateOrderReq"); err != nil {
-		goto WriteStructBeginError
-	}
-	if p != nil {
-		if err = p.writeField1(oprot); err != nil {
-			fieldId = 1
-			goto WriteFieldError
-		}
-		if err = p.writeField2(oprot); err != nil {
-			fieldId = 2
-			goto WriteFieldError
-		}
-		if err = p.writeField3(oprot); err != nil {
-			fieldId = 3
-			goto WriteFieldError
-		}
-		if err = p.writeField4(oprot); err != nil {
-			fieldId = 4
-			goto WriteFieldError
-		}
-
-	}
-	if err = oprot.WriteFieldStop(); err != nil {
-		goto WriteFieldStopError
-	}
-	if err = oprot.WriteStructEnd(); err != nil {
-		goto WriteStructEndError
+func (p *OrderItem) ReadField7(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.ProductSnapshot = v
 	}
 	return nil
-WriteStructBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
-WriteFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
-WriteFieldStopError:
-	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
-WriteStructEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *CreateOrderReq) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("user_id", thrift.I64, 1); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.UserId); err != nil {
+func (p *OrderItem) ReadField8(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
 		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+	} else {
+		p.Status = Status(v)
 	}
 	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *CreateOrderReq) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("address", thrift.STRING, 2); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.Address); err != nil {
+func (p *OrderItem) ReadField9(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+	} else {
+		p.CreateTime = v
 	}
 	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *CreateOrderReq) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("product_id", thrift.I64, 3); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.ProductId); err != nil {
+func (p *OrderItem) ReadField10(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+	} else {
+		p.UpdateTime = v
 	}
 	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *CreateOrderReq) writeField4(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("stock_num", thrift.I64, 4); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.StockNum); err != nil {
+func (p *OrderItem) ReadField11(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+	} else {
+		p.Price = v
 	}
 	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
 
-func (p *CreateOrderReq) String() string {
-	if p == nil {
-		return "<nil>"
+func (p *OrderItem) ReadField12(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
 	}
-	return fmt.Sprintf("CreateOrderReq(%+v)", *p)
-}
+	p.Lines = make([]*OrderLine, 0, size)
+	for i := 0; i < size; i++ {
+		_elem := NewOrderLine()
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
 
-func (p *CreateOrderReq) DeepEqual(ano *CreateOrderReq) bool {
-	if p == ano {
-		return true
-	} else if p == nil || ano == nil {
-		return false
-	}
-	if !p.Field1DeepEqual(ano.UserId) {
-		return false
-	}
-	if !p.Field2DeepEqual(ano.Address) {
-		return false
+		p.Lines = append(p.Lines, _elem)
 	}
-	if !p.Field3DeepEqual(ano.ProductId) {
-		return false
-	}
-	if !p.Field4DeepEqual(ano.StockNum) {
-		return false
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
 	}
-	return true
+	return nil
 }
 
-func (p *CreateOrderReq) Field1DeepEqual(src int64) bool {
-
-	if p.UserId != src {
-		return false
+func (p *OrderItem) ReadField13(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		p.TotalPrice = v
 	}
-	return true
+	return nil
 }
-func (p *CreateOrderReq) Field2DeepEqual(src string) bool {
 
-	if strings.Compare(p.Address, src) != 0 {
-		return false
+func (p *OrderItem) ReadField14(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		p.PayTime = v
 	}
-	return true
+	return nil
 }
-func (p *CreateOrderReq) Field3DeepEqual(src int64) bool {
 
-	if p.ProductId != src {
-		return false
+func (p *OrderItem) ReadField15(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		p.ShipTime = v
 	}
-	return true
+	return nil
 }
-func (p *CreateOrderReq) Field4DeepEqual(src int64) bool {
 
-	if p.StockNum != src {
-		return false
+func (p *OrderItem) ReadField16(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		p.DeliverTime = v
 	}
-	return true
-}
-
-type CreateOrderResp struct {
-	BaseResp *base.BaseResp `thrift:"BaseResp,255" json:"BaseResp"`
-}
-
-func NewCreateOrderResp() *CreateOrderResp {
-	return &CreateOrderResp{}
+	return nil
 }
 
-var CreateOrderResp_BaseResp_DEFAULT *base.BaseResp
-
-func (p *CreateOrderResp) GetBaseResp() (v *base.BaseResp) {
-	if !p.IsSetBaseResp() {
-		return CreateOrderResp_BaseResp_DEFAULT
+func (p *OrderItem) ReadField17(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		p.CompleteTime = v
 	}
-	return p.BaseResp
-}
-func (p *CreateOrderResp) SetBaseResp(val *base.BaseResp) {
-	p.BaseResp = val
-}
-
-var fieldIDToName_CreateOrderResp = map[int16]string{
-	255: "BaseResp",
-}
-
-func (p *CreateOrderResp) IsSetBaseResp() bool {
-	return p.BaseResp != nil
+	return nil
 }
 
-func (p *CreateOrderResp) Read(iprot thrift.TProtocol) (err error) {
-
-	var fieldTypeId thrift.TType
-	var fieldId int16
-
-	if _, err = iprot.ReadStructBegin(); err != nil {
-		goto ReadStructBeginError
-	}
-
-	for {
-		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
-		if err != nil {
-			goto ReadFieldBeginError
-		}
-		if fieldTypeId == thrift.STOP {
-			break
-		}
-
-		switch fieldId {
-		case 255:
-			if fieldTypeId == thrift.STRUCT {
-				if err = p.ReadField255(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
-			}
-		default:
-			if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		}
-
-		if err = iprot.ReadFieldEnd(); err != nil {
-			goto ReadFieldEndError
-		}
-	}
-	if err = iprot.ReadStructEnd(); err != nil {
-		goto ReadStructEndError
+func (p *OrderItem) ReadField18(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		p.CancelTime = v
 	}
-
 	return nil
-ReadStructBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
-ReadFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
-ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CreateOrderResp[fieldId]), err)
-SkipFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
-
-ReadFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
-ReadStructEndError:
-	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *CreateOrderResp) ReadField255(iprot thrift.TProtocol) error {
-	p.BaseResp = base.NewBaseResp()
-	if err := p.BaseResp.Read(iprot); err != nil {
+func (p *OrderItem) ReadField19(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
+	} else {
+		p.RefundTime = v
 	}
 	return nil
 }
 
-func (p *CreateOrderResp) Write(oprot thrift.TProtocol) (err error) {
+func (p *OrderItem) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("CreateOrderResp"); err != nil {
+	if err = oprot.WriteStructBegin("OrderItem"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
-		if err = p.writeField255(oprot); err != nil {
-			fieldId = 255
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+		if err = p.writeField9(oprot); err != nil {
+			fieldId = 9
+			goto WriteFieldError
+		}
+		if err = p.writeField10(oprot); err != nil {
+			fieldId = 10
+			goto WriteFieldError
+		}
+		if err = p.writeField11(oprot); err != nil {
+			fieldId = 11
+			goto WriteFieldError
+		}
+		if err = p.writeField12(oprot); err != nil {
+			fieldId = 12
+			goto WriteFieldError
+		}
+		if err = p.writeField13(oprot); err != nil {
+			fieldId = 13
+			goto WriteFieldError
+		}
+		if err = p.writeField14(oprot); err != nil {
+			fieldId = 14
+			goto WriteFieldError
+		}
+		if err = p.writeField15(oprot); err != nil {
+			fieldId = 15
+			goto WriteFieldError
+		}
+		if err = p.writeField16(oprot); err != nil {
+			fieldId = 16
+			goto WriteFieldError
+		}
+		if err = p.writeField17(oprot); err != nil {
+			fieldId = 17
+			goto WriteFieldError
+		}
+		if err = p.writeField18(oprot); err != nil {
+			fieldId = 18
+			goto WriteFieldError
+		}
+		if err = p.writeField19(oprot); err != nil {
+			fieldId = 19
 			goto WriteFieldError
 		}
 
@@ -1241,11 +1191,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *CreateOrderResp) writeField255(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("BaseResp", thrift.STRUCT, 255); err != nil {
+func (p *OrderItem) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("order_id", thrift.I64, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := p.BaseResp.Write(oprot); err != nil {
+	if err := oprot.WriteI64(p.OrderId); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -1253,167 +1203,101 @@ func (p *CreateOrderResp) writeField255(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *CreateOrderResp) String() string {
-	if p == nil {
-		return "<nil>"
+func (p *OrderItem) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
 	}
-	return fmt.Sprintf("CreateOrderResp(%+v)", *p)
-}
-
-func (p *CreateOrderResp) DeepEqual(ano *CreateOrderResp) bool {
-	if p == ano {
-		return true
-	} else if p == nil || ano == nil {
-		return false
+	if err := oprot.WriteI64(p.UserId); err != nil {
+		return err
 	}
-	if !p.Field255DeepEqual(ano.BaseResp) {
-		return false
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
-	return true
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *CreateOrderResp) Field255DeepEqual(src *base.BaseResp) bool {
-
-	if !p.BaseResp.DeepEqual(src) {
-		return false
+func (p *OrderItem) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_name", thrift.STRING, 3); err != nil {
+		goto WriteFieldBeginError
 	}
-	return true
-}
-
-type CancelOrderReq struct {
-	OrderId int64 `thrift:"order_id,1,required" json:"order_id"`
-}
-
-func NewCancelOrderReq() *CancelOrderReq {
-	return &CancelOrderReq{}
-}
-
-func (p *CancelOrderReq) GetOrderId() (v int64) {
-	return p.OrderId
-}
-func (p *CancelOrderReq) SetOrderId(val int64) {
-	p.OrderId = val
-}
-
-var fieldIDToName_CancelOrderReq = map[int16]string{
-	1: "order_id",
-}
-
-func (p *CancelOrderReq) Read(iprot thrift.TProtocol) (err error) {
-
-	var fieldTypeId thrift.TType
-	var fieldId int16
-	var issetOrderId bool = false
-
-	if _, err = iprot.ReadStructBegin(); err != nil {
-		goto ReadStructBeginError
+	if err := oprot.WriteString(p.UserName); err != nil {
+		return err
 	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
 
-	for {
-		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
-		if err != nil {
-			goto ReadFieldBeginError
-		}
-		if fieldTypeId == thrift.STOP {
-			break
-		}
-
-		switch fieldId {
-		case 1:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField1(iprot); err != nil {
-					goto ReadFieldError
-				}
-				issetOrderId = true
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
-			}
-		default:
-			if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		}
-
-		if err = iprot.ReadFieldEnd(); err != nil {
-			goto ReadFieldEndError
-		}
+func (p *OrderItem) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("address", thrift.STRING, 4); err != nil {
+		goto WriteFieldBeginError
 	}
-	if err = iprot.ReadStructEnd(); err != nil {
-		goto ReadStructEndError
+	if err := oprot.WriteString(p.Address); err != nil {
+		return err
 	}
-
-	if !issetOrderId {
-		fieldId = 1
-		goto RequiredFieldNotSetError
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
 	return nil
-ReadStructBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
-ReadFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
-ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CancelOrderReq[fieldId]), err)
-SkipFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
-
-ReadFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
-ReadStructEndError:
-	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
-RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_CancelOrderReq[fieldId]))
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
 
-func (p *CancelOrderReq) ReadField1(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI64(); err != nil {
+func (p *OrderItem) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("product_id", thrift.I64, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.ProductId); err != nil {
 		return err
-	} else {
-		p.OrderId = v
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
 	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
 
-func (p *CancelOrderReq) Write(oprot thrift.TProtocol) (err error) {
-	var fieldId int16
-	if err = oprot.WriteStructBegin("CancelOrderReq"); err != nil {
-		goto WriteStructBeginError
-	}
-	if p != nil {
-		if err = p.writeField1(oprot); err != nil {
-			fieldId = 1
-			goto WriteFieldError
-		}
-
+func (p *OrderItem) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("stock_num", thrift.I64, 6); err != nil {
+		goto WriteFieldBeginError
 	}
-	if err = oprot.WriteFieldStop(); err != nil {
-		goto WriteFieldStopError
+	if err := oprot.WriteI64(p.StockNum); err != nil {
+		return err
 	}
-	if err = oprot.WriteStructEnd(); err != nil {
-		goto WriteStructEndError
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
 	return nil
-WriteStructBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
-WriteFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
-WriteFieldStopError:
-	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
-WriteStructEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
 }
 
-func (p *CancelOrderReq) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("order_id", thrift.I64, 1); err != nil {
+func (p *OrderItem) writeField7(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("product_snapshot", thrift.STRING, 7); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.OrderId); err != nil {
+	if err := oprot.WriteString(p.ProductSnapshot); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -1421,167 +1305,211 @@ func (p *CancelOrderReq) writeField1(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
 }
 
-func (p *CancelOrderReq) String() string {
-	if p == nil {
-		return "<nil>"
+func (p *OrderItem) writeField8(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status", thrift.I32, 8); err != nil {
+		goto WriteFieldBeginError
 	}
-	return fmt.Sprintf("CancelOrderReq(%+v)", *p)
+	if err := oprot.WriteI32(int32(p.Status)); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
 }
 
-func (p *CancelOrderReq) DeepEqual(ano *CancelOrderReq) bool {
-	if p == ano {
-		return true
-	} else if p == nil || ano == nil {
-		return false
+func (p *OrderItem) writeField9(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("create_time", thrift.I64, 9); err != nil {
+		goto WriteFieldBeginError
 	}
-	if !p.Field1DeepEqual(ano.OrderId) {
-		return false
+	if err := oprot.WriteI64(p.CreateTime); err != nil {
+		return err
 	}
-	return true
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
 }
 
-func (p *CancelOrderReq) Field1DeepEqual(src int64) bool {
-
-	if p.OrderId != src {
-		return false
+func (p *OrderItem) writeField10(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("update_time", thrift.I64, 10); err != nil {
+		goto WriteFieldBeginError
 	}
-	return true
+	if err := oprot.WriteI64(p.UpdateTime); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
 }
 
-type CancelOrderResp struct {
-	BaseResp *base.BaseResp `thrift:"BaseResp,255" json:"BaseResp"`
-}
-
-func NewCancelOrderResp() *CancelOrderResp {
-	return &CancelOrderResp{}
+func (p *OrderItem) writeField11(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("price", thrift.I64, 11); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.Price); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 end error: ", p), err)
 }
 
-var CancelOrderResp_BaseResp_DEFAULT *base.BaseResp
-
-func (p *CancelOrderResp) GetBaseResp() (v *base.BaseResp) {
-	if !p.IsSetBaseResp() {
-		return CancelOrderResp_BaseResp_DEFAULT
+func (p *OrderItem) writeField12(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("lines", thrift.LIST, 12); err != nil {
+		goto WriteFieldBeginError
 	}
-	return p.BaseResp
-}
-func (p *CancelOrderResp) SetBaseResp(val *base.BaseResp) {
-	p.BaseResp = val
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Lines)); err != nil {
+		return err
+	}
+	for _, v := range p.Lines {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 12 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 12 end error: ", p), err)
 }
 
-var fieldIDToName_CancelOrderResp = map[int16]string{
-	255: "BaseResp",
+func (p *OrderItem) writeField13(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("total_price", thrift.I64, 13); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.TotalPrice); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 13 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 13 end error: ", p), err)
 }
 
-func (p *CancelOrderResp) IsSetBaseResp() bool {
-	return p.BaseResp != nil
+func (p *OrderItem) writeField14(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("pay_time", thrift.I64, 14); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.PayTime); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 14 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 14 end error: ", p), err)
 }
 
-func (p *CancelOrderResp) Read(iprot thrift.TProtocol) (err error) {
-
-	var fieldTypeId thrift.TType
-	var fieldId int16
-
-	if _, err = iprot.ReadStructBegin(); err != nil {
-		goto ReadStructBeginError
+func (p *OrderItem) writeField15(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("ship_time", thrift.I64, 15); err != nil {
+		goto WriteFieldBeginError
 	}
-
-	for {
-		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
-		if err != nil {
-			goto ReadFieldBeginError
-		}
-		if fieldTypeId == thrift.STOP {
-			break
-		}
-
-		switch fieldId {
-		case 255:
-			if fieldTypeId == thrift.STRUCT {
-				if err = p.ReadField255(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
-			}
-		default:
-			if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		}
-
-		if err = iprot.ReadFieldEnd(); err != nil {
-			goto ReadFieldEndError
-		}
+	if err := oprot.WriteI64(p.ShipTime); err != nil {
+		return err
 	}
-	if err = iprot.ReadStructEnd(); err != nil {
-		goto ReadStructEndError
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
-
 	return nil
-ReadStructBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
-ReadFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
-ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CancelOrderResp[fieldId]), err)
-SkipFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
-
-ReadFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
-ReadStructEndError:
-	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 15 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 15 end error: ", p), err)
 }
 
-func (p *CancelOrderResp) ReadField255(iprot thrift.TProtocol) error {
-	p.BaseResp = base.NewBaseResp()
-	if err := p.BaseResp.Read(iprot); err != nil {
+func (p *OrderItem) writeField16(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("deliver_time", thrift.I64, 16); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.DeliverTime); err != nil {
 		return err
 	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
 	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 16 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 16 end error: ", p), err)
 }
 
-func (p *CancelOrderResp) Write(oprot thrift.TProtocol) (err error) {
-	var fieldId int16
-	if err = oprot.WriteStructBegin("CancelOrderResp"); err != nil {
-		goto WriteStructBeginError
+func (p *OrderItem) writeField17(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("complete_time", thrift.I64, 17); err != nil {
+		goto WriteFieldBeginError
 	}
-	if p != nil {
-		if err = p.writeField255(oprot); err != nil {
-			fieldId = 255
-			goto WriteFieldError
-		}
+	if err := oprot.WriteI64(p.CompleteTime); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 17 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 17 end error: ", p), err)
+}
 
+func (p *OrderItem) writeField18(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("cancel_time", thrift.I64, 18); err != nil {
+		goto WriteFieldBeginError
 	}
-	if err = oprot.WriteFieldStop(); err != nil {
-		goto WriteFieldStopError
+	if err := oprot.WriteI64(p.CancelTime); err != nil {
+		return err
 	}
-	if err = oprot.WriteStructEnd(); err != nil {
-		goto WriteStructEndError
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
 	return nil
-WriteStructBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
-WriteFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
-WriteFieldStopError:
-	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
-WriteStructEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 18 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 18 end error: ", p), err)
 }
 
-func (p *CancelOrderResp) writeField255(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("BaseResp", thrift.STRUCT, 255); err != nil {
+func (p *OrderItem) writeField19(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("refund_time", thrift.I64, 19); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := p.BaseResp.Write(oprot); err != nil {
+	if err := oprot.WriteI64(p.RefundTime); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -1589,80 +1517,280 @@ func (p *CancelOrderResp) writeField255(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 19 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 19 end error: ", p), err)
 }
 
-func (p *CancelOrderResp) String() string {
+func (p *OrderItem) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CancelOrderResp(%+v)", *p)
+	return fmt.Sprintf("OrderItem(%+v)", *p)
 }
 
-func (p *CancelOrderResp) DeepEqual(ano *CancelOrderResp) bool {
+func (p *OrderItem) DeepEqual(ano *OrderItem) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field255DeepEqual(ano.BaseResp) {
+	if !p.Field1DeepEqual(ano.OrderId) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.UserId) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.UserName) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.Address) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.ProductId) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.StockNum) {
+		return false
+	}
+	if !p.Field7DeepEqual(ano.ProductSnapshot) {
+		return false
+	}
+	if !p.Field8DeepEqual(ano.Status) {
+		return false
+	}
+	if !p.Field9DeepEqual(ano.CreateTime) {
+		return false
+	}
+	if !p.Field10DeepEqual(ano.UpdateTime) {
+		return false
+	}
+	if !p.Field11DeepEqual(ano.Price) {
+		return false
+	}
+	if !p.Field12DeepEqual(ano.Lines) {
+		return false
+	}
+	if !p.Field13DeepEqual(ano.TotalPrice) {
+		return false
+	}
+	if !p.Field14DeepEqual(ano.PayTime) {
+		return false
+	}
+	if !p.Field15DeepEqual(ano.ShipTime) {
+		return false
+	}
+	if !p.Field16DeepEqual(ano.DeliverTime) {
+		return false
+	}
+	if !p.Field17DeepEqual(ano.CompleteTime) {
+		return false
+	}
+	if !p.Field18DeepEqual(ano.CancelTime) {
+		return false
+	}
+	if !p.Field19DeepEqual(ano.RefundTime) {
 		return false
 	}
 	return true
 }
 
-func (p *CancelOrderResp) Field255DeepEqual(src *base.BaseResp) bool {
+func (p *OrderItem) Field1DeepEqual(src int64) bool {
 
-	if !p.BaseResp.DeepEqual(src) {
+	if p.OrderId != src {
 		return false
 	}
 	return true
 }
+func (p *OrderItem) Field2DeepEqual(src int64) bool {
 
-type ListOrderReq struct {
-	UserId int64   `thrift:"user_id,1,required" json:"user_id"`
-	Status *Status `thrift:"status,2" json:"status,omitempty"`
+	if p.UserId != src {
+		return false
+	}
+	return true
 }
+func (p *OrderItem) Field3DeepEqual(src string) bool {
 
-func NewListOrderReq() *ListOrderReq {
-	return &ListOrderReq{}
+	if strings.Compare(p.UserName, src) != 0 {
+		return false
+	}
+	return true
 }
+func (p *OrderItem) Field4DeepEqual(src string) bool {
 
-func (p *ListOrderReq) GetUserId() (v int64) {
-	return p.UserId
+	if strings.Compare(p.Address, src) != 0 {
+		return false
+	}
+	return true
 }
+func (p *OrderItem) Field5DeepEqual(src int64) bool {
 
-var ListOrderReq_Status_DEFAULT Status
-
-func (p *ListOrderReq) GetStatus() (v Status) {
-	if !p.IsSetStatus() {
-		return ListOrderReq_Status_DEFAULT
+	if p.ProductId != src {
+		return false
 	}
-	return *p.Status
+	return true
 }
-func (p *ListOrderReq) SetUserId(val int64) {
-	p.UserId = val
+func (p *OrderItem) Field6DeepEqual(src int64) bool {
+
+	if p.StockNum != src {
+		return false
+	}
+	return true
 }
-func (p *ListOrderReq) SetStatus(val *Status) {
-	p.Status = val
+func (p *OrderItem) Field7DeepEqual(src string) bool {
+
+	if strings.Compare(p.ProductSnapshot, src) != 0 {
+		return false
+	}
+	return true
 }
+func (p *OrderItem) Field8DeepEqual(src Status) bool {
 
-var fieldIDToName_ListOrderReq = map[int16]string{
-	1: "user_id",
-	2: "status",
+	if p.Status != src {
+		return false
+	}
+	return true
 }
+func (p *OrderItem) Field9DeepEqual(src int64) bool {
 
-func (p *ListOrderReq) IsSetStatus() bool {
-	return p.Status != nil
+	if p.CreateTime != src {
+		return false
+	}
+	return true
 }
+func (p *OrderItem) Field10DeepEqual(src int64) bool {
 
-func (p *ListOrderReq) Read(iprot thrift.TProtocol) (err error) {
+	if p.UpdateTime != src {
+		return false
+	}
+	return true
+}
+func (p *OrderItem) Field11DeepEqual(src int64) bool {
+
+	if p.Price != src {
+		return false
+	}
+	return true
+}
+func (p *OrderItem) Field12DeepEqual(src []*OrderLine) bool {
+
+	if len(p.Lines) != len(src) {
+		return false
+	}
+	for i, v := range p.Lines {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+func (p *OrderItem) Field13DeepEqual(src int64) bool {
+
+	if p.TotalPrice != src {
+		return false
+	}
+	return true
+}
+func (p *OrderItem) Field14DeepEqual(src int64) bool {
+
+	if p.PayTime != src {
+		return false
+	}
+	return true
+}
+func (p *OrderItem) Field15DeepEqual(src int64) bool {
+
+	if p.ShipTime != src {
+		return false
+	}
+	return true
+}
+func (p *OrderItem) Field16DeepEqual(src int64) bool {
+
+	if p.DeliverTime != src {
+		return false
+	}
+	return true
+}
+func (p *OrderItem) Field17DeepEqual(src int64) bool {
+
+	if p.CompleteTime != src {
+		return false
+	}
+	return true
+}
+func (p *OrderItem) Field18DeepEqual(src int64) bool {
+
+	if p.CancelTime != src {
+		return false
+	}
+	return true
+}
+func (p *OrderItem) Field19DeepEqual(src int64) bool {
+
+	if p.RefundTime != src {
+		return false
+	}
+	return true
+}
+
+type OrderLineReq struct {
+	ProductId int64  `thrift:"product_id,1,required" frugal:"1,required,i64" json:"product_id"`
+	SkuId     *int64 `thrift:"sku_id,2,optional" frugal:"2,optional,i64" json:"sku_id,omitempty"`
+	StockNum  int64  `thrift:"stock_num,3,required" frugal:"3,required,i64" json:"stock_num"`
+}
+
+func NewOrderLineReq() *OrderLineReq {
+	return &OrderLineReq{}
+}
+
+func (p *OrderLineReq) InitDefault() {
+	*p = OrderLineReq{}
+}
+
+func (p *OrderLineReq) GetProductId() (v int64) {
+	return p.ProductId
+}
+
+var OrderLineReq_SkuId_DEFAULT int64
+
+func (p *OrderLineReq) GetSkuId() (v int64) {
+	if !p.IsSetSkuId() {
+		return OrderLineReq_SkuId_DEFAULT
+	}
+	return *p.SkuId
+}
+
+func (p *OrderLineReq) GetStockNum() (v int64) {
+	return p.StockNum
+}
+func (p *OrderLineReq) SetProductId(val int64) {
+	p.ProductId = val
+}
+func (p *OrderLineReq) SetSkuId(val *int64) {
+	p.SkuId = val
+}
+func (p *OrderLineReq) SetStockNum(val int64) {
+	p.StockNum = val
+}
+
+var fieldIDToName_OrderLineReq = map[int16]string{
+	1: "product_id",
+	2: "sku_id",
+	3: "stock_num",
+}
+
+func (p *OrderLineReq) IsSetSkuId() bool {
+	return p.SkuId != nil
+}
+
+func (p *OrderLineReq) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetUserId bool = false
+	var issetProductId bool = false
+	var issetStockNum bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -1683,14 +1811,14 @@ func (p *ListOrderReq) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetUserId = true
+				issetProductId = true
 			} else {
 				if err = iprot.Skip(fieldTypeId); err != nil {
 					goto SkipFieldError
 				}
 			}
 		case 2:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -1699,6 +1827,17 @@ func (p *ListOrderReq) Read(iprot thrift.TProtocol) (err error) {
 					goto SkipFieldError
 				}
 			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetStockNum = true
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -1713,17 +1852,22 @@ func (p *ListOrderReq) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
-	if !issetUserId {
+	if !issetProductId {
 		fieldId = 1
 		goto RequiredFieldNotSetError
 	}
+
+	if !issetStockNum {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListOrderReq[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderLineReq[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -1732,31 +1876,39 @@ ReadFieldEndError:
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ListOrderReq[fieldId]))
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_OrderLineReq[fieldId]))
 }
 
-func (p *ListOrderReq) ReadField1(iprot thrift.TProtocol) error {
+func (p *OrderLineReq) ReadField1(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
-		p.UserId = v
+		p.ProductId = v
 	}
 	return nil
 }
 
-func (p *ListOrderReq) ReadField2(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(); err != nil {
+func (p *OrderLineReq) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
-		tmp := Status(v)
-		p.Status = &tmp
+		p.SkuId = &v
 	}
 	return nil
 }
 
-func (p *ListOrderReq) Write(oprot thrift.TProtocol) (err error) {
+func (p *OrderLineReq) ReadField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		p.StockNum = v
+	}
+	return nil
+}
+
+func (p *OrderLineReq) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ListOrderReq"); err != nil {
+	if err = oprot.WriteStructBegin("OrderLineReq"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -1768,6 +1920,10 @@ func (p *ListOrderReq) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
 
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
@@ -1787,11 +1943,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ListOrderReq) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("user_id", thrift.I64, 1); err != nil {
+func (p *OrderLineReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("product_id", thrift.I64, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.UserId); err != nil {
+	if err := oprot.WriteI64(p.ProductId); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -1804,12 +1960,12 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ListOrderReq) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetStatus() {
-		if err = oprot.WriteFieldBegin("status", thrift.I32, 2); err != nil {
+func (p *OrderLineReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSkuId() {
+		if err = oprot.WriteFieldBegin("sku_id", thrift.I64, 2); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteI32(int32(*p.Status)); err != nil {
+		if err := oprot.WriteI64(*p.SkuId); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -1823,95 +1979,209 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *ListOrderReq) String() string {
+func (p *OrderLineReq) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("stock_num", thrift.I64, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.StockNum); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *OrderLineReq) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ListOrderReq(%+v)", *p)
+	return fmt.Sprintf("OrderLineReq(%+v)", *p)
 }
 
-func (p *ListOrderReq) DeepEqual(ano *ListOrderReq) bool {
+func (p *OrderLineReq) DeepEqual(ano *OrderLineReq) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.UserId) {
+	if !p.Field1DeepEqual(ano.ProductId) {
 		return false
 	}
-	if !p.Field2DeepEqual(ano.Status) {
+	if !p.Field2DeepEqual(ano.SkuId) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.StockNum) {
 		return false
 	}
 	return true
 }
 
-func (p *ListOrderReq) Field1DeepEqual(src int64) bool {
+func (p *OrderLineReq) Field1DeepEqual(src int64) bool {
 
-	if p.UserId != src {
+	if p.ProductId != src {
 		return false
 	}
 	return true
 }
-func (p *ListOrderReq) Field2DeepEqual(src *Status) bool {
+func (p *OrderLineReq) Field2DeepEqual(src *int64) bool {
 
-	if p.Status == src {
+	if p.SkuId == src {
 		return true
-	} else if p.Status == nil || src == nil {
+	} else if p.SkuId == nil || src == nil {
 		return false
 	}
-	if *p.Status != *src {
+	if *p.SkuId != *src {
 		return false
 	}
 	return true
 }
+func (p *OrderLineReq) Field3DeepEqual(src int64) bool {
 
-type ListOrderResp struct {
-	Orders   []*OrderItem   `thrift:"orders,1" json:"orders"`
-	BaseResp *base.BaseResp `thrift:"BaseResp,255" json:"BaseResp"`
+	if p.StockNum != src {
+		return false
+	}
+	return true
 }
 
-func NewListOrderResp() *ListOrderResp {
-	return &ListOrderResp{}
+type CreateOrderReq struct {
+	UserId       int64           `thrift:"user_id,1,required" frugal:"1,required,i64" json:"user_id"`
+	Address      string          `thrift:"address,2,required" frugal:"2,required,string" json:"address"`
+	ProductId    *int64          `thrift:"product_id,3,optional" frugal:"3,optional,i64" json:"product_id,omitempty"`
+	StockNum     *int64          `thrift:"stock_num,4,optional" frugal:"4,optional,i64" json:"stock_num,omitempty"`
+	Lines        []*OrderLineReq `thrift:"lines,5,optional" frugal:"5,optional,list<OrderLineReq>" json:"lines,omitempty"`
+	FromCart     *bool           `thrift:"from_cart,6,optional" frugal:"6,optional,bool" json:"from_cart,omitempty"`
+	RequestToken *string         `thrift:"request_token,7,optional" frugal:"7,optional,string" json:"request_token,omitempty"`
 }
 
-func (p *ListOrderResp) GetOrders() (v []*OrderItem) {
-	return p.Orders
+func NewCreateOrderReq() *CreateOrderReq {
+	return &CreateOrderReq{}
 }
 
-var ListOrderResp_BaseResp_DEFAULT *base.BaseResp
-
-func (p *ListOrderResp) GetBaseResp() (v *base.BaseResp) {
-	if !p.IsSetBaseResp() {
-		return ListOrderResp_BaseResp_DEFAULT
-	}
-	return p.BaseResp
+func (p *CreateOrderReq) InitDefault() {
+	*p = CreateOrderReq{}
 }
-func (p *ListOrderResp) SetOrders(val []*OrderItem) {
-	p.Orders = val
+
+func (p *CreateOrderReq) GetUserId() (v int64) {
+	return p.UserId
 }
-func (p *ListOrderResp) SetBaseResp(val *base.BaseResp) {
-	p.BaseResp = val
+
+func (p *CreateOrderReq) GetAddress() (v string) {
+	return p.Address
 }
 
-var fieldIDToName_ListOrderResp = map[int16]string{
-	1:   "orders",
-	255: "BaseResp",
+var CreateOrderReq_ProductId_DEFAULT int64
+
+func (p *CreateOrderReq) GetProductId() (v int64) {
+	if !p.IsSetProductId() {
+		return CreateOrderReq_ProductId_DEFAULT
+	}
+	return *p.ProductId
 }
 
-func (p *ListOrderResp) IsSetBaseResp() bool {
-	return p.BaseResp != nil
+var CreateOrderReq_StockNum_DEFAULT int64
+
+func (p *CreateOrderReq) GetStockNum() (v int64) {
+	if !p.IsSetStockNum() {
+		return CreateOrderReq_StockNum_DEFAULT
+	}
+	return *p.StockNum
 }
 
-func (p *ListOrderResp) Read(iprot thrift.TProtocol) (err error) {
+var CreateOrderReq_Lines_DEFAULT []*OrderLineReq
 
-	var fieldTypeId thrift.TType
-	var fieldId int16
+func (p *CreateOrderReq) GetLines() (v []*OrderLineReq) {
+	if !p.IsSetLines() {
+		return CreateOrderReq_Lines_DEFAULT
+	}
+	return p.Lines
+}
 
-	if _, err = iprot.ReadStructBegin(); err != nil {
-		goto ReadStructBeginError
+var CreateOrderReq_FromCart_DEFAULT bool
+
+func (p *CreateOrderReq) GetFromCart() (v bool) {
+	if !p.IsSetFromCart() {
+		return CreateOrderReq_FromCart_DEFAULT
 	}
+	return *p.FromCart
+}
 
-	for {
+var CreateOrderReq_RequestToken_DEFAULT string
+
+func (p *CreateOrderReq) GetRequestToken() (v string) {
+	if !p.IsSetRequestToken() {
+		return CreateOrderReq_RequestToken_DEFAULT
+	}
+	return *p.RequestToken
+}
+func (p *CreateOrderReq) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *CreateOrderReq) SetAddress(val string) {
+	p.Address = val
+}
+func (p *CreateOrderReq) SetProductId(val *int64) {
+	p.ProductId = val
+}
+func (p *CreateOrderReq) SetStockNum(val *int64) {
+	p.StockNum = val
+}
+func (p *CreateOrderReq) SetLines(val []*OrderLineReq) {
+	p.Lines = val
+}
+func (p *CreateOrderReq) SetFromCart(val *bool) {
+	p.FromCart = val
+}
+func (p *CreateOrderReq) SetRequestToken(val *string) {
+	p.RequestToken = val
+}
+
+var fieldIDToName_CreateOrderReq = map[int16]string{
+	1: "user_id",
+	2: "address",
+	3: "product_id",
+	4: "stock_num",
+	5: "lines",
+	6: "from_cart",
+	7: "request_token",
+}
+
+func (p *CreateOrderReq) IsSetProductId() bool {
+	return p.ProductId != nil
+}
+
+func (p *CreateOrderReq) IsSetStockNum() bool {
+	return p.StockNum != nil
+}
+
+func (p *CreateOrderReq) IsSetLines() bool {
+	return p.Lines != nil
+}
+
+func (p *CreateOrderReq) IsSetFromCart() bool {
+	return p.FromCart != nil
+}
+
+func (p *CreateOrderReq) IsSetRequestToken() bool {
+	return p.RequestToken != nil
+}
+
+func (p *CreateOrderReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetUserId bool = false
+	var issetAddress bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
 		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
 		if err != nil {
 			goto ReadFieldBeginError
@@ -1922,18 +2192,70 @@ func (p *ListOrderResp) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
+				issetUserId = true
 			} else {
 				if err = iprot.Skip(fieldTypeId); err != nil {
 					goto SkipFieldError
 				}
 			}
-		case 255:
-			if fieldTypeId == thrift.STRUCT {
-				if err = p.ReadField255(iprot); err != nil {
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetAddress = true
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 6:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 7:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField7(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else {
@@ -1955,13 +2277,22 @@ func (p *ListOrderResp) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
+	if !issetUserId {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetAddress {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListOrderResp[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CreateOrderReq[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -1969,21 +2300,59 @@ ReadFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_CreateOrderReq[fieldId]))
 }
 
-func (p *ListOrderResp) ReadField1(iprot thrift.TProtocol) error {
+func (p *CreateOrderReq) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		p.UserId = v
+	}
+	return nil
+}
+
+func (p *CreateOrderReq) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.Address = v
+	}
+	return nil
+}
+
+func (p *CreateOrderReq) ReadField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		p.ProductId = &v
+	}
+	return nil
+}
+
+func (p *CreateOrderReq) ReadField4(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		p.StockNum = &v
+	}
+	return nil
+}
+
+func (p *CreateOrderReq) ReadField5(iprot thrift.TProtocol) error {
 	_, size, err := iprot.ReadListBegin()
 	if err != nil {
 		return err
 	}
-	p.Orders = make([]*OrderItem, 0, size)
+	p.Lines = make([]*OrderLineReq, 0, size)
 	for i := 0; i < size; i++ {
-		_elem := NewOrderItem()
+		_elem := NewOrderLineReq()
 		if err := _elem.Read(iprot); err != nil {
 			return err
 		}
 
-		p.Orders = append(p.Orders, _elem)
+		p.Lines = append(p.Lines, _elem)
 	}
 	if err := iprot.ReadListEnd(); err != nil {
 		return err
@@ -1991,17 +2360,27 @@ func (p *ListOrderResp) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *ListOrderResp) ReadField255(iprot thrift.TProtocol) error {
-	p.BaseResp = base.NewBaseResp()
-	if err := p.BaseResp.Read(iprot); err != nil {
+func (p *CreateOrderReq) ReadField6(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		p.FromCart = &v
+	}
+	return nil
+}
+
+func (p *CreateOrderReq) ReadField7(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
 		return err
+	} else {
+		p.RequestToken = &v
 	}
 	return nil
 }
 
-func (p *ListOrderResp) Write(oprot thrift.TProtocol) (err error) {
+func (p *CreateOrderReq) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ListOrderResp"); err != nil {
+	if err = oprot.WriteStructBegin("CreateOrderReq"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -2009,8 +2388,28 @@ func (p *ListOrderResp) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
-		if err = p.writeField255(oprot); err != nil {
-			fieldId = 255
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
 			goto WriteFieldError
 		}
 
@@ -2032,19 +2431,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ListOrderResp) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("orders", thrift.LIST, 1); err != nil {
+func (p *CreateOrderReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.I64, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Orders)); err != nil {
-		return err
-	}
-	for _, v := range p.Orders {
-		if err := v.Write(oprot); err != nil {
-			return err
-		}
-	}
-	if err := oprot.WriteListEnd(); err != nil {
+	if err := oprot.WriteI64(p.UserId); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -2057,11 +2448,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ListOrderResp) writeField255(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("BaseResp", thrift.STRUCT, 255); err != nil {
+func (p *CreateOrderReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("address", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := p.BaseResp.Write(oprot); err != nil {
+	if err := oprot.WriteString(p.Address); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -2069,39 +2460,195 @@ func (p *ListOrderResp) writeField255(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *ListOrderResp) String() string {
-	if p == nil {
-		return "<nil>"
+func (p *CreateOrderReq) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetProductId() {
+		if err = oprot.WriteFieldBegin("product_id", thrift.I64, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.ProductId); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-	return fmt.Sprintf("ListOrderResp(%+v)", *p)
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *ListOrderResp) DeepEqual(ano *ListOrderResp) bool {
-	if p == ano {
-		return true
-	} else if p == nil || ano == nil {
-		return false
-	}
-	if !p.Field1DeepEqual(ano.Orders) {
-		return false
-	}
-	if !p.Field255DeepEqual(ano.BaseResp) {
-		return false
+func (p *CreateOrderReq) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetStockNum() {
+		if err = oprot.WriteFieldBegin("stock_num", thrift.I64, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.StockNum); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-	return true
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
 
-func (p *ListOrderResp) Field1DeepEqual(src []*OrderItem) bool {
+func (p *CreateOrderReq) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetLines() {
+		if err = oprot.WriteFieldBegin("lines", thrift.LIST, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Lines)); err != nil {
+			return err
+		}
+		for _, v := range p.Lines {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
 
-	if len(p.Orders) != len(src) {
+func (p *CreateOrderReq) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetFromCart() {
+		if err = oprot.WriteFieldBegin("from_cart", thrift.BOOL, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteBool(*p.FromCart); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
+func (p *CreateOrderReq) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRequestToken() {
+		if err = oprot.WriteFieldBegin("request_token", thrift.STRING, 7); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.RequestToken); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+
+func (p *CreateOrderReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CreateOrderReq(%+v)", *p)
+}
+
+func (p *CreateOrderReq) DeepEqual(ano *CreateOrderReq) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
 		return false
 	}
-	for i, v := range p.Orders {
+	if !p.Field1DeepEqual(ano.UserId) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Address) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.ProductId) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.StockNum) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.Lines) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.FromCart) {
+		return false
+	}
+	if !p.Field7DeepEqual(ano.RequestToken) {
+		return false
+	}
+	return true
+}
+
+func (p *CreateOrderReq) Field1DeepEqual(src int64) bool {
+
+	if p.UserId != src {
+		return false
+	}
+	return true
+}
+func (p *CreateOrderReq) Field2DeepEqual(src string) bool {
+
+	if strings.Compare(p.Address, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *CreateOrderReq) Field3DeepEqual(src *int64) bool {
+
+	if p.ProductId == src {
+		return true
+	} else if p.ProductId == nil || src == nil {
+		return false
+	}
+	if *p.ProductId != *src {
+		return false
+	}
+	return true
+}
+func (p *CreateOrderReq) Field4DeepEqual(src *int64) bool {
+
+	if p.StockNum == src {
+		return true
+	} else if p.StockNum == nil || src == nil {
+		return false
+	}
+	if *p.StockNum != *src {
+		return false
+	}
+	return true
+}
+func (p *CreateOrderReq) Field5DeepEqual(src []*OrderLineReq) bool {
+
+	if len(p.Lines) != len(src) {
+		return false
+	}
+	for i, v := range p.Lines {
 		_src := src[i]
 		if !v.DeepEqual(_src) {
 			return false
@@ -2109,38 +2656,76 @@ func (p *ListOrderResp) Field1DeepEqual(src []*OrderItem) bool {
 	}
 	return true
 }
-func (p *ListOrderResp) Field255DeepEqual(src *base.BaseResp) bool {
+func (p *CreateOrderReq) Field6DeepEqual(src *bool) bool {
 
-	if !p.BaseResp.DeepEqual(src) {
+	if p.FromCart == src {
+		return true
+	} else if p.FromCart == nil || src == nil {
+		return false
+	}
+	if *p.FromCart != *src {
 		return false
 	}
 	return true
 }
+func (p *CreateOrderReq) Field7DeepEqual(src *string) bool {
 
-type GetOrderByIdReq struct {
-	OrderId int64 `thrift:"order_id,1,required" json:"order_id"`
+	if p.RequestToken == src {
+		return true
+	} else if p.RequestToken == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.RequestToken, *src) != 0 {
+		return false
+	}
+	return true
 }
 
-func NewGetOrderByIdReq() *GetOrderByIdReq {
-	return &GetOrderByIdReq{}
+type CreateOrderResp struct {
+	OrderId  int64          `thrift:"order_id,1" frugal:"1,default,i64" json:"order_id"`
+	BaseResp *base.BaseResp `thrift:"BaseResp,255" frugal:"255,default,base.BaseResp" json:"BaseResp"`
 }
 
-func (p *GetOrderByIdReq) GetOrderId() (v int64) {
+func NewCreateOrderResp() *CreateOrderResp {
+	return &CreateOrderResp{}
+}
+
+func (p *CreateOrderResp) InitDefault() {
+	*p = CreateOrderResp{}
+}
+
+func (p *CreateOrderResp) GetOrderId() (v int64) {
 	return p.OrderId
 }
-func (p *GetOrderByIdReq) SetOrderId(val int64) {
+
+var CreateOrderResp_BaseResp_DEFAULT *base.BaseResp
+
+func (p *CreateOrderResp) GetBaseResp() (v *base.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return CreateOrderResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *CreateOrderResp) SetOrderId(val int64) {
 	p.OrderId = val
 }
+func (p *CreateOrderResp) SetBaseResp(val *base.BaseResp) {
+	p.BaseResp = val
+}
 
-var fieldIDToName_GetOrderByIdReq = map[int16]string{
-	1: "order_id",
+var fieldIDToName_CreateOrderResp = map[int16]string{
+	1:   "order_id",
+	255: "BaseResp",
 }
 
-func (p *GetOrderByIdReq) Read(iprot thrift.TProtocol) (err error) {
+func (p *CreateOrderResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *CreateOrderResp) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetOrderId bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -2161,7 +2746,16 @@ func (p *GetOrderByIdReq) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetOrderId = true
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
 			} else {
 				if err = iprot.Skip(fieldTypeId); err != nil {
 					goto SkipFieldError
@@ -2181,17 +2775,13 @@ func (p *GetOrderByIdReq) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
-	if !issetOrderId {
-		fieldId = 1
-		goto RequiredFieldNotSetError
-	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetOrderByIdReq[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CreateOrderResp[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -2199,11 +2789,9 @@ ReadFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
-RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_GetOrderByIdReq[fieldId]))
 }
 
-func (p *GetOrderByIdReq) ReadField1(iprot thrift.TProtocol) error {
+func (p *CreateOrderResp) ReadField1(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
@@ -2212,9 +2800,17 @@ func (p *GetOrderByIdReq) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *GetOrderByIdReq) Write(oprot thrift.TProtocol) (err error) {
+func (p *CreateOrderResp) ReadField255(iprot thrift.TProtocol) error {
+	p.BaseResp = base.NewBaseResp()
+	if err := p.BaseResp.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *CreateOrderResp) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetOrderByIdReq"); err != nil {
+	if err = oprot.WriteStructBegin("CreateOrderResp"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -2222,6 +2818,10 @@ func (p *GetOrderByIdReq) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
 
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
@@ -2241,7 +2841,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *GetOrderByIdReq) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *CreateOrderResp) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("order_id", thrift.I64, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -2258,14 +2858,31 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *GetOrderByIdReq) String() string {
+func (p *CreateOrderResp) writeField255(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("BaseResp", thrift.STRUCT, 255); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *CreateOrderResp) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetOrderByIdReq(%+v)", *p)
+	return fmt.Sprintf("CreateOrderResp(%+v)", *p)
 }
 
-func (p *GetOrderByIdReq) DeepEqual(ano *GetOrderByIdReq) bool {
+func (p *CreateOrderResp) DeepEqual(ano *CreateOrderResp) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -2274,67 +2891,65 @@ func (p *GetOrderByIdReq) DeepEqual(ano *GetOrderByIdReq) bool {
 	if !p.Field1DeepEqual(ano.OrderId) {
 		return false
 	}
+	if !p.Field255DeepEqual(ano.BaseResp) {
+		return false
+	}
 	return true
 }
 
-func (p *GetOrderByIdReq) Field1DeepEqual(src int64) bool {
+func (p *CreateOrderResp) Field1DeepEqual(src int64) bool {
 
 	if p.OrderId != src {
 		return false
 	}
 	return true
 }
+func (p *CreateOrderResp) Field255DeepEqual(src *base.BaseResp) bool {
 
-type GetOrderByIdResp struct {
-	Order    *OrderItem     `thrift:"order,1" json:"order"`
-	BaseResp *base.BaseResp `thrift:"BaseResp,255" json:"BaseResp"`
+	if !p.BaseResp.DeepEqual(src) {
+		return false
+	}
+	return true
 }
 
-func NewGetOrderByIdResp() *GetOrderByIdResp {
-	return &GetOrderByIdResp{}
+type CancelOrderReq struct {
+	OrderId int64 `thrift:"order_id,1,required" frugal:"1,required,i64" json:"order_id"`
+	UserId  int64 `thrift:"user_id,2,required" frugal:"2,required,i64" json:"user_id"`
 }
 
-var GetOrderByIdResp_Order_DEFAULT *OrderItem
+func NewCancelOrderReq() *CancelOrderReq {
+	return &CancelOrderReq{}
+}
 
-func (p *GetOrderByIdResp) GetOrder() (v *OrderItem) {
-	if !p.IsSetOrder() {
-		return GetOrderByIdResp_Order_DEFAULT
-	}
-	return p.Order
+func (p *CancelOrderReq) InitDefault() {
+	*p = CancelOrderReq{}
 }
 
-var GetOrderByIdResp_BaseResp_DEFAULT *base.BaseResp
+func (p *CancelOrderReq) GetOrderId() (v int64) {
+	return p.OrderId
+}
 
-func (p *GetOrderByIdResp) GetBaseResp() (v *base.BaseResp) {
-	if !p.IsSetBaseResp() {
-		return GetOrderByIdResp_BaseResp_DEFAULT
-	}
-	return p.BaseResp
-}
-func (p *GetOrderByIdResp) SetOrder(val *OrderItem) {
-	p.Order = val
-}
-func (p *GetOrderByIdResp) SetBaseResp(val *base.BaseResp) {
-	p.BaseResp = val
+func (p *CancelOrderReq) GetUserId() (v int64) {
+	return p.UserId
 }
-
-var fieldIDToName_GetOrderByIdResp = map[int16]string{
-	1:   "order",
-	255: "BaseResp",
+func (p *CancelOrderReq) SetOrderId(val int64) {
+	p.OrderId = val
 }
-
-func (p *GetOrderByIdResp) IsSetOrder() bool {
-	return p.Order != nil
+func (p *CancelOrderReq) SetUserId(val int64) {
+	p.UserId = val
 }
 
-func (p *GetOrderByIdResp) IsSetBaseResp() bool {
-	return p.BaseResp != nil
+var fieldIDToName_CancelOrderReq = map[int16]string{
+	1: "order_id",
+	2: "user_id",
 }
 
-func (p *GetOrderByIdResp) Read(iprot thrift.TProtocol) (err error) {
+func (p *CancelOrderReq) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
+	var issetOrderId bool = false
+	var issetUserId bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -2351,20 +2966,22 @@ func (p *GetOrderByIdResp) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
+				issetOrderId = true
 			} else {
 				if err = iprot.Skip(fieldTypeId); err != nil {
 					goto SkipFieldError
 				}
 			}
-		case 255:
-			if fieldTypeId == thrift.STRUCT {
-				if err = p.ReadField255(iprot); err != nil {
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
+				issetUserId = true
 			} else {
 				if err = iprot.Skip(fieldTypeId); err != nil {
 					goto SkipFieldError
@@ -2384,13 +3001,22 @@ func (p *GetOrderByIdResp) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
+	if !issetOrderId {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetUserId {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetOrderByIdResp[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CancelOrderReq[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -2398,27 +3024,31 @@ ReadFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_CancelOrderReq[fieldId]))
 }
 
-func (p *GetOrderByIdResp) ReadField1(iprot thrift.TProtocol) error {
-	p.Order = NewOrderItem()
-	if err := p.Order.Read(iprot); err != nil {
+func (p *CancelOrderReq) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
+	} else {
+		p.OrderId = v
 	}
 	return nil
 }
 
-func (p *GetOrderByIdResp) ReadField255(iprot thrift.TProtocol) error {
-	p.BaseResp = base.NewBaseResp()
-	if err := p.BaseResp.Read(iprot); err != nil {
+func (p *CancelOrderReq) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
+	} else {
+		p.UserId = v
 	}
 	return nil
 }
 
-func (p *GetOrderByIdResp) Write(oprot thrift.TProtocol) (err error) {
+func (p *CancelOrderReq) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetOrderByIdResp"); err != nil {
+	if err = oprot.WriteStructBegin("CancelOrderReq"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -2426,8 +3056,8 @@ func (p *GetOrderByIdResp) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
-		if err = p.writeField255(oprot); err != nil {
-			fieldId = 255
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
 			goto WriteFieldError
 		}
 
@@ -2449,11 +3079,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *GetOrderByIdResp) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("order", thrift.STRUCT, 1); err != nil {
+func (p *CancelOrderReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("order_id", thrift.I64, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := p.Order.Write(oprot); err != nil {
+	if err := oprot.WriteI64(p.OrderId); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -2466,11 +3096,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *GetOrderByIdResp) writeField255(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("BaseResp", thrift.STRUCT, 255); err != nil {
+func (p *CancelOrderReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.I64, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := p.BaseResp.Write(oprot); err != nil {
+	if err := oprot.WriteI64(p.UserId); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -2478,389 +3108,10516 @@ func (p *GetOrderByIdResp) writeField255(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *GetOrderByIdResp) String() string {
+func (p *CancelOrderReq) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetOrderByIdResp(%+v)", *p)
+	return fmt.Sprintf("CancelOrderReq(%+v)", *p)
 }
 
-func (p *GetOrderByIdResp) DeepEqual(ano *GetOrderByIdResp) bool {
+func (p *CancelOrderReq) DeepEqual(ano *CancelOrderReq) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.Order) {
+	if !p.Field1DeepEqual(ano.OrderId) {
 		return false
 	}
-	if !p.Field255DeepEqual(ano.BaseResp) {
+	if !p.Field2DeepEqual(ano.UserId) {
 		return false
 	}
 	return true
 }
 
-func (p *GetOrderByIdResp) Field1DeepEqual(src *OrderItem) bool {
+func (p *CancelOrderReq) Field1DeepEqual(src int64) bool {
 
-	if !p.Order.DeepEqual(src) {
+	if p.OrderId != src {
 		return false
 	}
 	return true
 }
-func (p *GetOrderByIdResp) Field255DeepEqual(src *base.BaseResp) bool {
+func (p *CancelOrderReq) Field2DeepEqual(src int64) bool {
 
-	if !p.BaseResp.DeepEqual(src) {
+	if p.UserId != src {
 		return false
 	}
 	return true
 }
 
-type OrderService interface {
-	CreateOrder(ctx context.Context, req *CreateOrderReq) (r *CreateOrderResp, err error)
-
-	CancelOrder(ctx context.Context, req *CancelOrderReq) (r *CancelOrderResp, err error)
-
-	ListOrder(ctx context.Context, req *ListOrderReq) (r *ListOrderResp, err error)
-
-	GetOrderById(ctx context.Context, req *GetOrderByIdReq) (r *GetOrderByIdResp, err error)
+type CancelOrderResp struct {
+	BaseResp *base.BaseResp `thrift:"BaseResp,255" frugal:"255,default,base.BaseResp" json:"BaseResp"`
 }
 
-type OrderServiceClient struct {
-	c thrift.TClient
+func NewCancelOrderResp() *CancelOrderResp {
+	return &CancelOrderResp{}
 }
 
-func NewOrderServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *OrderServiceClient {
-	return &OrderServiceClient{
-		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
-	}
+func (p *CancelOrderResp) InitDefault() {
+	*p = CancelOrderResp{}
 }
 
-func NewOrderServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *OrderServiceClient {
-	return &OrderServiceClient{
-		c: thrift.NewTStandardClient(iprot, oprot),
-	}
-}
+var CancelOrderResp_BaseResp_DEFAULT *base.BaseResp
 
-func NewOrderServiceClient(c thrift.TClient) *OrderServiceClient {
-	return &OrderServiceClient{
-		c: c,
+func (p *CancelOrderResp) GetBaseResp() (v *base.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return CancelOrderResp_BaseResp_DEFAULT
 	}
+	return p.BaseResp
 }
-
-func (p *OrderServiceClient) Client_() thrift.TClient {
-	return p.c
+func (p *CancelOrderResp) SetBaseResp(val *base.BaseResp) {
+	p.BaseResp = val
 }
 
-func (p *OrderServiceClient) CreateOrder(ctx context.Context, req *CreateOrderReq) (r *CreateOrderResp, err error) {
-	var _args OrderServiceCreateOrderArgs
-	_args.Req = req
-	var _result OrderServiceCreateOrderResult
-	if err = p.Client_().Call(ctx, "CreateOrder", &_args, &_result); err != nil {
-		return
-	}
-	return _result.GetSuccess(), nil
+var fieldIDToName_CancelOrderResp = map[int16]string{
+	255: "BaseResp",
 }
 
-func (p *OrderServiceClient) CancelOrder(ctx context.Context, req *CancelOrderReq) (r *CancelOrderResp, err error) {
-	var _args OrderServiceCancelOrderArgs
-	_args.Req = req
-	var _result OrderServiceCancelOrderResult
-	if err = p.Client_().Call(ctx, "CancelOrder", &_args, &_result); err != nil {
-		return
-	}
-	return _result.GetSuccess(), nil
+func (p *CancelOrderResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
 }
 
-func (p *OrderServiceClient) ListOrder(ctx context.Context, req *ListOrderReq) (r *ListOrderResp, err error) {
-	var _args OrderServiceListOrderArgs
-	_args.Req = req
-	var _result OrderServiceListOrderResult
-	if err = p.Client_().Call(ctx, "ListOrder", &_args, &_result); err != nil {
-		return
+func (p *CancelOrderResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	return _result.GetSuccess(), nil
-}
 
-func (p *OrderServiceClient) GetOrderById(ctx context.Context, req *GetOrderByIdReq) (r *GetOrderByIdResp, err error) {
-	var _args OrderServiceGetOrderByIdArgs
-	_args.Req = req
-	var _result OrderServiceGetOrderByIdResult
-	if err = p.Client_().Call(ctx, "GetOrderById", &_args, &_result); err != nil {
-		return
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	return _result.GetSuccess(), nil
-}
 
-type OrderServiceProcessor struct {
-	processorMap map[string]thrift.TProcessorFunction
-	handler      OrderService
-}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CancelOrderResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
-func (p *OrderServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
-	p.processorMap[key] = processor
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *OrderServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
-	processor, ok = p.processorMap[key]
-	return processor, ok
+func (p *CancelOrderResp) ReadField255(iprot thrift.TProtocol) error {
+	p.BaseResp = base.NewBaseResp()
+	if err := p.BaseResp.Read(iprot); err != nil {
+		return err
+	}
+	return nil
 }
 
-func (p *OrderServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
-	return p.processorMap
-}
+func (p *CancelOrderResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("CancelOrderResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
 
-func NewOrderServiceProcessor(handler OrderService) *OrderServiceProcessor {
-	self := &OrderServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
-	self.AddToProcessorMap("CreateOrder", &orderServiceProcessorCreateOrder{handler: handler})
-	self.AddToProcessorMap("CancelOrder", &orderServiceProcessorCancelOrder{handler: handler})
-	self.AddToProcessorMap("ListOrder", &orderServiceProcessorListOrder{handler: handler})
-	self.AddToProcessorMap("GetOrderById", &orderServiceProcessorGetOrderById{handler: handler})
-	return self
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
-func (p *OrderServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	name, _, seqId, err := iprot.ReadMessageBegin()
-	if err != nil {
-		return false, err
+
+func (p *CancelOrderResp) writeField255(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("BaseResp", thrift.STRUCT, 255); err != nil {
+		goto WriteFieldBeginError
 	}
-	if processor, ok := p.GetProcessorFunction(name); ok {
-		return processor.Process(ctx, seqId, iprot, oprot)
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
 	}
-	iprot.Skip(thrift.STRUCT)
-	iprot.ReadMessageEnd()
-	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
-	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
-	x.Write(oprot)
-	oprot.WriteMessageEnd()
-	oprot.Flush(ctx)
-	return false, x
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
 }
 
-type orderServiceProcessorCreateOrder struct {
-	handler OrderService
+func (p *CancelOrderResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CancelOrderResp(%+v)", *p)
 }
 
-func (p *orderServiceProcessorCreateOrder) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := OrderServiceCreateOrderArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("CreateOrder", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *CancelOrderResp) DeepEqual(ano *CancelOrderResp) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field255DeepEqual(ano.BaseResp) {
+		return false
 	}
+	return true
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := OrderServiceCreateOrderResult{}
-	var retval *CreateOrderResp
-	if retval, err2 = p.handler.CreateOrder(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing CreateOrder: "+err2.Error())
-		oprot.WriteMessageBegin("CreateOrder", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
+func (p *CancelOrderResp) Field255DeepEqual(src *base.BaseResp) bool {
+
+	if !p.BaseResp.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type PayOrderReq struct {
+	OrderId int64 `thrift:"order_id,1,required" frugal:"1,required,i64" json:"order_id"`
+	UserId  int64 `thrift:"user_id,2,required" frugal:"2,required,i64" json:"user_id"`
+}
+
+func NewPayOrderReq() *PayOrderReq {
+	return &PayOrderReq{}
+}
+
+func (p *PayOrderReq) InitDefault() {
+	*p = PayOrderReq{}
+}
+
+func (p *PayOrderReq) GetOrderId() (v int64) {
+	return p.OrderId
+}
+
+func (p *PayOrderReq) GetUserId() (v int64) {
+	return p.UserId
+}
+func (p *PayOrderReq) SetOrderId(val int64) {
+	p.OrderId = val
+}
+func (p *PayOrderReq) SetUserId(val int64) {
+	p.UserId = val
+}
+
+var fieldIDToName_PayOrderReq = map[int16]string{
+	1: "order_id",
+	2: "user_id",
+}
+
+func (p *PayOrderReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetOrderId bool = false
+	var issetUserId bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetOrderId = true
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetUserId = true
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetOrderId {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetUserId {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PayOrderReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_PayOrderReq[fieldId]))
+}
+
+func (p *PayOrderReq) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
 	} else {
-		result.Success = retval
+		p.OrderId = v
 	}
-	if err2 = oprot.WriteMessageBegin("CreateOrder", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	return nil
+}
+
+func (p *PayOrderReq) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		p.UserId = v
+	}
+	return nil
+}
+
+func (p *PayOrderReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("PayOrderReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PayOrderReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("order_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.OrderId); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *PayOrderReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.UserId); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *PayOrderReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PayOrderReq(%+v)", *p)
+}
+
+func (p *PayOrderReq) DeepEqual(ano *PayOrderReq) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.OrderId) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.UserId) {
+		return false
+	}
+	return true
+}
+
+func (p *PayOrderReq) Field1DeepEqual(src int64) bool {
+
+	if p.OrderId != src {
+		return false
+	}
+	return true
+}
+func (p *PayOrderReq) Field2DeepEqual(src int64) bool {
+
+	if p.UserId != src {
+		return false
+	}
+	return true
+}
+
+type PayOrderResp struct {
+	BaseResp *base.BaseResp `thrift:"BaseResp,255" frugal:"255,default,base.BaseResp" json:"BaseResp"`
+}
+
+func NewPayOrderResp() *PayOrderResp {
+	return &PayOrderResp{}
+}
+
+func (p *PayOrderResp) InitDefault() {
+	*p = PayOrderResp{}
+}
+
+var PayOrderResp_BaseResp_DEFAULT *base.BaseResp
+
+func (p *PayOrderResp) GetBaseResp() (v *base.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return PayOrderResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *PayOrderResp) SetBaseResp(val *base.BaseResp) {
+	p.BaseResp = val
+}
+
+var fieldIDToName_PayOrderResp = map[int16]string{
+	255: "BaseResp",
+}
+
+func (p *PayOrderResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *PayOrderResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PayOrderResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PayOrderResp) ReadField255(iprot thrift.TProtocol) error {
+	p.BaseResp = base.NewBaseResp()
+	if err := p.BaseResp.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *PayOrderResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("PayOrderResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PayOrderResp) writeField255(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("BaseResp", thrift.STRUCT, 255); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *PayOrderResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PayOrderResp(%+v)", *p)
+}
+
+func (p *PayOrderResp) DeepEqual(ano *PayOrderResp) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field255DeepEqual(ano.BaseResp) {
+		return false
+	}
+	return true
+}
+
+func (p *PayOrderResp) Field255DeepEqual(src *base.BaseResp) bool {
+
+	if !p.BaseResp.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type ShipOrderReq struct {
+	OrderId int64 `thrift:"order_id,1,required" frugal:"1,required,i64" json:"order_id"`
+}
+
+func NewShipOrderReq() *ShipOrderReq {
+	return &ShipOrderReq{}
+}
+
+func (p *ShipOrderReq) InitDefault() {
+	*p = ShipOrderReq{}
+}
+
+func (p *ShipOrderReq) GetOrderId() (v int64) {
+	return p.OrderId
+}
+func (p *ShipOrderReq) SetOrderId(val int64) {
+	p.OrderId = val
+}
+
+var fieldIDToName_ShipOrderReq = map[int16]string{
+	1: "order_id",
+}
+
+func (p *ShipOrderReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetOrderId bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetOrderId = true
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetOrderId {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ShipOrderReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ShipOrderReq[fieldId]))
+}
+
+func (p *ShipOrderReq) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		p.OrderId = v
+	}
+	return nil
+}
+
+func (p *ShipOrderReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ShipOrderReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ShipOrderReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("order_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.OrderId); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ShipOrderReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ShipOrderReq(%+v)", *p)
+}
+
+func (p *ShipOrderReq) DeepEqual(ano *ShipOrderReq) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.OrderId) {
+		return false
+	}
+	return true
+}
+
+func (p *ShipOrderReq) Field1DeepEqual(src int64) bool {
+
+	if p.OrderId != src {
+		return false
+	}
+	return true
+}
+
+type ShipOrderResp struct {
+	BaseResp *base.BaseResp `thrift:"BaseResp,255" frugal:"255,default,base.BaseResp" json:"BaseResp"`
+}
+
+func NewShipOrderResp() *ShipOrderResp {
+	return &ShipOrderResp{}
+}
+
+func (p *ShipOrderResp) InitDefault() {
+	*p = ShipOrderResp{}
+}
+
+var ShipOrderResp_BaseResp_DEFAULT *base.BaseResp
+
+func (p *ShipOrderResp) GetBaseResp() (v *base.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return ShipOrderResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *ShipOrderResp) SetBaseResp(val *base.BaseResp) {
+	p.BaseResp = val
+}
+
+var fieldIDToName_ShipOrderResp = map[int16]string{
+	255: "BaseResp",
+}
+
+func (p *ShipOrderResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *ShipOrderResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ShipOrderResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ShipOrderResp) ReadField255(iprot thrift.TProtocol) error {
+	p.BaseResp = base.NewBaseResp()
+	if err := p.BaseResp.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *ShipOrderResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ShipOrderResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ShipOrderResp) writeField255(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("BaseResp", thrift.STRUCT, 255); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *ShipOrderResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ShipOrderResp(%+v)", *p)
+}
+
+func (p *ShipOrderResp) DeepEqual(ano *ShipOrderResp) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field255DeepEqual(ano.BaseResp) {
+		return false
+	}
+	return true
+}
+
+func (p *ShipOrderResp) Field255DeepEqual(src *base.BaseResp) bool {
+
+	if !p.BaseResp.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type DeliverOrderReq struct {
+	OrderId int64 `thrift:"order_id,1,required" frugal:"1,required,i64" json:"order_id"`
+}
+
+func NewDeliverOrderReq() *DeliverOrderReq {
+	return &DeliverOrderReq{}
+}
+
+func (p *DeliverOrderReq) InitDefault() {
+	*p = DeliverOrderReq{}
+}
+
+func (p *DeliverOrderReq) GetOrderId() (v int64) {
+	return p.OrderId
+}
+func (p *DeliverOrderReq) SetOrderId(val int64) {
+	p.OrderId = val
+}
+
+var fieldIDToName_DeliverOrderReq = map[int16]string{
+	1: "order_id",
+}
+
+func (p *DeliverOrderReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetOrderId bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetOrderId = true
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetOrderId {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DeliverOrderReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_DeliverOrderReq[fieldId]))
+}
+
+func (p *DeliverOrderReq) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		p.OrderId = v
+	}
+	return nil
+}
+
+func (p *DeliverOrderReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("DeliverOrderReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *DeliverOrderReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("order_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.OrderId); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *DeliverOrderReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DeliverOrderReq(%+v)", *p)
+}
+
+func (p *DeliverOrderReq) DeepEqual(ano *DeliverOrderReq) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.OrderId) {
+		return false
+	}
+	return true
+}
+
+func (p *DeliverOrderReq) Field1DeepEqual(src int64) bool {
+
+	if p.OrderId != src {
+		return false
+	}
+	return true
+}
+
+type DeliverOrderResp struct {
+	BaseResp *base.BaseResp `thrift:"BaseResp,255" frugal:"255,default,base.BaseResp" json:"BaseResp"`
+}
+
+func NewDeliverOrderResp() *DeliverOrderResp {
+	return &DeliverOrderResp{}
+}
+
+func (p *DeliverOrderResp) InitDefault() {
+	*p = DeliverOrderResp{}
+}
+
+var DeliverOrderResp_BaseResp_DEFAULT *base.BaseResp
+
+func (p *DeliverOrderResp) GetBaseResp() (v *base.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return DeliverOrderResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *DeliverOrderResp) SetBaseResp(val *base.BaseResp) {
+	p.BaseResp = val
+}
+
+var fieldIDToName_DeliverOrderResp = map[int16]string{
+	255: "BaseResp",
+}
+
+func (p *DeliverOrderResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *DeliverOrderResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DeliverOrderResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *DeliverOrderResp) ReadField255(iprot thrift.TProtocol) error {
+	p.BaseResp = base.NewBaseResp()
+	if err := p.BaseResp.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *DeliverOrderResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("DeliverOrderResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *DeliverOrderResp) writeField255(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("BaseResp", thrift.STRUCT, 255); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *DeliverOrderResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DeliverOrderResp(%+v)", *p)
+}
+
+func (p *DeliverOrderResp) DeepEqual(ano *DeliverOrderResp) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field255DeepEqual(ano.BaseResp) {
+		return false
+	}
+	return true
+}
+
+func (p *DeliverOrderResp) Field255DeepEqual(src *base.BaseResp) bool {
+
+	if !p.BaseResp.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type ConfirmReceiptReq struct {
+	OrderId int64 `thrift:"order_id,1,required" frugal:"1,required,i64" json:"order_id"`
+	UserId  int64 `thrift:"user_id,2,required" frugal:"2,required,i64" json:"user_id"`
+}
+
+func NewConfirmReceiptReq() *ConfirmReceiptReq {
+	return &ConfirmReceiptReq{}
+}
+
+func (p *ConfirmReceiptReq) InitDefault() {
+	*p = ConfirmReceiptReq{}
+}
+
+func (p *ConfirmReceiptReq) GetOrderId() (v int64) {
+	return p.OrderId
+}
+
+func (p *ConfirmReceiptReq) GetUserId() (v int64) {
+	return p.UserId
+}
+func (p *ConfirmReceiptReq) SetOrderId(val int64) {
+	p.OrderId = val
+}
+func (p *ConfirmReceiptReq) SetUserId(val int64) {
+	p.UserId = val
+}
+
+var fieldIDToName_ConfirmReceiptReq = map[int16]string{
+	1: "order_id",
+	2: "user_id",
+}
+
+func (p *ConfirmReceiptReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetOrderId bool = false
+	var issetUserId bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetOrderId = true
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetUserId = true
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetOrderId {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetUserId {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ConfirmReceiptReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ConfirmReceiptReq[fieldId]))
+}
+
+func (p *ConfirmReceiptReq) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		p.OrderId = v
+	}
+	return nil
+}
+
+func (p *ConfirmReceiptReq) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		p.UserId = v
+	}
+	return nil
+}
+
+func (p *ConfirmReceiptReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ConfirmReceiptReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ConfirmReceiptReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("order_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.OrderId); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ConfirmReceiptReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.UserId); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *ConfirmReceiptReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ConfirmReceiptReq(%+v)", *p)
+}
+
+func (p *ConfirmReceiptReq) DeepEqual(ano *ConfirmReceiptReq) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.OrderId) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.UserId) {
+		return false
+	}
+	return true
+}
+
+func (p *ConfirmReceiptReq) Field1DeepEqual(src int64) bool {
+
+	if p.OrderId != src {
+		return false
+	}
+	return true
+}
+func (p *ConfirmReceiptReq) Field2DeepEqual(src int64) bool {
+
+	if p.UserId != src {
+		return false
+	}
+	return true
+}
+
+type ConfirmReceiptResp struct {
+	BaseResp *base.BaseResp `thrift:"BaseResp,255" frugal:"255,default,base.BaseResp" json:"BaseResp"`
+}
+
+func NewConfirmReceiptResp() *ConfirmReceiptResp {
+	return &ConfirmReceiptResp{}
+}
+
+func (p *ConfirmReceiptResp) InitDefault() {
+	*p = ConfirmReceiptResp{}
+}
+
+var ConfirmReceiptResp_BaseResp_DEFAULT *base.BaseResp
+
+func (p *ConfirmReceiptResp) GetBaseResp() (v *base.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return ConfirmReceiptResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *ConfirmReceiptResp) SetBaseResp(val *base.BaseResp) {
+	p.BaseResp = val
+}
+
+var fieldIDToName_ConfirmReceiptResp = map[int16]string{
+	255: "BaseResp",
+}
+
+func (p *ConfirmReceiptResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *ConfirmReceiptResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ConfirmReceiptResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ConfirmReceiptResp) ReadField255(iprot thrift.TProtocol) error {
+	p.BaseResp = base.NewBaseResp()
+	if err := p.BaseResp.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *ConfirmReceiptResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ConfirmReceiptResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ConfirmReceiptResp) writeField255(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("BaseResp", thrift.STRUCT, 255); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *ConfirmReceiptResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ConfirmReceiptResp(%+v)", *p)
+}
+
+func (p *ConfirmReceiptResp) DeepEqual(ano *ConfirmReceiptResp) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field255DeepEqual(ano.BaseResp) {
+		return false
+	}
+	return true
+}
+
+func (p *ConfirmReceiptResp) Field255DeepEqual(src *base.BaseResp) bool {
+
+	if !p.BaseResp.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type RefundOrderReq struct {
+	OrderId int64 `thrift:"order_id,1,required" frugal:"1,required,i64" json:"order_id"`
+	UserId  int64 `thrift:"user_id,2,required" frugal:"2,required,i64" json:"user_id"`
+}
+
+func NewRefundOrderReq() *RefundOrderReq {
+	return &RefundOrderReq{}
+}
+
+func (p *RefundOrderReq) InitDefault() {
+	*p = RefundOrderReq{}
+}
+
+func (p *RefundOrderReq) GetOrderId() (v int64) {
+	return p.OrderId
+}
+
+func (p *RefundOrderReq) GetUserId() (v int64) {
+	return p.UserId
+}
+func (p *RefundOrderReq) SetOrderId(val int64) {
+	p.OrderId = val
+}
+func (p *RefundOrderReq) SetUserId(val int64) {
+	p.UserId = val
+}
+
+var fieldIDToName_RefundOrderReq = map[int16]string{
+	1: "order_id",
+	2: "user_id",
+}
+
+func (p *RefundOrderReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetOrderId bool = false
+	var issetUserId bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetOrderId = true
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetUserId = true
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetOrderId {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetUserId {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RefundOrderReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_RefundOrderReq[fieldId]))
+}
+
+func (p *RefundOrderReq) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		p.OrderId = v
+	}
+	return nil
+}
+
+func (p *RefundOrderReq) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		p.UserId = v
+	}
+	return nil
+}
+
+func (p *RefundOrderReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("RefundOrderReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *RefundOrderReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("order_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.OrderId); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *RefundOrderReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.UserId); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *RefundOrderReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RefundOrderReq(%+v)", *p)
+}
+
+func (p *RefundOrderReq) DeepEqual(ano *RefundOrderReq) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.OrderId) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.UserId) {
+		return false
+	}
+	return true
+}
+
+func (p *RefundOrderReq) Field1DeepEqual(src int64) bool {
+
+	if p.OrderId != src {
+		return false
+	}
+	return true
+}
+func (p *RefundOrderReq) Field2DeepEqual(src int64) bool {
+
+	if p.UserId != src {
+		return false
+	}
+	return true
+}
+
+type RefundOrderResp struct {
+	BaseResp *base.BaseResp `thrift:"BaseResp,255" frugal:"255,default,base.BaseResp" json:"BaseResp"`
+}
+
+func NewRefundOrderResp() *RefundOrderResp {
+	return &RefundOrderResp{}
+}
+
+func (p *RefundOrderResp) InitDefault() {
+	*p = RefundOrderResp{}
+}
+
+var RefundOrderResp_BaseResp_DEFAULT *base.BaseResp
+
+func (p *RefundOrderResp) GetBaseResp() (v *base.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return RefundOrderResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *RefundOrderResp) SetBaseResp(val *base.BaseResp) {
+	p.BaseResp = val
+}
+
+var fieldIDToName_RefundOrderResp = map[int16]string{
+	255: "BaseResp",
+}
+
+func (p *RefundOrderResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *RefundOrderResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RefundOrderResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *RefundOrderResp) ReadField255(iprot thrift.TProtocol) error {
+	p.BaseResp = base.NewBaseResp()
+	if err := p.BaseResp.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *RefundOrderResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("RefundOrderResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *RefundOrderResp) writeField255(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("BaseResp", thrift.STRUCT, 255); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *RefundOrderResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RefundOrderResp(%+v)", *p)
+}
+
+func (p *RefundOrderResp) DeepEqual(ano *RefundOrderResp) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field255DeepEqual(ano.BaseResp) {
+		return false
+	}
+	return true
+}
+
+func (p *RefundOrderResp) Field255DeepEqual(src *base.BaseResp) bool {
+
+	if !p.BaseResp.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type ShopRefundOrderReq struct {
+	OrderId int64 `thrift:"order_id,1,required" frugal:"1,required,i64" json:"order_id"`
+}
+
+func NewShopRefundOrderReq() *ShopRefundOrderReq {
+	return &ShopRefundOrderReq{}
+}
+
+func (p *ShopRefundOrderReq) InitDefault() {
+	*p = ShopRefundOrderReq{}
+}
+
+func (p *ShopRefundOrderReq) GetOrderId() (v int64) {
+	return p.OrderId
+}
+func (p *ShopRefundOrderReq) SetOrderId(val int64) {
+	p.OrderId = val
+}
+
+var fieldIDToName_ShopRefundOrderReq = map[int16]string{
+	1: "order_id",
+}
+
+func (p *ShopRefundOrderReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetOrderId bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetOrderId = true
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetOrderId {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ShopRefundOrderReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ShopRefundOrderReq[fieldId]))
+}
+
+func (p *ShopRefundOrderReq) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		p.OrderId = v
+	}
+	return nil
+}
+
+func (p *ShopRefundOrderReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ShopRefundOrderReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ShopRefundOrderReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("order_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.OrderId); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ShopRefundOrderReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ShopRefundOrderReq(%+v)", *p)
+}
+
+func (p *ShopRefundOrderReq) DeepEqual(ano *ShopRefundOrderReq) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.OrderId) {
+		return false
+	}
+	return true
+}
+
+func (p *ShopRefundOrderReq) Field1DeepEqual(src int64) bool {
+
+	if p.OrderId != src {
+		return false
+	}
+	return true
+}
+
+type ShopRefundOrderResp struct {
+	BaseResp *base.BaseResp `thrift:"BaseResp,255" frugal:"255,default,base.BaseResp" json:"BaseResp"`
+}
+
+func NewShopRefundOrderResp() *ShopRefundOrderResp {
+	return &ShopRefundOrderResp{}
+}
+
+func (p *ShopRefundOrderResp) InitDefault() {
+	*p = ShopRefundOrderResp{}
+}
+
+var ShopRefundOrderResp_BaseResp_DEFAULT *base.BaseResp
+
+func (p *ShopRefundOrderResp) GetBaseResp() (v *base.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return ShopRefundOrderResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *ShopRefundOrderResp) SetBaseResp(val *base.BaseResp) {
+	p.BaseResp = val
+}
+
+var fieldIDToName_ShopRefundOrderResp = map[int16]string{
+	255: "BaseResp",
+}
+
+func (p *ShopRefundOrderResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *ShopRefundOrderResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ShopRefundOrderResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ShopRefundOrderResp) ReadField255(iprot thrift.TProtocol) error {
+	p.BaseResp = base.NewBaseResp()
+	if err := p.BaseResp.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *ShopRefundOrderResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ShopRefundOrderResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ShopRefundOrderResp) writeField255(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("BaseResp", thrift.STRUCT, 255); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *ShopRefundOrderResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ShopRefundOrderResp(%+v)", *p)
+}
+
+func (p *ShopRefundOrderResp) DeepEqual(ano *ShopRefundOrderResp) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field255DeepEqual(ano.BaseResp) {
+		return false
+	}
+	return true
+}
+
+func (p *ShopRefundOrderResp) Field255DeepEqual(src *base.BaseResp) bool {
+
+	if !p.BaseResp.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type ListOrderReq struct {
+	UserId int64   `thrift:"user_id,1,required" frugal:"1,required,i64" json:"user_id"`
+	Status *Status `thrift:"status,2,optional" frugal:"2,optional,Status" json:"status,omitempty"`
+}
+
+func NewListOrderReq() *ListOrderReq {
+	return &ListOrderReq{}
+}
+
+func (p *ListOrderReq) InitDefault() {
+	*p = ListOrderReq{}
+}
+
+func (p *ListOrderReq) GetUserId() (v int64) {
+	return p.UserId
+}
+
+var ListOrderReq_Status_DEFAULT Status
+
+func (p *ListOrderReq) GetStatus() (v Status) {
+	if !p.IsSetStatus() {
+		return ListOrderReq_Status_DEFAULT
+	}
+	return *p.Status
+}
+func (p *ListOrderReq) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *ListOrderReq) SetStatus(val *Status) {
+	p.Status = val
+}
+
+var fieldIDToName_ListOrderReq = map[int16]string{
+	1: "user_id",
+	2: "status",
+}
+
+func (p *ListOrderReq) IsSetStatus() bool {
+	return p.Status != nil
+}
+
+func (p *ListOrderReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetUserId bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetUserId = true
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetUserId {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListOrderReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ListOrderReq[fieldId]))
+}
+
+func (p *ListOrderReq) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		p.UserId = v
+	}
+	return nil
+}
+
+func (p *ListOrderReq) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		tmp := Status(v)
+		p.Status = &tmp
+	}
+	return nil
+}
+
+func (p *ListOrderReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ListOrderReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ListOrderReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.UserId); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ListOrderReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetStatus() {
+		if err = oprot.WriteFieldBegin("status", thrift.I32, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(int32(*p.Status)); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *ListOrderReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListOrderReq(%+v)", *p)
+}
+
+func (p *ListOrderReq) DeepEqual(ano *ListOrderReq) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.UserId) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Status) {
+		return false
+	}
+	return true
+}
+
+func (p *ListOrderReq) Field1DeepEqual(src int64) bool {
+
+	if p.UserId != src {
+		return false
+	}
+	return true
+}
+func (p *ListOrderReq) Field2DeepEqual(src *Status) bool {
+
+	if p.Status == src {
+		return true
+	} else if p.Status == nil || src == nil {
+		return false
+	}
+	if *p.Status != *src {
+		return false
+	}
+	return true
+}
+
+type ListOrderResp struct {
+	Orders   []*OrderItem   `thrift:"orders,1" frugal:"1,default,list<OrderItem>" json:"orders"`
+	BaseResp *base.BaseResp `thrift:"BaseResp,255" frugal:"255,default,base.BaseResp" json:"BaseResp"`
+}
+
+func NewListOrderResp() *ListOrderResp {
+	return &ListOrderResp{}
+}
+
+func (p *ListOrderResp) InitDefault() {
+	*p = ListOrderResp{}
+}
+
+func (p *ListOrderResp) GetOrders() (v []*OrderItem) {
+	return p.Orders
+}
+
+var ListOrderResp_BaseResp_DEFAULT *base.BaseResp
+
+func (p *ListOrderResp) GetBaseResp() (v *base.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return ListOrderResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *ListOrderResp) SetOrders(val []*OrderItem) {
+	p.Orders = val
+}
+func (p *ListOrderResp) SetBaseResp(val *base.BaseResp) {
+	p.BaseResp = val
+}
+
+var fieldIDToName_ListOrderResp = map[int16]string{
+	1:   "orders",
+	255: "BaseResp",
+}
+
+func (p *ListOrderResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *ListOrderResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListOrderResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ListOrderResp) ReadField1(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	p.Orders = make([]*OrderItem, 0, size)
+	for i := 0; i < size; i++ {
+		_elem := NewOrderItem()
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		p.Orders = append(p.Orders, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *ListOrderResp) ReadField255(iprot thrift.TProtocol) error {
+	p.BaseResp = base.NewBaseResp()
+	if err := p.BaseResp.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *ListOrderResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ListOrderResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ListOrderResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("orders", thrift.LIST, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Orders)); err != nil {
+		return err
+	}
+	for _, v := range p.Orders {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ListOrderResp) writeField255(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("BaseResp", thrift.STRUCT, 255); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *ListOrderResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListOrderResp(%+v)", *p)
+}
+
+func (p *ListOrderResp) DeepEqual(ano *ListOrderResp) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Orders) {
+		return false
+	}
+	if !p.Field255DeepEqual(ano.BaseResp) {
+		return false
+	}
+	return true
+}
+
+func (p *ListOrderResp) Field1DeepEqual(src []*OrderItem) bool {
+
+	if len(p.Orders) != len(src) {
+		return false
+	}
+	for i, v := range p.Orders {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+func (p *ListOrderResp) Field255DeepEqual(src *base.BaseResp) bool {
+
+	if !p.BaseResp.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type GetOrderByIdReq struct {
+	OrderId int64 `thrift:"order_id,1,required" frugal:"1,required,i64" json:"order_id"`
+	UserId  int64 `thrift:"user_id,2,required" frugal:"2,required,i64" json:"user_id"`
+}
+
+func NewGetOrderByIdReq() *GetOrderByIdReq {
+	return &GetOrderByIdReq{}
+}
+
+func (p *GetOrderByIdReq) InitDefault() {
+	*p = GetOrderByIdReq{}
+}
+
+func (p *GetOrderByIdReq) GetOrderId() (v int64) {
+	return p.OrderId
+}
+
+func (p *GetOrderByIdReq) GetUserId() (v int64) {
+	return p.UserId
+}
+func (p *GetOrderByIdReq) SetOrderId(val int64) {
+	p.OrderId = val
+}
+func (p *GetOrderByIdReq) SetUserId(val int64) {
+	p.UserId = val
+}
+
+var fieldIDToName_GetOrderByIdReq = map[int16]string{
+	1: "order_id",
+	2: "user_id",
+}
+
+func (p *GetOrderByIdReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetOrderId bool = false
+	var issetUserId bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetOrderId = true
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetUserId = true
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetOrderId {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetUserId {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetOrderByIdReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_GetOrderByIdReq[fieldId]))
+}
+
+func (p *GetOrderByIdReq) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		p.OrderId = v
+	}
+	return nil
+}
+
+func (p *GetOrderByIdReq) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		p.UserId = v
+	}
+	return nil
+}
+
+func (p *GetOrderByIdReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetOrderByIdReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *GetOrderByIdReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("order_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.OrderId); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *GetOrderByIdReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.UserId); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *GetOrderByIdReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetOrderByIdReq(%+v)", *p)
+}
+
+func (p *GetOrderByIdReq) DeepEqual(ano *GetOrderByIdReq) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.OrderId) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.UserId) {
+		return false
+	}
+	return true
+}
+
+func (p *GetOrderByIdReq) Field1DeepEqual(src int64) bool {
+
+	if p.OrderId != src {
+		return false
+	}
+	return true
+}
+func (p *GetOrderByIdReq) Field2DeepEqual(src int64) bool {
+
+	if p.UserId != src {
+		return false
+	}
+	return true
+}
+
+type GetOrderByIdResp struct {
+	Order    *OrderItem     `thrift:"order,1" frugal:"1,default,OrderItem" json:"order"`
+	BaseResp *base.BaseResp `thrift:"BaseResp,255" frugal:"255,default,base.BaseResp" json:"BaseResp"`
+}
+
+func NewGetOrderByIdResp() *GetOrderByIdResp {
+	return &GetOrderByIdResp{}
+}
+
+func (p *GetOrderByIdResp) InitDefault() {
+	*p = GetOrderByIdResp{}
+}
+
+var GetOrderByIdResp_Order_DEFAULT *OrderItem
+
+func (p *GetOrderByIdResp) GetOrder() (v *OrderItem) {
+	if !p.IsSetOrder() {
+		return GetOrderByIdResp_Order_DEFAULT
+	}
+	return p.Order
+}
+
+var GetOrderByIdResp_BaseResp_DEFAULT *base.BaseResp
+
+func (p *GetOrderByIdResp) GetBaseResp() (v *base.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return GetOrderByIdResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *GetOrderByIdResp) SetOrder(val *OrderItem) {
+	p.Order = val
+}
+func (p *GetOrderByIdResp) SetBaseResp(val *base.BaseResp) {
+	p.BaseResp = val
+}
+
+var fieldIDToName_GetOrderByIdResp = map[int16]string{
+	1:   "order",
+	255: "BaseResp",
+}
+
+func (p *GetOrderByIdResp) IsSetOrder() bool {
+	return p.Order != nil
+}
+
+func (p *GetOrderByIdResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *GetOrderByIdResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetOrderByIdResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *GetOrderByIdResp) ReadField1(iprot thrift.TProtocol) error {
+	p.Order = NewOrderItem()
+	if err := p.Order.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *GetOrderByIdResp) ReadField255(iprot thrift.TProtocol) error {
+	p.BaseResp = base.NewBaseResp()
+	if err := p.BaseResp.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *GetOrderByIdResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetOrderByIdResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *GetOrderByIdResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("order", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Order.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *GetOrderByIdResp) writeField255(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("BaseResp", thrift.STRUCT, 255); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *GetOrderByIdResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetOrderByIdResp(%+v)", *p)
+}
+
+func (p *GetOrderByIdResp) DeepEqual(ano *GetOrderByIdResp) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Order) {
+		return false
+	}
+	if !p.Field255DeepEqual(ano.BaseResp) {
+		return false
+	}
+	return true
+}
+
+func (p *GetOrderByIdResp) Field1DeepEqual(src *OrderItem) bool {
+
+	if !p.Order.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *GetOrderByIdResp) Field255DeepEqual(src *base.BaseResp) bool {
+
+	if !p.BaseResp.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type CartItem struct {
+	ProductId int64  `thrift:"product_id,1" frugal:"1,default,i64" json:"product_id"`
+	SkuId     int64  `thrift:"sku_id,2" frugal:"2,default,i64" json:"sku_id"`
+	StockNum  int64  `thrift:"stock_num,3" frugal:"3,default,i64" json:"stock_num"`
+	Name      string `thrift:"name,4" frugal:"4,default,string" json:"name"`
+	Pic       string `thrift:"pic,5" frugal:"5,default,string" json:"pic"`
+	Edition   string `thrift:"edition,6" frugal:"6,default,string" json:"edition"`
+	Price     int64  `thrift:"price,7" frugal:"7,default,i64" json:"price"`
+	Available bool   `thrift:"available,8" frugal:"8,default,bool" json:"available"`
+}
+
+func NewCartItem() *CartItem {
+	return &CartItem{}
+}
+
+func (p *CartItem) InitDefault() {
+	*p = CartItem{}
+}
+
+func (p *CartItem) GetProductId() (v int64) {
+	return p.ProductId
+}
+
+func (p *CartItem) GetSkuId() (v int64) {
+	return p.SkuId
+}
+
+func (p *CartItem) GetStockNum() (v int64) {
+	return p.StockNum
+}
+
+func (p *CartItem) GetName() (v string) {
+	return p.Name
+}
+
+func (p *CartItem) GetPic() (v string) {
+	return p.Pic
+}
+
+func (p *CartItem) GetEdition() (v string) {
+	return p.Edition
+}
+
+func (p *CartItem) GetPrice() (v int64) {
+	return p.Price
+}
+
+func (p *CartItem) GetAvailable() (v bool) {
+	return p.Available
+}
+func (p *CartItem) SetProductId(val int64) {
+	p.ProductId = val
+}
+func (p *CartItem) SetSkuId(val int64) {
+	p.SkuId = val
+}
+func (p *CartItem) SetStockNum(val int64) {
+	p.StockNum = val
+}
+func (p *CartItem) SetName(val string) {
+	p.Name = val
+}
+func (p *CartItem) SetPic(val string) {
+	p.Pic = val
+}
+func (p *CartItem) SetEdition(val string) {
+	p.Edition = val
+}
+func (p *CartItem) SetPrice(val int64) {
+	p.Price = val
+}
+func (p *CartItem) SetAvailable(val bool) {
+	p.Available = val
+}
+
+var fieldIDToName_CartItem = map[int16]string{
+	1: "product_id",
+	2: "sku_id",
+	3: "stock_num",
+	4: "name",
+	5: "pic",
+	6: "edition",
+	7: "price",
+	8: "available",
+}
+
+func (p *CartItem) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 6:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 7:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 8:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CartItem[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *CartItem) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		p.ProductId = v
+	}
+	return nil
+}
+
+func (p *CartItem) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		p.SkuId = v
+	}
+	return nil
+}
+
+func (p *CartItem) ReadField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		p.StockNum = v
+	}
+	return nil
+}
+
+func (p *CartItem) ReadField4(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.Name = v
+	}
+	return nil
+}
+
+func (p *CartItem) ReadField5(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.Pic = v
+	}
+	return nil
+}
+
+func (p *CartItem) ReadField6(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.Edition = v
+	}
+	return nil
+}
+
+func (p *CartItem) ReadField7(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		p.Price = v
+	}
+	return nil
+}
+
+func (p *CartItem) ReadField8(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		p.Available = v
+	}
+	return nil
+}
+
+func (p *CartItem) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("CartItem"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *CartItem) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("product_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.ProductId); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *CartItem) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("sku_id", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.SkuId); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *CartItem) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("stock_num", thrift.I64, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.StockNum); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *CartItem) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("name", thrift.STRING, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Name); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *CartItem) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("pic", thrift.STRING, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Pic); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *CartItem) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("edition", thrift.STRING, 6); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Edition); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
+func (p *CartItem) writeField7(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("price", thrift.I64, 7); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.Price); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+
+func (p *CartItem) writeField8(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("available", thrift.BOOL, 8); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBool(p.Available); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+
+func (p *CartItem) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CartItem(%+v)", *p)
+}
+
+func (p *CartItem) DeepEqual(ano *CartItem) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.ProductId) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.SkuId) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.StockNum) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.Name) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.Pic) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.Edition) {
+		return false
+	}
+	if !p.Field7DeepEqual(ano.Price) {
+		return false
+	}
+	if !p.Field8DeepEqual(ano.Available) {
+		return false
+	}
+	return true
+}
+
+func (p *CartItem) Field1DeepEqual(src int64) bool {
+
+	if p.ProductId != src {
+		return false
+	}
+	return true
+}
+func (p *CartItem) Field2DeepEqual(src int64) bool {
+
+	if p.SkuId != src {
+		return false
+	}
+	return true
+}
+func (p *CartItem) Field3DeepEqual(src int64) bool {
+
+	if p.StockNum != src {
+		return false
+	}
+	return true
+}
+func (p *CartItem) Field4DeepEqual(src string) bool {
+
+	if strings.Compare(p.Name, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *CartItem) Field5DeepEqual(src string) bool {
+
+	if strings.Compare(p.Pic, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *CartItem) Field6DeepEqual(src string) bool {
+
+	if strings.Compare(p.Edition, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *CartItem) Field7DeepEqual(src int64) bool {
+
+	if p.Price != src {
+		return false
+	}
+	return true
+}
+func (p *CartItem) Field8DeepEqual(src bool) bool {
+
+	if p.Available != src {
+		return false
+	}
+	return true
+}
+
+type AddCartItemReq struct {
+	UserId    int64  `thrift:"user_id,1,required" frugal:"1,required,i64" json:"user_id"`
+	ProductId int64  `thrift:"product_id,2,required" frugal:"2,required,i64" json:"product_id"`
+	SkuId     *int64 `thrift:"sku_id,3,optional" frugal:"3,optional,i64" json:"sku_id,omitempty"`
+	StockNum  int64  `thrift:"stock_num,4,required" frugal:"4,required,i64" json:"stock_num"`
+}
+
+func NewAddCartItemReq() *AddCartItemReq {
+	return &AddCartItemReq{}
+}
+
+func (p *AddCartItemReq) InitDefault() {
+	*p = AddCartItemReq{}
+}
+
+func (p *AddCartItemReq) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *AddCartItemReq) GetProductId() (v int64) {
+	return p.ProductId
+}
+
+var AddCartItemReq_SkuId_DEFAULT int64
+
+func (p *AddCartItemReq) GetSkuId() (v int64) {
+	if !p.IsSetSkuId() {
+		return AddCartItemReq_SkuId_DEFAULT
+	}
+	return *p.SkuId
+}
+
+func (p *AddCartItemReq) GetStockNum() (v int64) {
+	return p.StockNum
+}
+func (p *AddCartItemReq) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *AddCartItemReq) SetProductId(val int64) {
+	p.ProductId = val
+}
+func (p *AddCartItemReq) SetSkuId(val *int64) {
+	p.SkuId = val
+}
+func (p *AddCartItemReq) SetStockNum(val int64) {
+	p.StockNum = val
+}
+
+var fieldIDToName_AddCartItemReq = map[int16]string{
+	1: "user_id",
+	2: "product_id",
+	3: "sku_id",
+	4: "stock_num",
+}
+
+func (p *AddCartItemReq) IsSetSkuId() bool {
+	return p.SkuId != nil
+}
+
+func (p *AddCartItemReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetUserId bool = false
+	var issetProductId bool = false
+	var issetStockNum bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetUserId = true
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetProductId = true
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetStockNum = true
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetUserId {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetProductId {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetStockNum {
+		fieldId = 4
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AddCartItemReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_AddCartItemReq[fieldId]))
+}
+
+func (p *AddCartItemReq) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		p.UserId = v
+	}
+	return nil
+}
+
+func (p *AddCartItemReq) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		p.ProductId = v
+	}
+	return nil
+}
+
+func (p *AddCartItemReq) ReadField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		p.SkuId = &v
+	}
+	return nil
+}
+
+func (p *AddCartItemReq) ReadField4(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		p.StockNum = v
+	}
+	return nil
+}
+
+func (p *AddCartItemReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("AddCartItemReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AddCartItemReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.UserId); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *AddCartItemReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("product_id", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.ProductId); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *AddCartItemReq) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSkuId() {
+		if err = oprot.WriteFieldBegin("sku_id", thrift.I64, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.SkuId); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *AddCartItemReq) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("stock_num", thrift.I64, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.StockNum); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *AddCartItemReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AddCartItemReq(%+v)", *p)
+}
+
+func (p *AddCartItemReq) DeepEqual(ano *AddCartItemReq) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.UserId) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.ProductId) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.SkuId) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.StockNum) {
+		return false
+	}
+	return true
+}
+
+func (p *AddCartItemReq) Field1DeepEqual(src int64) bool {
+
+	if p.UserId != src {
+		return false
+	}
+	return true
+}
+func (p *AddCartItemReq) Field2DeepEqual(src int64) bool {
+
+	if p.ProductId != src {
+		return false
+	}
+	return true
+}
+func (p *AddCartItemReq) Field3DeepEqual(src *int64) bool {
+
+	if p.SkuId == src {
+		return true
+	} else if p.SkuId == nil || src == nil {
+		return false
+	}
+	if *p.SkuId != *src {
+		return false
+	}
+	return true
+}
+func (p *AddCartItemReq) Field4DeepEqual(src int64) bool {
+
+	if p.StockNum != src {
+		return false
+	}
+	return true
+}
+
+type AddCartItemResp struct {
+	BaseResp *base.BaseResp `thrift:"BaseResp,255" frugal:"255,default,base.BaseResp" json:"BaseResp"`
+}
+
+func NewAddCartItemResp() *AddCartItemResp {
+	return &AddCartItemResp{}
+}
+
+func (p *AddCartItemResp) InitDefault() {
+	*p = AddCartItemResp{}
+}
+
+var AddCartItemResp_BaseResp_DEFAULT *base.BaseResp
+
+func (p *AddCartItemResp) GetBaseResp() (v *base.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return AddCartItemResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *AddCartItemResp) SetBaseResp(val *base.BaseResp) {
+	p.BaseResp = val
+}
+
+var fieldIDToName_AddCartItemResp = map[int16]string{
+	255: "BaseResp",
+}
+
+func (p *AddCartItemResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *AddCartItemResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AddCartItemResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *AddCartItemResp) ReadField255(iprot thrift.TProtocol) error {
+	p.BaseResp = base.NewBaseResp()
+	if err := p.BaseResp.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *AddCartItemResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("AddCartItemResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AddCartItemResp) writeField255(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("BaseResp", thrift.STRUCT, 255); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *AddCartItemResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AddCartItemResp(%+v)", *p)
+}
+
+func (p *AddCartItemResp) DeepEqual(ano *AddCartItemResp) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field255DeepEqual(ano.BaseResp) {
+		return false
+	}
+	return true
+}
+
+func (p *AddCartItemResp) Field255DeepEqual(src *base.BaseResp) bool {
+
+	if !p.BaseResp.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type UpdateCartItemReq struct {
+	UserId    int64  `thrift:"user_id,1,required" frugal:"1,required,i64" json:"user_id"`
+	ProductId int64  `thrift:"product_id,2,required" frugal:"2,required,i64" json:"product_id"`
+	SkuId     *int64 `thrift:"sku_id,3,optional" frugal:"3,optional,i64" json:"sku_id,omitempty"`
+	StockNum  int64  `thrift:"stock_num,4,required" frugal:"4,required,i64" json:"stock_num"`
+}
+
+func NewUpdateCartItemReq() *UpdateCartItemReq {
+	return &UpdateCartItemReq{}
+}
+
+func (p *UpdateCartItemReq) InitDefault() {
+	*p = UpdateCartItemReq{}
+}
+
+func (p *UpdateCartItemReq) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *UpdateCartItemReq) GetProductId() (v int64) {
+	return p.ProductId
+}
+
+var UpdateCartItemReq_SkuId_DEFAULT int64
+
+func (p *UpdateCartItemReq) GetSkuId() (v int64) {
+	if !p.IsSetSkuId() {
+		return UpdateCartItemReq_SkuId_DEFAULT
+	}
+	return *p.SkuId
+}
+
+func (p *UpdateCartItemReq) GetStockNum() (v int64) {
+	return p.StockNum
+}
+func (p *UpdateCartItemReq) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *UpdateCartItemReq) SetProductId(val int64) {
+	p.ProductId = val
+}
+func (p *UpdateCartItemReq) SetSkuId(val *int64) {
+	p.SkuId = val
+}
+func (p *UpdateCartItemReq) SetStockNum(val int64) {
+	p.StockNum = val
+}
+
+var fieldIDToName_UpdateCartItemReq = map[int16]string{
+	1: "user_id",
+	2: "product_id",
+	3: "sku_id",
+	4: "stock_num",
+}
+
+func (p *UpdateCartItemReq) IsSetSkuId() bool {
+	return p.SkuId != nil
+}
+
+func (p *UpdateCartItemReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetUserId bool = false
+	var issetProductId bool = false
+	var issetStockNum bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetUserId = true
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetProductId = true
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetStockNum = true
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetUserId {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetProductId {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetStockNum {
+		fieldId = 4
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdateCartItemReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_UpdateCartItemReq[fieldId]))
+}
+
+func (p *UpdateCartItemReq) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		p.UserId = v
+	}
+	return nil
+}
+
+func (p *UpdateCartItemReq) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		p.ProductId = v
+	}
+	return nil
+}
+
+func (p *UpdateCartItemReq) ReadField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		p.SkuId = &v
+	}
+	return nil
+}
+
+func (p *UpdateCartItemReq) ReadField4(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		p.StockNum = v
+	}
+	return nil
+}
+
+func (p *UpdateCartItemReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UpdateCartItemReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UpdateCartItemReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.UserId); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *UpdateCartItemReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("product_id", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.ProductId); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *UpdateCartItemReq) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSkuId() {
+		if err = oprot.WriteFieldBegin("sku_id", thrift.I64, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.SkuId); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *UpdateCartItemReq) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("stock_num", thrift.I64, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.StockNum); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *UpdateCartItemReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdateCartItemReq(%+v)", *p)
+}
+
+func (p *UpdateCartItemReq) DeepEqual(ano *UpdateCartItemReq) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.UserId) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.ProductId) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.SkuId) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.StockNum) {
+		return false
+	}
+	return true
+}
+
+func (p *UpdateCartItemReq) Field1DeepEqual(src int64) bool {
+
+	if p.UserId != src {
+		return false
+	}
+	return true
+}
+func (p *UpdateCartItemReq) Field2DeepEqual(src int64) bool {
+
+	if p.ProductId != src {
+		return false
+	}
+	return true
+}
+func (p *UpdateCartItemReq) Field3DeepEqual(src *int64) bool {
+
+	if p.SkuId == src {
+		return true
+	} else if p.SkuId == nil || src == nil {
+		return false
+	}
+	if *p.SkuId != *src {
+		return false
+	}
+	return true
+}
+func (p *UpdateCartItemReq) Field4DeepEqual(src int64) bool {
+
+	if p.StockNum != src {
+		return false
+	}
+	return true
+}
+
+type UpdateCartItemResp struct {
+	BaseResp *base.BaseResp `thrift:"BaseResp,255" frugal:"255,default,base.BaseResp" json:"BaseResp"`
+}
+
+func NewUpdateCartItemResp() *UpdateCartItemResp {
+	return &UpdateCartItemResp{}
+}
+
+func (p *UpdateCartItemResp) InitDefault() {
+	*p = UpdateCartItemResp{}
+}
+
+var UpdateCartItemResp_BaseResp_DEFAULT *base.BaseResp
+
+func (p *UpdateCartItemResp) GetBaseResp() (v *base.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return UpdateCartItemResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *UpdateCartItemResp) SetBaseResp(val *base.BaseResp) {
+	p.BaseResp = val
+}
+
+var fieldIDToName_UpdateCartItemResp = map[int16]string{
+	255: "BaseResp",
+}
+
+func (p *UpdateCartItemResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *UpdateCartItemResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdateCartItemResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UpdateCartItemResp) ReadField255(iprot thrift.TProtocol) error {
+	p.BaseResp = base.NewBaseResp()
+	if err := p.BaseResp.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *UpdateCartItemResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UpdateCartItemResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UpdateCartItemResp) writeField255(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("BaseResp", thrift.STRUCT, 255); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *UpdateCartItemResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdateCartItemResp(%+v)", *p)
+}
+
+func (p *UpdateCartItemResp) DeepEqual(ano *UpdateCartItemResp) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field255DeepEqual(ano.BaseResp) {
+		return false
+	}
+	return true
+}
+
+func (p *UpdateCartItemResp) Field255DeepEqual(src *base.BaseResp) bool {
+
+	if !p.BaseResp.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type RemoveCartItemReq struct {
+	UserId    int64  `thrift:"user_id,1,required" frugal:"1,required,i64" json:"user_id"`
+	ProductId int64  `thrift:"product_id,2,required" frugal:"2,required,i64" json:"product_id"`
+	SkuId     *int64 `thrift:"sku_id,3,optional" frugal:"3,optional,i64" json:"sku_id,omitempty"`
+}
+
+func NewRemoveCartItemReq() *RemoveCartItemReq {
+	return &RemoveCartItemReq{}
+}
+
+func (p *RemoveCartItemReq) InitDefault() {
+	*p = RemoveCartItemReq{}
+}
+
+func (p *RemoveCartItemReq) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *RemoveCartItemReq) GetProductId() (v int64) {
+	return p.ProductId
+}
+
+var RemoveCartItemReq_SkuId_DEFAULT int64
+
+func (p *RemoveCartItemReq) GetSkuId() (v int64) {
+	if !p.IsSetSkuId() {
+		return RemoveCartItemReq_SkuId_DEFAULT
+	}
+	return *p.SkuId
+}
+func (p *RemoveCartItemReq) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *RemoveCartItemReq) SetProductId(val int64) {
+	p.ProductId = val
+}
+func (p *RemoveCartItemReq) SetSkuId(val *int64) {
+	p.SkuId = val
+}
+
+var fieldIDToName_RemoveCartItemReq = map[int16]string{
+	1: "user_id",
+	2: "product_id",
+	3: "sku_id",
+}
+
+func (p *RemoveCartItemReq) IsSetSkuId() bool {
+	return p.SkuId != nil
+}
+
+func (p *RemoveCartItemReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetUserId bool = false
+	var issetProductId bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetUserId = true
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetProductId = true
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetUserId {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetProductId {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RemoveCartItemReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_RemoveCartItemReq[fieldId]))
+}
+
+func (p *RemoveCartItemReq) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		p.UserId = v
+	}
+	return nil
+}
+
+func (p *RemoveCartItemReq) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		p.ProductId = v
+	}
+	return nil
+}
+
+func (p *RemoveCartItemReq) ReadField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		p.SkuId = &v
+	}
+	return nil
+}
+
+func (p *RemoveCartItemReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("RemoveCartItemReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *RemoveCartItemReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.UserId); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *RemoveCartItemReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("product_id", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.ProductId); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *RemoveCartItemReq) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSkuId() {
+		if err = oprot.WriteFieldBegin("sku_id", thrift.I64, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.SkuId); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *RemoveCartItemReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RemoveCartItemReq(%+v)", *p)
+}
+
+func (p *RemoveCartItemReq) DeepEqual(ano *RemoveCartItemReq) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.UserId) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.ProductId) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.SkuId) {
+		return false
+	}
+	return true
+}
+
+func (p *RemoveCartItemReq) Field1DeepEqual(src int64) bool {
+
+	if p.UserId != src {
+		return false
+	}
+	return true
+}
+func (p *RemoveCartItemReq) Field2DeepEqual(src int64) bool {
+
+	if p.ProductId != src {
+		return false
+	}
+	return true
+}
+func (p *RemoveCartItemReq) Field3DeepEqual(src *int64) bool {
+
+	if p.SkuId == src {
+		return true
+	} else if p.SkuId == nil || src == nil {
+		return false
+	}
+	if *p.SkuId != *src {
+		return false
+	}
+	return true
+}
+
+type RemoveCartItemResp struct {
+	BaseResp *base.BaseResp `thrift:"BaseResp,255" frugal:"255,default,base.BaseResp" json:"BaseResp"`
+}
+
+func NewRemoveCartItemResp() *RemoveCartItemResp {
+	return &RemoveCartItemResp{}
+}
+
+func (p *RemoveCartItemResp) InitDefault() {
+	*p = RemoveCartItemResp{}
+}
+
+var RemoveCartItemResp_BaseResp_DEFAULT *base.BaseResp
+
+func (p *RemoveCartItemResp) GetBaseResp() (v *base.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return RemoveCartItemResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *RemoveCartItemResp) SetBaseResp(val *base.BaseResp) {
+	p.BaseResp = val
+}
+
+var fieldIDToName_RemoveCartItemResp = map[int16]string{
+	255: "BaseResp",
+}
+
+func (p *RemoveCartItemResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *RemoveCartItemResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RemoveCartItemResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *RemoveCartItemResp) ReadField255(iprot thrift.TProtocol) error {
+	p.BaseResp = base.NewBaseResp()
+	if err := p.BaseResp.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *RemoveCartItemResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("RemoveCartItemResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *RemoveCartItemResp) writeField255(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("BaseResp", thrift.STRUCT, 255); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *RemoveCartItemResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RemoveCartItemResp(%+v)", *p)
+}
+
+func (p *RemoveCartItemResp) DeepEqual(ano *RemoveCartItemResp) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field255DeepEqual(ano.BaseResp) {
+		return false
+	}
+	return true
+}
+
+func (p *RemoveCartItemResp) Field255DeepEqual(src *base.BaseResp) bool {
+
+	if !p.BaseResp.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type GetCartReq struct {
+	UserId int64 `thrift:"user_id,1,required" frugal:"1,required,i64" json:"user_id"`
+}
+
+func NewGetCartReq() *GetCartReq {
+	return &GetCartReq{}
+}
+
+func (p *GetCartReq) InitDefault() {
+	*p = GetCartReq{}
+}
+
+func (p *GetCartReq) GetUserId() (v int64) {
+	return p.UserId
+}
+func (p *GetCartReq) SetUserId(val int64) {
+	p.UserId = val
+}
+
+var fieldIDToName_GetCartReq = map[int16]string{
+	1: "user_id",
+}
+
+func (p *GetCartReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetUserId bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetUserId = true
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetUserId {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetCartReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_GetCartReq[fieldId]))
+}
+
+func (p *GetCartReq) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		p.UserId = v
+	}
+	return nil
+}
+
+func (p *GetCartReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetCartReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *GetCartReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.UserId); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *GetCartReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetCartReq(%+v)", *p)
+}
+
+func (p *GetCartReq) DeepEqual(ano *GetCartReq) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.UserId) {
+		return false
+	}
+	return true
+}
+
+func (p *GetCartReq) Field1DeepEqual(src int64) bool {
+
+	if p.UserId != src {
+		return false
+	}
+	return true
+}
+
+type GetCartResp struct {
+	Items      []*CartItem    `thrift:"items,1" frugal:"1,default,list<CartItem>" json:"items"`
+	TotalPrice int64          `thrift:"total_price,2" frugal:"2,default,i64" json:"total_price"`
+	BaseResp   *base.BaseResp `thrift:"BaseResp,255" frugal:"255,default,base.BaseResp" json:"BaseResp"`
+}
+
+func NewGetCartResp() *GetCartResp {
+	return &GetCartResp{}
+}
+
+func (p *GetCartResp) InitDefault() {
+	*p = GetCartResp{}
+}
+
+func (p *GetCartResp) GetItems() (v []*CartItem) {
+	return p.Items
+}
+
+func (p *GetCartResp) GetTotalPrice() (v int64) {
+	return p.TotalPrice
+}
+
+var GetCartResp_BaseResp_DEFAULT *base.BaseResp
+
+func (p *GetCartResp) GetBaseResp() (v *base.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return GetCartResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *GetCartResp) SetItems(val []*CartItem) {
+	p.Items = val
+}
+func (p *GetCartResp) SetTotalPrice(val int64) {
+	p.TotalPrice = val
+}
+func (p *GetCartResp) SetBaseResp(val *base.BaseResp) {
+	p.BaseResp = val
+}
+
+var fieldIDToName_GetCartResp = map[int16]string{
+	1:   "items",
+	2:   "total_price",
+	255: "BaseResp",
+}
+
+func (p *GetCartResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *GetCartResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetCartResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *GetCartResp) ReadField1(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	p.Items = make([]*CartItem, 0, size)
+	for i := 0; i < size; i++ {
+		_elem := NewCartItem()
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		p.Items = append(p.Items, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *GetCartResp) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		p.TotalPrice = v
+	}
+	return nil
+}
+
+func (p *GetCartResp) ReadField255(iprot thrift.TProtocol) error {
+	p.BaseResp = base.NewBaseResp()
+	if err := p.BaseResp.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *GetCartResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetCartResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *GetCartResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("items", thrift.LIST, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Items)); err != nil {
+		return err
+	}
+	for _, v := range p.Items {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *GetCartResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("total_price", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.TotalPrice); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *GetCartResp) writeField255(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("BaseResp", thrift.STRUCT, 255); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *GetCartResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetCartResp(%+v)", *p)
+}
+
+func (p *GetCartResp) DeepEqual(ano *GetCartResp) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Items) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.TotalPrice) {
+		return false
+	}
+	if !p.Field255DeepEqual(ano.BaseResp) {
+		return false
+	}
+	return true
+}
+
+func (p *GetCartResp) Field1DeepEqual(src []*CartItem) bool {
+
+	if len(p.Items) != len(src) {
+		return false
+	}
+	for i, v := range p.Items {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+func (p *GetCartResp) Field2DeepEqual(src int64) bool {
+
+	if p.TotalPrice != src {
+		return false
+	}
+	return true
+}
+func (p *GetCartResp) Field255DeepEqual(src *base.BaseResp) bool {
+
+	if !p.BaseResp.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type OrderService interface {
+	CreateOrder(ctx context.Context, req *CreateOrderReq) (r *CreateOrderResp, err error)
+
+	CancelOrder(ctx context.Context, req *CancelOrderReq) (r *CancelOrderResp, err error)
+
+	PayOrder(ctx context.Context, req *PayOrderReq) (r *PayOrderResp, err error)
+
+	ShipOrder(ctx context.Context, req *ShipOrderReq) (r *ShipOrderResp, err error)
+
+	DeliverOrder(ctx context.Context, req *DeliverOrderReq) (r *DeliverOrderResp, err error)
+
+	ConfirmReceipt(ctx context.Context, req *ConfirmReceiptReq) (r *ConfirmReceiptResp, err error)
+
+	RefundOrder(ctx context.Context, req *RefundOrderReq) (r *RefundOrderResp, err error)
+
+	ShopRefundOrder(ctx context.Context, req *ShopRefundOrderReq) (r *ShopRefundOrderResp, err error)
+
+	ListOrder(ctx context.Context, req *ListOrderReq) (r *ListOrderResp, err error)
+
+	GetOrderById(ctx context.Context, req *GetOrderByIdReq) (r *GetOrderByIdResp, err error)
+
+	AddCartItem(ctx context.Context, req *AddCartItemReq) (r *AddCartItemResp, err error)
+
+	UpdateCartItem(ctx context.Context, req *UpdateCartItemReq) (r *UpdateCartItemResp, err error)
+
+	RemoveCartItem(ctx context.Context, req *RemoveCartItemReq) (r *RemoveCartItemResp, err error)
+
+	GetCart(ctx context.Context, req *GetCartReq) (r *GetCartResp, err error)
+}
+
+type OrderServiceClient struct {
+	c thrift.TClient
+}
+
+func NewOrderServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *OrderServiceClient {
+	return &OrderServiceClient{
+		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
+	}
+}
+
+func NewOrderServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *OrderServiceClient {
+	return &OrderServiceClient{
+		c: thrift.NewTStandardClient(iprot, oprot),
+	}
+}
+
+func NewOrderServiceClient(c thrift.TClient) *OrderServiceClient {
+	return &OrderServiceClient{
+		c: c,
+	}
+}
+
+func (p *OrderServiceClient) Client_() thrift.TClient {
+	return p.c
+}
+
+func (p *OrderServiceClient) CreateOrder(ctx context.Context, req *CreateOrderReq) (r *CreateOrderResp, err error) {
+	var _args OrderServiceCreateOrderArgs
+	_args.Req = req
+	var _result OrderServiceCreateOrderResult
+	if err = p.Client_().Call(ctx, "CreateOrder", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *OrderServiceClient) CancelOrder(ctx context.Context, req *CancelOrderReq) (r *CancelOrderResp, err error) {
+	var _args OrderServiceCancelOrderArgs
+	_args.Req = req
+	var _result OrderServiceCancelOrderResult
+	if err = p.Client_().Call(ctx, "CancelOrder", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *OrderServiceClient) PayOrder(ctx context.Context, req *PayOrderReq) (r *PayOrderResp, err error) {
+	var _args OrderServicePayOrderArgs
+	_args.Req = req
+	var _result OrderServicePayOrderResult
+	if err = p.Client_().Call(ctx, "PayOrder", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *OrderServiceClient) ShipOrder(ctx context.Context, req *ShipOrderReq) (r *ShipOrderResp, err error) {
+	var _args OrderServiceShipOrderArgs
+	_args.Req = req
+	var _result OrderServiceShipOrderResult
+	if err = p.Client_().Call(ctx, "ShipOrder", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *OrderServiceClient) DeliverOrder(ctx context.Context, req *DeliverOrderReq) (r *DeliverOrderResp, err error) {
+	var _args OrderServiceDeliverOrderArgs
+	_args.Req = req
+	var _result OrderServiceDeliverOrderResult
+	if err = p.Client_().Call(ctx, "DeliverOrder", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *OrderServiceClient) ConfirmReceipt(ctx context.Context, req *ConfirmReceiptReq) (r *ConfirmReceiptResp, err error) {
+	var _args OrderServiceConfirmReceiptArgs
+	_args.Req = req
+	var _result OrderServiceConfirmReceiptResult
+	if err = p.Client_().Call(ctx, "ConfirmReceipt", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *OrderServiceClient) RefundOrder(ctx context.Context, req *RefundOrderReq) (r *RefundOrderResp, err error) {
+	var _args OrderServiceRefundOrderArgs
+	_args.Req = req
+	var _result OrderServiceRefundOrderResult
+	if err = p.Client_().Call(ctx, "RefundOrder", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *OrderServiceClient) ShopRefundOrder(ctx context.Context, req *ShopRefundOrderReq) (r *ShopRefundOrderResp, err error) {
+	var _args OrderServiceShopRefundOrderArgs
+	_args.Req = req
+	var _result OrderServiceShopRefundOrderResult
+	if err = p.Client_().Call(ctx, "ShopRefundOrder", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *OrderServiceClient) ListOrder(ctx context.Context, req *ListOrderReq) (r *ListOrderResp, err error) {
+	var _args OrderServiceListOrderArgs
+	_args.Req = req
+	var _result OrderServiceListOrderResult
+	if err = p.Client_().Call(ctx, "ListOrder", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *OrderServiceClient) GetOrderById(ctx context.Context, req *GetOrderByIdReq) (r *GetOrderByIdResp, err error) {
+	var _args OrderServiceGetOrderByIdArgs
+	_args.Req = req
+	var _result OrderServiceGetOrderByIdResult
+	if err = p.Client_().Call(ctx, "GetOrderById", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *OrderServiceClient) AddCartItem(ctx context.Context, req *AddCartItemReq) (r *AddCartItemResp, err error) {
+	var _args OrderServiceAddCartItemArgs
+	_args.Req = req
+	var _result OrderServiceAddCartItemResult
+	if err = p.Client_().Call(ctx, "AddCartItem", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *OrderServiceClient) UpdateCartItem(ctx context.Context, req *UpdateCartItemReq) (r *UpdateCartItemResp, err error) {
+	var _args OrderServiceUpdateCartItemArgs
+	_args.Req = req
+	var _result OrderServiceUpdateCartItemResult
+	if err = p.Client_().Call(ctx, "UpdateCartItem", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *OrderServiceClient) RemoveCartItem(ctx context.Context, req *RemoveCartItemReq) (r *RemoveCartItemResp, err error) {
+	var _args OrderServiceRemoveCartItemArgs
+	_args.Req = req
+	var _result OrderServiceRemoveCartItemResult
+	if err = p.Client_().Call(ctx, "RemoveCartItem", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *OrderServiceClient) GetCart(ctx context.Context, req *GetCartReq) (r *GetCartResp, err error) {
+	var _args OrderServiceGetCartArgs
+	_args.Req = req
+	var _result OrderServiceGetCartResult
+	if err = p.Client_().Call(ctx, "GetCart", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+type OrderServiceProcessor struct {
+	processorMap map[string]thrift.TProcessorFunction
+	handler      OrderService
+}
+
+func (p *OrderServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
+	p.processorMap[key] = processor
+}
+
+func (p *OrderServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
+	processor, ok = p.processorMap[key]
+	return processor, ok
+}
+
+func (p *OrderServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
+	return p.processorMap
+}
+
+func NewOrderServiceProcessor(handler OrderService) *OrderServiceProcessor {
+	self := &OrderServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
+	self.AddToProcessorMap("CreateOrder", &orderServiceProcessorCreateOrder{handler: handler})
+	self.AddToProcessorMap("CancelOrder", &orderServiceProcessorCancelOrder{handler: handler})
+	self.AddToProcessorMap("PayOrder", &orderServiceProcessorPayOrder{handler: handler})
+	self.AddToProcessorMap("ShipOrder", &orderServiceProcessorShipOrder{handler: handler})
+	self.AddToProcessorMap("DeliverOrder", &orderServiceProcessorDeliverOrder{handler: handler})
+	self.AddToProcessorMap("ConfirmReceipt", &orderServiceProcessorConfirmReceipt{handler: handler})
+	self.AddToProcessorMap("RefundOrder", &orderServiceProcessorRefundOrder{handler: handler})
+	self.AddToProcessorMap("ShopRefundOrder", &orderServiceProcessorShopRefundOrder{handler: handler})
+	self.AddToProcessorMap("ListOrder", &orderServiceProcessorListOrder{handler: handler})
+	self.AddToProcessorMap("GetOrderById", &orderServiceProcessorGetOrderById{handler: handler})
+	self.AddToProcessorMap("AddCartItem", &orderServiceProcessorAddCartItem{handler: handler})
+	self.AddToProcessorMap("UpdateCartItem", &orderServiceProcessorUpdateCartItem{handler: handler})
+	self.AddToProcessorMap("RemoveCartItem", &orderServiceProcessorRemoveCartItem{handler: handler})
+	self.AddToProcessorMap("GetCart", &orderServiceProcessorGetCart{handler: handler})
+	return self
+}
+func (p *OrderServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	name, _, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return false, err
+	}
+	if processor, ok := p.GetProcessorFunction(name); ok {
+		return processor.Process(ctx, seqId, iprot, oprot)
+	}
+	iprot.Skip(thrift.STRUCT)
+	iprot.ReadMessageEnd()
+	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
+	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
+	x.Write(oprot)
+	oprot.WriteMessageEnd()
+	oprot.Flush(ctx)
+	return false, x
+}
+
+type orderServiceProcessorCreateOrder struct {
+	handler OrderService
+}
+
+func (p *orderServiceProcessorCreateOrder) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := OrderServiceCreateOrderArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("CreateOrder", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := OrderServiceCreateOrderResult{}
+	var retval *CreateOrderResp
+	if retval, err2 = p.handler.CreateOrder(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing CreateOrder: "+err2.Error())
+		oprot.WriteMessageBegin("CreateOrder", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("CreateOrder", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type orderServiceProcessorCancelOrder struct {
+	handler OrderService
+}
+
+func (p *orderServiceProcessorCancelOrder) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := OrderServiceCancelOrderArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("CancelOrder", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := OrderServiceCancelOrderResult{}
+	var retval *CancelOrderResp
+	if retval, err2 = p.handler.CancelOrder(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing CancelOrder: "+err2.Error())
+		oprot.WriteMessageBegin("CancelOrder", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("CancelOrder", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type orderServiceProcessorPayOrder struct {
+	handler OrderService
+}
+
+func (p *orderServiceProcessorPayOrder) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := OrderServicePayOrderArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("PayOrder", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := OrderServicePayOrderResult{}
+	var retval *PayOrderResp
+	if retval, err2 = p.handler.PayOrder(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing PayOrder: "+err2.Error())
+		oprot.WriteMessageBegin("PayOrder", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("PayOrder", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type orderServiceProcessorShipOrder struct {
+	handler OrderService
+}
+
+func (p *orderServiceProcessorShipOrder) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := OrderServiceShipOrderArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ShipOrder", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := OrderServiceShipOrderResult{}
+	var retval *ShipOrderResp
+	if retval, err2 = p.handler.ShipOrder(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ShipOrder: "+err2.Error())
+		oprot.WriteMessageBegin("ShipOrder", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ShipOrder", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type orderServiceProcessorDeliverOrder struct {
+	handler OrderService
+}
+
+func (p *orderServiceProcessorDeliverOrder) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := OrderServiceDeliverOrderArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("DeliverOrder", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := OrderServiceDeliverOrderResult{}
+	var retval *DeliverOrderResp
+	if retval, err2 = p.handler.DeliverOrder(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing DeliverOrder: "+err2.Error())
+		oprot.WriteMessageBegin("DeliverOrder", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("DeliverOrder", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type orderServiceProcessorConfirmReceipt struct {
+	handler OrderService
+}
+
+func (p *orderServiceProcessorConfirmReceipt) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := OrderServiceConfirmReceiptArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ConfirmReceipt", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := OrderServiceConfirmReceiptResult{}
+	var retval *ConfirmReceiptResp
+	if retval, err2 = p.handler.ConfirmReceipt(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ConfirmReceipt: "+err2.Error())
+		oprot.WriteMessageBegin("ConfirmReceipt", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ConfirmReceipt", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type orderServiceProcessorRefundOrder struct {
+	handler OrderService
+}
+
+func (p *orderServiceProcessorRefundOrder) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := OrderServiceRefundOrderArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("RefundOrder", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := OrderServiceRefundOrderResult{}
+	var retval *RefundOrderResp
+	if retval, err2 = p.handler.RefundOrder(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RefundOrder: "+err2.Error())
+		oprot.WriteMessageBegin("RefundOrder", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("RefundOrder", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type orderServiceProcessorShopRefundOrder struct {
+	handler OrderService
+}
+
+func (p *orderServiceProcessorShopRefundOrder) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := OrderServiceShopRefundOrderArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ShopRefundOrder", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := OrderServiceShopRefundOrderResult{}
+	var retval *ShopRefundOrderResp
+	if retval, err2 = p.handler.ShopRefundOrder(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ShopRefundOrder: "+err2.Error())
+		oprot.WriteMessageBegin("ShopRefundOrder", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ShopRefundOrder", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type orderServiceProcessorListOrder struct {
+	handler OrderService
+}
+
+func (p *orderServiceProcessorListOrder) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := OrderServiceListOrderArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ListOrder", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := OrderServiceListOrderResult{}
+	var retval *ListOrderResp
+	if retval, err2 = p.handler.ListOrder(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ListOrder: "+err2.Error())
+		oprot.WriteMessageBegin("ListOrder", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ListOrder", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type orderServiceProcessorGetOrderById struct {
+	handler OrderService
+}
+
+func (p *orderServiceProcessorGetOrderById) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := OrderServiceGetOrderByIdArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetOrderById", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := OrderServiceGetOrderByIdResult{}
+	var retval *GetOrderByIdResp
+	if retval, err2 = p.handler.GetOrderById(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetOrderById: "+err2.Error())
+		oprot.WriteMessageBegin("GetOrderById", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetOrderById", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type orderServiceProcessorAddCartItem struct {
+	handler OrderService
+}
+
+func (p *orderServiceProcessorAddCartItem) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := OrderServiceAddCartItemArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("AddCartItem", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := OrderServiceAddCartItemResult{}
+	var retval *AddCartItemResp
+	if retval, err2 = p.handler.AddCartItem(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing AddCartItem: "+err2.Error())
+		oprot.WriteMessageBegin("AddCartItem", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("AddCartItem", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type orderServiceProcessorUpdateCartItem struct {
+	handler OrderService
+}
+
+func (p *orderServiceProcessorUpdateCartItem) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := OrderServiceUpdateCartItemArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("UpdateCartItem", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := OrderServiceUpdateCartItemResult{}
+	var retval *UpdateCartItemResp
+	if retval, err2 = p.handler.UpdateCartItem(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UpdateCartItem: "+err2.Error())
+		oprot.WriteMessageBegin("UpdateCartItem", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("UpdateCartItem", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type orderServiceProcessorRemoveCartItem struct {
+	handler OrderService
+}
+
+func (p *orderServiceProcessorRemoveCartItem) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := OrderServiceRemoveCartItemArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("RemoveCartItem", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := OrderServiceRemoveCartItemResult{}
+	var retval *RemoveCartItemResp
+	if retval, err2 = p.handler.RemoveCartItem(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RemoveCartItem: "+err2.Error())
+		oprot.WriteMessageBegin("RemoveCartItem", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("RemoveCartItem", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type orderServiceProcessorGetCart struct {
+	handler OrderService
+}
+
+func (p *orderServiceProcessorGetCart) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := OrderServiceGetCartArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetCart", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := OrderServiceGetCartResult{}
+	var retval *GetCartResp
+	if retval, err2 = p.handler.GetCart(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetCart: "+err2.Error())
+		oprot.WriteMessageBegin("GetCart", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetCart", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type OrderServiceCreateOrderArgs struct {
+	Req *CreateOrderReq `thrift:"req,1" frugal:"1,default,CreateOrderReq" json:"req"`
+}
+
+func NewOrderServiceCreateOrderArgs() *OrderServiceCreateOrderArgs {
+	return &OrderServiceCreateOrderArgs{}
+}
+
+func (p *OrderServiceCreateOrderArgs) InitDefault() {
+	*p = OrderServiceCreateOrderArgs{}
+}
+
+var OrderServiceCreateOrderArgs_Req_DEFAULT *CreateOrderReq
+
+func (p *OrderServiceCreateOrderArgs) GetReq() (v *CreateOrderReq) {
+	if !p.IsSetReq() {
+		return OrderServiceCreateOrderArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *OrderServiceCreateOrderArgs) SetReq(val *CreateOrderReq) {
+	p.Req = val
+}
+
+var fieldIDToName_OrderServiceCreateOrderArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *OrderServiceCreateOrderArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *OrderServiceCreateOrderArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderServiceCreateOrderArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *OrderServiceCreateOrderArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewCreateOrderReq()
+	if err := p.Req.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *OrderServiceCreateOrderArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("CreateOrder_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *OrderServiceCreateOrderArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *OrderServiceCreateOrderArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OrderServiceCreateOrderArgs(%+v)", *p)
+}
+
+func (p *OrderServiceCreateOrderArgs) DeepEqual(ano *OrderServiceCreateOrderArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *OrderServiceCreateOrderArgs) Field1DeepEqual(src *CreateOrderReq) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type OrderServiceCreateOrderResult struct {
+	Success *CreateOrderResp `thrift:"success,0,optional" frugal:"0,optional,CreateOrderResp" json:"success,omitempty"`
+}
+
+func NewOrderServiceCreateOrderResult() *OrderServiceCreateOrderResult {
+	return &OrderServiceCreateOrderResult{}
+}
+
+func (p *OrderServiceCreateOrderResult) InitDefault() {
+	*p = OrderServiceCreateOrderResult{}
+}
+
+var OrderServiceCreateOrderResult_Success_DEFAULT *CreateOrderResp
+
+func (p *OrderServiceCreateOrderResult) GetSuccess() (v *CreateOrderResp) {
+	if !p.IsSetSuccess() {
+		return OrderServiceCreateOrderResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *OrderServiceCreateOrderResult) SetSuccess(x interface{}) {
+	p.Success = x.(*CreateOrderResp)
+}
+
+var fieldIDToName_OrderServiceCreateOrderResult = map[int16]string{
+	0: "success",
+}
+
+func (p *OrderServiceCreateOrderResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *OrderServiceCreateOrderResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderServiceCreateOrderResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *OrderServiceCreateOrderResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewCreateOrderResp()
+	if err := p.Success.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *OrderServiceCreateOrderResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("CreateOrder_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *OrderServiceCreateOrderResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *OrderServiceCreateOrderResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OrderServiceCreateOrderResult(%+v)", *p)
+}
+
+func (p *OrderServiceCreateOrderResult) DeepEqual(ano *OrderServiceCreateOrderResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *OrderServiceCreateOrderResult) Field0DeepEqual(src *CreateOrderResp) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type OrderServiceCancelOrderArgs struct {
+	Req *CancelOrderReq `thrift:"req,1" frugal:"1,default,CancelOrderReq" json:"req"`
+}
+
+func NewOrderServiceCancelOrderArgs() *OrderServiceCancelOrderArgs {
+	return &OrderServiceCancelOrderArgs{}
+}
+
+func (p *OrderServiceCancelOrderArgs) InitDefault() {
+	*p = OrderServiceCancelOrderArgs{}
+}
+
+var OrderServiceCancelOrderArgs_Req_DEFAULT *CancelOrderReq
+
+func (p *OrderServiceCancelOrderArgs) GetReq() (v *CancelOrderReq) {
+	if !p.IsSetReq() {
+		return OrderServiceCancelOrderArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *OrderServiceCancelOrderArgs) SetReq(val *CancelOrderReq) {
+	p.Req = val
+}
+
+var fieldIDToName_OrderServiceCancelOrderArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *OrderServiceCancelOrderArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *OrderServiceCancelOrderArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderServiceCancelOrderArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *OrderServiceCancelOrderArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewCancelOrderReq()
+	if err := p.Req.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *OrderServiceCancelOrderArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("CancelOrder_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *OrderServiceCancelOrderArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *OrderServiceCancelOrderArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OrderServiceCancelOrderArgs(%+v)", *p)
+}
+
+func (p *OrderServiceCancelOrderArgs) DeepEqual(ano *OrderServiceCancelOrderArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *OrderServiceCancelOrderArgs) Field1DeepEqual(src *CancelOrderReq) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type OrderServiceCancelOrderResult struct {
+	Success *CancelOrderResp `thrift:"success,0,optional" frugal:"0,optional,CancelOrderResp" json:"success,omitempty"`
+}
+
+func NewOrderServiceCancelOrderResult() *OrderServiceCancelOrderResult {
+	return &OrderServiceCancelOrderResult{}
+}
+
+func (p *OrderServiceCancelOrderResult) InitDefault() {
+	*p = OrderServiceCancelOrderResult{}
+}
+
+var OrderServiceCancelOrderResult_Success_DEFAULT *CancelOrderResp
+
+func (p *OrderServiceCancelOrderResult) GetSuccess() (v *CancelOrderResp) {
+	if !p.IsSetSuccess() {
+		return OrderServiceCancelOrderResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *OrderServiceCancelOrderResult) SetSuccess(x interface{}) {
+	p.Success = x.(*CancelOrderResp)
+}
+
+var fieldIDToName_OrderServiceCancelOrderResult = map[int16]string{
+	0: "success",
+}
+
+func (p *OrderServiceCancelOrderResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *OrderServiceCancelOrderResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderServiceCancelOrderResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *OrderServiceCancelOrderResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewCancelOrderResp()
+	if err := p.Success.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *OrderServiceCancelOrderResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("CancelOrder_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *OrderServiceCancelOrderResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *OrderServiceCancelOrderResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OrderServiceCancelOrderResult(%+v)", *p)
+}
+
+func (p *OrderServiceCancelOrderResult) DeepEqual(ano *OrderServiceCancelOrderResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *OrderServiceCancelOrderResult) Field0DeepEqual(src *CancelOrderResp) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type OrderServicePayOrderArgs struct {
+	Req *PayOrderReq `thrift:"req,1" frugal:"1,default,PayOrderReq" json:"req"`
+}
+
+func NewOrderServicePayOrderArgs() *OrderServicePayOrderArgs {
+	return &OrderServicePayOrderArgs{}
+}
+
+func (p *OrderServicePayOrderArgs) InitDefault() {
+	*p = OrderServicePayOrderArgs{}
+}
+
+var OrderServicePayOrderArgs_Req_DEFAULT *PayOrderReq
+
+func (p *OrderServicePayOrderArgs) GetReq() (v *PayOrderReq) {
+	if !p.IsSetReq() {
+		return OrderServicePayOrderArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *OrderServicePayOrderArgs) SetReq(val *PayOrderReq) {
+	p.Req = val
+}
+
+var fieldIDToName_OrderServicePayOrderArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *OrderServicePayOrderArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *OrderServicePayOrderArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderServicePayOrderArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *OrderServicePayOrderArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewPayOrderReq()
+	if err := p.Req.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *OrderServicePayOrderArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("PayOrder_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *OrderServicePayOrderArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *OrderServicePayOrderArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OrderServicePayOrderArgs(%+v)", *p)
+}
+
+func (p *OrderServicePayOrderArgs) DeepEqual(ano *OrderServicePayOrderArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *OrderServicePayOrderArgs) Field1DeepEqual(src *PayOrderReq) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type OrderServicePayOrderResult struct {
+	Success *PayOrderResp `thrift:"success,0,optional" frugal:"0,optional,PayOrderResp" json:"success,omitempty"`
+}
+
+func NewOrderServicePayOrderResult() *OrderServicePayOrderResult {
+	return &OrderServicePayOrderResult{}
+}
+
+func (p *OrderServicePayOrderResult) InitDefault() {
+	*p = OrderServicePayOrderResult{}
+}
+
+var OrderServicePayOrderResult_Success_DEFAULT *PayOrderResp
+
+func (p *OrderServicePayOrderResult) GetSuccess() (v *PayOrderResp) {
+	if !p.IsSetSuccess() {
+		return OrderServicePayOrderResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *OrderServicePayOrderResult) SetSuccess(x interface{}) {
+	p.Success = x.(*PayOrderResp)
+}
+
+var fieldIDToName_OrderServicePayOrderResult = map[int16]string{
+	0: "success",
+}
+
+func (p *OrderServicePayOrderResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *OrderServicePayOrderResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderServicePayOrderResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *OrderServicePayOrderResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewPayOrderResp()
+	if err := p.Success.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *OrderServicePayOrderResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("PayOrder_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *OrderServicePayOrderResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *OrderServicePayOrderResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OrderServicePayOrderResult(%+v)", *p)
+}
+
+func (p *OrderServicePayOrderResult) DeepEqual(ano *OrderServicePayOrderResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *OrderServicePayOrderResult) Field0DeepEqual(src *PayOrderResp) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type OrderServiceShipOrderArgs struct {
+	Req *ShipOrderReq `thrift:"req,1" frugal:"1,default,ShipOrderReq" json:"req"`
+}
+
+func NewOrderServiceShipOrderArgs() *OrderServiceShipOrderArgs {
+	return &OrderServiceShipOrderArgs{}
+}
+
+func (p *OrderServiceShipOrderArgs) InitDefault() {
+	*p = OrderServiceShipOrderArgs{}
+}
+
+var OrderServiceShipOrderArgs_Req_DEFAULT *ShipOrderReq
+
+func (p *OrderServiceShipOrderArgs) GetReq() (v *ShipOrderReq) {
+	if !p.IsSetReq() {
+		return OrderServiceShipOrderArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *OrderServiceShipOrderArgs) SetReq(val *ShipOrderReq) {
+	p.Req = val
+}
+
+var fieldIDToName_OrderServiceShipOrderArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *OrderServiceShipOrderArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *OrderServiceShipOrderArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderServiceShipOrderArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *OrderServiceShipOrderArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewShipOrderReq()
+	if err := p.Req.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *OrderServiceShipOrderArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ShipOrder_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *OrderServiceShipOrderArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *OrderServiceShipOrderArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OrderServiceShipOrderArgs(%+v)", *p)
+}
+
+func (p *OrderServiceShipOrderArgs) DeepEqual(ano *OrderServiceShipOrderArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *OrderServiceShipOrderArgs) Field1DeepEqual(src *ShipOrderReq) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type OrderServiceShipOrderResult struct {
+	Success *ShipOrderResp `thrift:"success,0,optional" frugal:"0,optional,ShipOrderResp" json:"success,omitempty"`
+}
+
+func NewOrderServiceShipOrderResult() *OrderServiceShipOrderResult {
+	return &OrderServiceShipOrderResult{}
+}
+
+func (p *OrderServiceShipOrderResult) InitDefault() {
+	*p = OrderServiceShipOrderResult{}
+}
+
+var OrderServiceShipOrderResult_Success_DEFAULT *ShipOrderResp
+
+func (p *OrderServiceShipOrderResult) GetSuccess() (v *ShipOrderResp) {
+	if !p.IsSetSuccess() {
+		return OrderServiceShipOrderResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *OrderServiceShipOrderResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ShipOrderResp)
+}
+
+var fieldIDToName_OrderServiceShipOrderResult = map[int16]string{
+	0: "success",
+}
+
+func (p *OrderServiceShipOrderResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *OrderServiceShipOrderResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderServiceShipOrderResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *OrderServiceShipOrderResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewShipOrderResp()
+	if err := p.Success.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *OrderServiceShipOrderResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ShipOrder_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *OrderServiceShipOrderResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *OrderServiceShipOrderResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OrderServiceShipOrderResult(%+v)", *p)
+}
+
+func (p *OrderServiceShipOrderResult) DeepEqual(ano *OrderServiceShipOrderResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *OrderServiceShipOrderResult) Field0DeepEqual(src *ShipOrderResp) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type OrderServiceDeliverOrderArgs struct {
+	Req *DeliverOrderReq `thrift:"req,1" frugal:"1,default,DeliverOrderReq" json:"req"`
+}
+
+func NewOrderServiceDeliverOrderArgs() *OrderServiceDeliverOrderArgs {
+	return &OrderServiceDeliverOrderArgs{}
+}
+
+func (p *OrderServiceDeliverOrderArgs) InitDefault() {
+	*p = OrderServiceDeliverOrderArgs{}
+}
+
+var OrderServiceDeliverOrderArgs_Req_DEFAULT *DeliverOrderReq
+
+func (p *OrderServiceDeliverOrderArgs) GetReq() (v *DeliverOrderReq) {
+	if !p.IsSetReq() {
+		return OrderServiceDeliverOrderArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *OrderServiceDeliverOrderArgs) SetReq(val *DeliverOrderReq) {
+	p.Req = val
+}
+
+var fieldIDToName_OrderServiceDeliverOrderArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *OrderServiceDeliverOrderArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *OrderServiceDeliverOrderArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderServiceDeliverOrderArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *OrderServiceDeliverOrderArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewDeliverOrderReq()
+	if err := p.Req.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *OrderServiceDeliverOrderArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("DeliverOrder_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *OrderServiceDeliverOrderArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *OrderServiceDeliverOrderArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OrderServiceDeliverOrderArgs(%+v)", *p)
+}
+
+func (p *OrderServiceDeliverOrderArgs) DeepEqual(ano *OrderServiceDeliverOrderArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *OrderServiceDeliverOrderArgs) Field1DeepEqual(src *DeliverOrderReq) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type OrderServiceDeliverOrderResult struct {
+	Success *DeliverOrderResp `thrift:"success,0,optional" frugal:"0,optional,DeliverOrderResp" json:"success,omitempty"`
+}
+
+func NewOrderServiceDeliverOrderResult() *OrderServiceDeliverOrderResult {
+	return &OrderServiceDeliverOrderResult{}
+}
+
+func (p *OrderServiceDeliverOrderResult) InitDefault() {
+	*p = OrderServiceDeliverOrderResult{}
+}
+
+var OrderServiceDeliverOrderResult_Success_DEFAULT *DeliverOrderResp
+
+func (p *OrderServiceDeliverOrderResult) GetSuccess() (v *DeliverOrderResp) {
+	if !p.IsSetSuccess() {
+		return OrderServiceDeliverOrderResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *OrderServiceDeliverOrderResult) SetSuccess(x interface{}) {
+	p.Success = x.(*DeliverOrderResp)
+}
+
+var fieldIDToName_OrderServiceDeliverOrderResult = map[int16]string{
+	0: "success",
+}
+
+func (p *OrderServiceDeliverOrderResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *OrderServiceDeliverOrderResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderServiceDeliverOrderResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *OrderServiceDeliverOrderResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewDeliverOrderResp()
+	if err := p.Success.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *OrderServiceDeliverOrderResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("DeliverOrder_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *OrderServiceDeliverOrderResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *OrderServiceDeliverOrderResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OrderServiceDeliverOrderResult(%+v)", *p)
+}
+
+func (p *OrderServiceDeliverOrderResult) DeepEqual(ano *OrderServiceDeliverOrderResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *OrderServiceDeliverOrderResult) Field0DeepEqual(src *DeliverOrderResp) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type OrderServiceConfirmReceiptArgs struct {
+	Req *ConfirmReceiptReq `thrift:"req,1" frugal:"1,default,ConfirmReceiptReq" json:"req"`
+}
+
+func NewOrderServiceConfirmReceiptArgs() *OrderServiceConfirmReceiptArgs {
+	return &OrderServiceConfirmReceiptArgs{}
+}
+
+func (p *OrderServiceConfirmReceiptArgs) InitDefault() {
+	*p = OrderServiceConfirmReceiptArgs{}
+}
+
+var OrderServiceConfirmReceiptArgs_Req_DEFAULT *ConfirmReceiptReq
+
+func (p *OrderServiceConfirmReceiptArgs) GetReq() (v *ConfirmReceiptReq) {
+	if !p.IsSetReq() {
+		return OrderServiceConfirmReceiptArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *OrderServiceConfirmReceiptArgs) SetReq(val *ConfirmReceiptReq) {
+	p.Req = val
+}
+
+var fieldIDToName_OrderServiceConfirmReceiptArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *OrderServiceConfirmReceiptArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *OrderServiceConfirmReceiptArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderServiceConfirmReceiptArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *OrderServiceConfirmReceiptArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewConfirmReceiptReq()
+	if err := p.Req.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *OrderServiceConfirmReceiptArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ConfirmReceipt_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *OrderServiceConfirmReceiptArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *OrderServiceConfirmReceiptArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OrderServiceConfirmReceiptArgs(%+v)", *p)
+}
+
+func (p *OrderServiceConfirmReceiptArgs) DeepEqual(ano *OrderServiceConfirmReceiptArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *OrderServiceConfirmReceiptArgs) Field1DeepEqual(src *ConfirmReceiptReq) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type OrderServiceConfirmReceiptResult struct {
+	Success *ConfirmReceiptResp `thrift:"success,0,optional" frugal:"0,optional,ConfirmReceiptResp" json:"success,omitempty"`
+}
+
+func NewOrderServiceConfirmReceiptResult() *OrderServiceConfirmReceiptResult {
+	return &OrderServiceConfirmReceiptResult{}
+}
+
+func (p *OrderServiceConfirmReceiptResult) InitDefault() {
+	*p = OrderServiceConfirmReceiptResult{}
+}
+
+var OrderServiceConfirmReceiptResult_Success_DEFAULT *ConfirmReceiptResp
+
+func (p *OrderServiceConfirmReceiptResult) GetSuccess() (v *ConfirmReceiptResp) {
+	if !p.IsSetSuccess() {
+		return OrderServiceConfirmReceiptResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *OrderServiceConfirmReceiptResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ConfirmReceiptResp)
+}
+
+var fieldIDToName_OrderServiceConfirmReceiptResult = map[int16]string{
+	0: "success",
+}
+
+func (p *OrderServiceConfirmReceiptResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *OrderServiceConfirmReceiptResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderServiceConfirmReceiptResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *OrderServiceConfirmReceiptResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewConfirmReceiptResp()
+	if err := p.Success.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *OrderServiceConfirmReceiptResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ConfirmReceipt_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *OrderServiceConfirmReceiptResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *OrderServiceConfirmReceiptResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OrderServiceConfirmReceiptResult(%+v)", *p)
+}
+
+func (p *OrderServiceConfirmReceiptResult) DeepEqual(ano *OrderServiceConfirmReceiptResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *OrderServiceConfirmReceiptResult) Field0DeepEqual(src *ConfirmReceiptResp) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type OrderServiceRefundOrderArgs struct {
+	Req *RefundOrderReq `thrift:"req,1" frugal:"1,default,RefundOrderReq" json:"req"`
+}
+
+func NewOrderServiceRefundOrderArgs() *OrderServiceRefundOrderArgs {
+	return &OrderServiceRefundOrderArgs{}
+}
+
+func (p *OrderServiceRefundOrderArgs) InitDefault() {
+	*p = OrderServiceRefundOrderArgs{}
+}
+
+var OrderServiceRefundOrderArgs_Req_DEFAULT *RefundOrderReq
+
+func (p *OrderServiceRefundOrderArgs) GetReq() (v *RefundOrderReq) {
+	if !p.IsSetReq() {
+		return OrderServiceRefundOrderArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *OrderServiceRefundOrderArgs) SetReq(val *RefundOrderReq) {
+	p.Req = val
+}
+
+var fieldIDToName_OrderServiceRefundOrderArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *OrderServiceRefundOrderArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *OrderServiceRefundOrderArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderServiceRefundOrderArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *OrderServiceRefundOrderArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewRefundOrderReq()
+	if err := p.Req.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *OrderServiceRefundOrderArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("RefundOrder_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *OrderServiceRefundOrderArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *OrderServiceRefundOrderArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OrderServiceRefundOrderArgs(%+v)", *p)
+}
+
+func (p *OrderServiceRefundOrderArgs) DeepEqual(ano *OrderServiceRefundOrderArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *OrderServiceRefundOrderArgs) Field1DeepEqual(src *RefundOrderReq) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type OrderServiceRefundOrderResult struct {
+	Success *RefundOrderResp `thrift:"success,0,optional" frugal:"0,optional,RefundOrderResp" json:"success,omitempty"`
+}
+
+func NewOrderServiceRefundOrderResult() *OrderServiceRefundOrderResult {
+	return &OrderServiceRefundOrderResult{}
+}
+
+func (p *OrderServiceRefundOrderResult) InitDefault() {
+	*p = OrderServiceRefundOrderResult{}
+}
+
+var OrderServiceRefundOrderResult_Success_DEFAULT *RefundOrderResp
+
+func (p *OrderServiceRefundOrderResult) GetSuccess() (v *RefundOrderResp) {
+	if !p.IsSetSuccess() {
+		return OrderServiceRefundOrderResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *OrderServiceRefundOrderResult) SetSuccess(x interface{}) {
+	p.Success = x.(*RefundOrderResp)
+}
+
+var fieldIDToName_OrderServiceRefundOrderResult = map[int16]string{
+	0: "success",
+}
+
+func (p *OrderServiceRefundOrderResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *OrderServiceRefundOrderResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderServiceRefundOrderResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *OrderServiceRefundOrderResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewRefundOrderResp()
+	if err := p.Success.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *OrderServiceRefundOrderResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("RefundOrder_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *OrderServiceRefundOrderResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *OrderServiceRefundOrderResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OrderServiceRefundOrderResult(%+v)", *p)
+}
+
+func (p *OrderServiceRefundOrderResult) DeepEqual(ano *OrderServiceRefundOrderResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *OrderServiceRefundOrderResult) Field0DeepEqual(src *RefundOrderResp) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type OrderServiceShopRefundOrderArgs struct {
+	Req *ShopRefundOrderReq `thrift:"req,1" frugal:"1,default,ShopRefundOrderReq" json:"req"`
+}
+
+func NewOrderServiceShopRefundOrderArgs() *OrderServiceShopRefundOrderArgs {
+	return &OrderServiceShopRefundOrderArgs{}
+}
+
+func (p *OrderServiceShopRefundOrderArgs) InitDefault() {
+	*p = OrderServiceShopRefundOrderArgs{}
+}
+
+var OrderServiceShopRefundOrderArgs_Req_DEFAULT *ShopRefundOrderReq
+
+func (p *OrderServiceShopRefundOrderArgs) GetReq() (v *ShopRefundOrderReq) {
+	if !p.IsSetReq() {
+		return OrderServiceShopRefundOrderArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *OrderServiceShopRefundOrderArgs) SetReq(val *ShopRefundOrderReq) {
+	p.Req = val
+}
+
+var fieldIDToName_OrderServiceShopRefundOrderArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *OrderServiceShopRefundOrderArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *OrderServiceShopRefundOrderArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderServiceShopRefundOrderArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *OrderServiceShopRefundOrderArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewShopRefundOrderReq()
+	if err := p.Req.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *OrderServiceShopRefundOrderArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ShopRefundOrder_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *OrderServiceShopRefundOrderArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *OrderServiceShopRefundOrderArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OrderServiceShopRefundOrderArgs(%+v)", *p)
+}
+
+func (p *OrderServiceShopRefundOrderArgs) DeepEqual(ano *OrderServiceShopRefundOrderArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *OrderServiceShopRefundOrderArgs) Field1DeepEqual(src *ShopRefundOrderReq) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type OrderServiceShopRefundOrderResult struct {
+	Success *ShopRefundOrderResp `thrift:"success,0,optional" frugal:"0,optional,ShopRefundOrderResp" json:"success,omitempty"`
+}
+
+func NewOrderServiceShopRefundOrderResult() *OrderServiceShopRefundOrderResult {
+	return &OrderServiceShopRefundOrderResult{}
+}
+
+func (p *OrderServiceShopRefundOrderResult) InitDefault() {
+	*p = OrderServiceShopRefundOrderResult{}
+}
+
+var OrderServiceShopRefundOrderResult_Success_DEFAULT *ShopRefundOrderResp
+
+func (p *OrderServiceShopRefundOrderResult) GetSuccess() (v *ShopRefundOrderResp) {
+	if !p.IsSetSuccess() {
+		return OrderServiceShopRefundOrderResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *OrderServiceShopRefundOrderResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ShopRefundOrderResp)
+}
+
+var fieldIDToName_OrderServiceShopRefundOrderResult = map[int16]string{
+	0: "success",
+}
+
+func (p *OrderServiceShopRefundOrderResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *OrderServiceShopRefundOrderResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderServiceShopRefundOrderResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *OrderServiceShopRefundOrderResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewShopRefundOrderResp()
+	if err := p.Success.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *OrderServiceShopRefundOrderResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ShopRefundOrder_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *OrderServiceShopRefundOrderResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *OrderServiceShopRefundOrderResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OrderServiceShopRefundOrderResult(%+v)", *p)
+}
+
+func (p *OrderServiceShopRefundOrderResult) DeepEqual(ano *OrderServiceShopRefundOrderResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *OrderServiceShopRefundOrderResult) Field0DeepEqual(src *ShopRefundOrderResp) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type OrderServiceListOrderArgs struct {
+	Req *ListOrderReq `thrift:"req,1" frugal:"1,default,ListOrderReq" json:"req"`
+}
+
+func NewOrderServiceListOrderArgs() *OrderServiceListOrderArgs {
+	return &OrderServiceListOrderArgs{}
+}
+
+func (p *OrderServiceListOrderArgs) InitDefault() {
+	*p = OrderServiceListOrderArgs{}
+}
+
+var OrderServiceListOrderArgs_Req_DEFAULT *ListOrderReq
+
+func (p *OrderServiceListOrderArgs) GetReq() (v *ListOrderReq) {
+	if !p.IsSetReq() {
+		return OrderServiceListOrderArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *OrderServiceListOrderArgs) SetReq(val *ListOrderReq) {
+	p.Req = val
+}
+
+var fieldIDToName_OrderServiceListOrderArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *OrderServiceListOrderArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *OrderServiceListOrderArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderServiceListOrderArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *OrderServiceListOrderArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewListOrderReq()
+	if err := p.Req.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *OrderServiceListOrderArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ListOrder_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *OrderServiceListOrderArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *OrderServiceListOrderArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OrderServiceListOrderArgs(%+v)", *p)
+}
+
+func (p *OrderServiceListOrderArgs) DeepEqual(ano *OrderServiceListOrderArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *OrderServiceListOrderArgs) Field1DeepEqual(src *ListOrderReq) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type OrderServiceListOrderResult struct {
+	Success *ListOrderResp `thrift:"success,0,optional" frugal:"0,optional,ListOrderResp" json:"success,omitempty"`
+}
+
+func NewOrderServiceListOrderResult() *OrderServiceListOrderResult {
+	return &OrderServiceListOrderResult{}
+}
+
+func (p *OrderServiceListOrderResult) InitDefault() {
+	*p = OrderServiceListOrderResult{}
+}
+
+var OrderServiceListOrderResult_Success_DEFAULT *ListOrderResp
+
+func (p *OrderServiceListOrderResult) GetSuccess() (v *ListOrderResp) {
+	if !p.IsSetSuccess() {
+		return OrderServiceListOrderResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *OrderServiceListOrderResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ListOrderResp)
+}
+
+var fieldIDToName_OrderServiceListOrderResult = map[int16]string{
+	0: "success",
+}
+
+func (p *OrderServiceListOrderResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *OrderServiceListOrderResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderServiceListOrderResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *OrderServiceListOrderResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewListOrderResp()
+	if err := p.Success.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *OrderServiceListOrderResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ListOrder_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *OrderServiceListOrderResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *OrderServiceListOrderResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OrderServiceListOrderResult(%+v)", *p)
+}
+
+func (p *OrderServiceListOrderResult) DeepEqual(ano *OrderServiceListOrderResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *OrderServiceListOrderResult) Field0DeepEqual(src *ListOrderResp) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type OrderServiceGetOrderByIdArgs struct {
+	Req *GetOrderByIdReq `thrift:"req,1" frugal:"1,default,GetOrderByIdReq" json:"req"`
+}
+
+func NewOrderServiceGetOrderByIdArgs() *OrderServiceGetOrderByIdArgs {
+	return &OrderServiceGetOrderByIdArgs{}
+}
+
+func (p *OrderServiceGetOrderByIdArgs) InitDefault() {
+	*p = OrderServiceGetOrderByIdArgs{}
+}
+
+var OrderServiceGetOrderByIdArgs_Req_DEFAULT *GetOrderByIdReq
+
+func (p *OrderServiceGetOrderByIdArgs) GetReq() (v *GetOrderByIdReq) {
+	if !p.IsSetReq() {
+		return OrderServiceGetOrderByIdArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *OrderServiceGetOrderByIdArgs) SetReq(val *GetOrderByIdReq) {
+	p.Req = val
+}
+
+var fieldIDToName_OrderServiceGetOrderByIdArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *OrderServiceGetOrderByIdArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *OrderServiceGetOrderByIdArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderServiceGetOrderByIdArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *OrderServiceGetOrderByIdArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewGetOrderByIdReq()
+	if err := p.Req.Read(iprot); err != nil {
+		return err
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	return nil
+}
+
+func (p *OrderServiceGetOrderByIdArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetOrderById_args"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err != nil {
-		return
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	return true, err
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-type orderServiceProcessorCancelOrder struct {
-	handler OrderService
+func (p *OrderServiceGetOrderByIdArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *orderServiceProcessorCancelOrder) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := OrderServiceCancelOrderArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("CancelOrder", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *OrderServiceGetOrderByIdArgs) String() string {
+	if p == nil {
+		return "<nil>"
 	}
+	return fmt.Sprintf("OrderServiceGetOrderByIdArgs(%+v)", *p)
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := OrderServiceCancelOrderResult{}
-	var retval *CancelOrderResp
-	if retval, err2 = p.handler.CancelOrder(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing CancelOrder: "+err2.Error())
-		oprot.WriteMessageBegin("CancelOrder", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("CancelOrder", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+func (p *OrderServiceGetOrderByIdArgs) DeepEqual(ano *OrderServiceGetOrderByIdArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	return true
+}
+
+func (p *OrderServiceGetOrderByIdArgs) Field1DeepEqual(src *GetOrderByIdReq) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
 	}
-	if err != nil {
-		return
+	return true
+}
+
+type OrderServiceGetOrderByIdResult struct {
+	Success *GetOrderByIdResp `thrift:"success,0,optional" frugal:"0,optional,GetOrderByIdResp" json:"success,omitempty"`
+}
+
+func NewOrderServiceGetOrderByIdResult() *OrderServiceGetOrderByIdResult {
+	return &OrderServiceGetOrderByIdResult{}
+}
+
+func (p *OrderServiceGetOrderByIdResult) InitDefault() {
+	*p = OrderServiceGetOrderByIdResult{}
+}
+
+var OrderServiceGetOrderByIdResult_Success_DEFAULT *GetOrderByIdResp
+
+func (p *OrderServiceGetOrderByIdResult) GetSuccess() (v *GetOrderByIdResp) {
+	if !p.IsSetSuccess() {
+		return OrderServiceGetOrderByIdResult_Success_DEFAULT
 	}
-	return true, err
+	return p.Success
+}
+func (p *OrderServiceGetOrderByIdResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetOrderByIdResp)
 }
 
-type orderServiceProcessorListOrder struct {
-	handler OrderService
+var fieldIDToName_OrderServiceGetOrderByIdResult = map[int16]string{
+	0: "success",
 }
 
-func (p *orderServiceProcessorListOrder) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := OrderServiceListOrderArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("ListOrder", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *OrderServiceGetOrderByIdResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *OrderServiceGetOrderByIdResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := OrderServiceListOrderResult{}
-	var retval *ListOrderResp
-	if retval, err2 = p.handler.ListOrder(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ListOrder: "+err2.Error())
-		oprot.WriteMessageBegin("ListOrder", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if err2 = oprot.WriteMessageBegin("ListOrder", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderServiceGetOrderByIdResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *OrderServiceGetOrderByIdResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewGetOrderByIdResp()
+	if err := p.Success.Read(iprot); err != nil {
+		return err
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	return nil
+}
+
+func (p *OrderServiceGetOrderByIdResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetOrderById_result"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+
 	}
-	if err != nil {
-		return
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	return true, err
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-type orderServiceProcessorGetOrderById struct {
-	handler OrderService
+func (p *OrderServiceGetOrderByIdResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *orderServiceProcessorGetOrderById) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := OrderServiceGetOrderByIdArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("GetOrderById", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *OrderServiceGetOrderByIdResult) String() string {
+	if p == nil {
+		return "<nil>"
 	}
+	return fmt.Sprintf("OrderServiceGetOrderByIdResult(%+v)", *p)
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := OrderServiceGetOrderByIdResult{}
-	var retval *GetOrderByIdResp
-	if retval, err2 = p.handler.GetOrderById(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetOrderById: "+err2.Error())
-		oprot.WriteMessageBegin("GetOrderById", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("GetOrderById", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+func (p *OrderServiceGetOrderByIdResult) DeepEqual(ano *OrderServiceGetOrderByIdResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
 	}
-	if err != nil {
-		return
+	return true
+}
+
+func (p *OrderServiceGetOrderByIdResult) Field0DeepEqual(src *GetOrderByIdResp) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
 	}
-	return true, err
+	return true
 }
 
-type OrderServiceCreateOrderArgs struct {
-	Req *CreateOrderReq `thrift:"req,1" json:"req"`
+type OrderServiceAddCartItemArgs struct {
+	Req *AddCartItemReq `thrift:"req,1" frugal:"1,default,AddCartItemReq" json:"req"`
 }
 
-func NewOrderServiceCreateOrderArgs() *OrderServiceCreateOrderArgs {
-	return &OrderServiceCreateOrderArgs{}
+func NewOrderServiceAddCartItemArgs() *OrderServiceAddCartItemArgs {
+	return &OrderServiceAddCartItemArgs{}
 }
 
-var OrderServiceCreateOrderArgs_Req_DEFAULT *CreateOrderReq
+func (p *OrderServiceAddCartItemArgs) InitDefault() {
+	*p = OrderServiceAddCartItemArgs{}
+}
 
-func (p *OrderServiceCreateOrderArgs) GetReq() (v *CreateOrderReq) {
+var OrderServiceAddCartItemArgs_Req_DEFAULT *AddCartItemReq
+
+func (p *OrderServiceAddCartItemArgs) GetReq() (v *AddCartItemReq) {
 	if !p.IsSetReq() {
-		return OrderServiceCreateOrderArgs_Req_DEFAULT
+		return OrderServiceAddCartItemArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *OrderServiceCreateOrderArgs) SetReq(val *CreateOrderReq) {
+func (p *OrderServiceAddCartItemArgs) SetReq(val *AddCartItemReq) {
 	p.Req = val
 }
 
-var fieldIDToName_OrderServiceCreateOrderArgs = map[int16]string{
+var fieldIDToName_OrderServiceAddCartItemArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *OrderServiceCreateOrderArgs) IsSetReq() bool {
+func (p *OrderServiceAddCartItemArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *OrderServiceCreateOrderArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *OrderServiceAddCartItemArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -2909,7 +13666,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderServiceCreateOrderArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderServiceAddCartItemArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -2919,17 +13676,17 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *OrderServiceCreateOrderArgs) ReadField1(iprot thrift.TProtocol) error {
-	p.Req = NewCreateOrderReq()
+func (p *OrderServiceAddCartItemArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewAddCartItemReq()
 	if err := p.Req.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *OrderServiceCreateOrderArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *OrderServiceAddCartItemArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("CreateOrder_args"); err != nil {
+	if err = oprot.WriteStructBegin("AddCartItem_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -2956,7 +13713,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *OrderServiceCreateOrderArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *OrderServiceAddCartItemArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -2973,14 +13730,14 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *OrderServiceCreateOrderArgs) String() string {
+func (p *OrderServiceAddCartItemArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("OrderServiceCreateOrderArgs(%+v)", *p)
+	return fmt.Sprintf("OrderServiceAddCartItemArgs(%+v)", *p)
 }
 
-func (p *OrderServiceCreateOrderArgs) DeepEqual(ano *OrderServiceCreateOrderArgs) bool {
+func (p *OrderServiceAddCartItemArgs) DeepEqual(ano *OrderServiceAddCartItemArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -2992,7 +13749,7 @@ func (p *OrderServiceCreateOrderArgs) DeepEqual(ano *OrderServiceCreateOrderArgs
 	return true
 }
 
-func (p *OrderServiceCreateOrderArgs) Field1DeepEqual(src *CreateOrderReq) bool {
+func (p *OrderServiceAddCartItemArgs) Field1DeepEqual(src *AddCartItemReq) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -3000,35 +13757,39 @@ func (p *OrderServiceCreateOrderArgs) Field1DeepEqual(src *CreateOrderReq) bool
 	return true
 }
 
-type OrderServiceCreateOrderResult struct {
-	Success *CreateOrderResp `thrift:"success,0" json:"success,omitempty"`
+type OrderServiceAddCartItemResult struct {
+	Success *AddCartItemResp `thrift:"success,0,optional" frugal:"0,optional,AddCartItemResp" json:"success,omitempty"`
 }
 
-func NewOrderServiceCreateOrderResult() *OrderServiceCreateOrderResult {
-	return &OrderServiceCreateOrderResult{}
+func NewOrderServiceAddCartItemResult() *OrderServiceAddCartItemResult {
+	return &OrderServiceAddCartItemResult{}
 }
 
-var OrderServiceCreateOrderResult_Success_DEFAULT *CreateOrderResp
+func (p *OrderServiceAddCartItemResult) InitDefault() {
+	*p = OrderServiceAddCartItemResult{}
+}
 
-func (p *OrderServiceCreateOrderResult) GetSuccess() (v *CreateOrderResp) {
+var OrderServiceAddCartItemResult_Success_DEFAULT *AddCartItemResp
+
+func (p *OrderServiceAddCartItemResult) GetSuccess() (v *AddCartItemResp) {
 	if !p.IsSetSuccess() {
-		return OrderServiceCreateOrderResult_Success_DEFAULT
+		return OrderServiceAddCartItemResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *OrderServiceCreateOrderResult) SetSuccess(x interface{}) {
-	p.Success = x.(*CreateOrderResp)
+func (p *OrderServiceAddCartItemResult) SetSuccess(x interface{}) {
+	p.Success = x.(*AddCartItemResp)
 }
 
-var fieldIDToName_OrderServiceCreateOrderResult = map[int16]string{
+var fieldIDToName_OrderServiceAddCartItemResult = map[int16]string{
 	0: "success",
 }
 
-func (p *OrderServiceCreateOrderResult) IsSetSuccess() bool {
+func (p *OrderServiceAddCartItemResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *OrderServiceCreateOrderResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *OrderServiceAddCartItemResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -3077,7 +13838,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderServiceCreateOrderResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderServiceAddCartItemResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3087,17 +13848,17 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *OrderServiceCreateOrderResult) ReadField0(iprot thrift.TProtocol) error {
-	p.Success = NewCreateOrderResp()
+func (p *OrderServiceAddCartItemResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewAddCartItemResp()
 	if err := p.Success.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *OrderServiceCreateOrderResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *OrderServiceAddCartItemResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("CreateOrder_result"); err != nil {
+	if err = oprot.WriteStructBegin("AddCartItem_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3124,7 +13885,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *OrderServiceCreateOrderResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *OrderServiceAddCartItemResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -3143,14 +13904,14 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *OrderServiceCreateOrderResult) String() string {
+func (p *OrderServiceAddCartItemResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("OrderServiceCreateOrderResult(%+v)", *p)
+	return fmt.Sprintf("OrderServiceAddCartItemResult(%+v)", *p)
 }
 
-func (p *OrderServiceCreateOrderResult) DeepEqual(ano *OrderServiceCreateOrderResult) bool {
+func (p *OrderServiceAddCartItemResult) DeepEqual(ano *OrderServiceAddCartItemResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -3162,7 +13923,7 @@ func (p *OrderServiceCreateOrderResult) DeepEqual(ano *OrderServiceCreateOrderRe
 	return true
 }
 
-func (p *OrderServiceCreateOrderResult) Field0DeepEqual(src *CreateOrderResp) bool {
+func (p *OrderServiceAddCartItemResult) Field0DeepEqual(src *AddCartItemResp) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -3170,35 +13931,39 @@ func (p *OrderServiceCreateOrderResult) Field0DeepEqual(src *CreateOrderResp) bo
 	return true
 }
 
-type OrderServiceCancelOrderArgs struct {
-	Req *CancelOrderReq `thrift:"req,1" json:"req"`
+type OrderServiceUpdateCartItemArgs struct {
+	Req *UpdateCartItemReq `thrift:"req,1" frugal:"1,default,UpdateCartItemReq" json:"req"`
 }
 
-func NewOrderServiceCancelOrderArgs() *OrderServiceCancelOrderArgs {
-	return &OrderServiceCancelOrderArgs{}
+func NewOrderServiceUpdateCartItemArgs() *OrderServiceUpdateCartItemArgs {
+	return &OrderServiceUpdateCartItemArgs{}
 }
 
-var OrderServiceCancelOrderArgs_Req_DEFAULT *CancelOrderReq
+func (p *OrderServiceUpdateCartItemArgs) InitDefault() {
+	*p = OrderServiceUpdateCartItemArgs{}
+}
 
-func (p *OrderServiceCancelOrderArgs) GetReq() (v *CancelOrderReq) {
+var OrderServiceUpdateCartItemArgs_Req_DEFAULT *UpdateCartItemReq
+
+func (p *OrderServiceUpdateCartItemArgs) GetReq() (v *UpdateCartItemReq) {
 	if !p.IsSetReq() {
-		return OrderServiceCancelOrderArgs_Req_DEFAULT
+		return OrderServiceUpdateCartItemArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *OrderServiceCancelOrderArgs) SetReq(val *CancelOrderReq) {
+func (p *OrderServiceUpdateCartItemArgs) SetReq(val *UpdateCartItemReq) {
 	p.Req = val
 }
 
-var fieldIDToName_OrderServiceCancelOrderArgs = map[int16]string{
+var fieldIDToName_OrderServiceUpdateCartItemArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *OrderServiceCancelOrderArgs) IsSetReq() bool {
+func (p *OrderServiceUpdateCartItemArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *OrderServiceCancelOrderArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *OrderServiceUpdateCartItemArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -3247,7 +14012,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderServiceCancelOrderArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderServiceUpdateCartItemArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3257,17 +14022,17 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *OrderServiceCancelOrderArgs) ReadField1(iprot thrift.TProtocol) error {
-	p.Req = NewCancelOrderReq()
+func (p *OrderServiceUpdateCartItemArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewUpdateCartItemReq()
 	if err := p.Req.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *OrderServiceCancelOrderArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *OrderServiceUpdateCartItemArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("CancelOrder_args"); err != nil {
+	if err = oprot.WriteStructBegin("UpdateCartItem_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3294,7 +14059,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *OrderServiceCancelOrderArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *OrderServiceUpdateCartItemArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -3311,14 +14076,14 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *OrderServiceCancelOrderArgs) String() string {
+func (p *OrderServiceUpdateCartItemArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("OrderServiceCancelOrderArgs(%+v)", *p)
+	return fmt.Sprintf("OrderServiceUpdateCartItemArgs(%+v)", *p)
 }
 
-func (p *OrderServiceCancelOrderArgs) DeepEqual(ano *OrderServiceCancelOrderArgs) bool {
+func (p *OrderServiceUpdateCartItemArgs) DeepEqual(ano *OrderServiceUpdateCartItemArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -3330,7 +14095,7 @@ func (p *OrderServiceCancelOrderArgs) DeepEqual(ano *OrderServiceCancelOrderArgs
 	return true
 }
 
-func (p *OrderServiceCancelOrderArgs) Field1DeepEqual(src *CancelOrderReq) bool {
+func (p *OrderServiceUpdateCartItemArgs) Field1DeepEqual(src *UpdateCartItemReq) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -3338,35 +14103,39 @@ func (p *OrderServiceCancelOrderArgs) Field1DeepEqual(src *CancelOrderReq) bool
 	return true
 }
 
-type OrderServiceCancelOrderResult struct {
-	Success *CancelOrderResp `thrift:"success,0" json:"success,omitempty"`
+type OrderServiceUpdateCartItemResult struct {
+	Success *UpdateCartItemResp `thrift:"success,0,optional" frugal:"0,optional,UpdateCartItemResp" json:"success,omitempty"`
 }
 
-func NewOrderServiceCancelOrderResult() *OrderServiceCancelOrderResult {
-	return &OrderServiceCancelOrderResult{}
+func NewOrderServiceUpdateCartItemResult() *OrderServiceUpdateCartItemResult {
+	return &OrderServiceUpdateCartItemResult{}
 }
 
-var OrderServiceCancelOrderResult_Success_DEFAULT *CancelOrderResp
+func (p *OrderServiceUpdateCartItemResult) InitDefault() {
+	*p = OrderServiceUpdateCartItemResult{}
+}
 
-func (p *OrderServiceCancelOrderResult) GetSuccess() (v *CancelOrderResp) {
+var OrderServiceUpdateCartItemResult_Success_DEFAULT *UpdateCartItemResp
+
+func (p *OrderServiceUpdateCartItemResult) GetSuccess() (v *UpdateCartItemResp) {
 	if !p.IsSetSuccess() {
-		return OrderServiceCancelOrderResult_Success_DEFAULT
+		return OrderServiceUpdateCartItemResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *OrderServiceCancelOrderResult) SetSuccess(x interface{}) {
-	p.Success = x.(*CancelOrderResp)
+func (p *OrderServiceUpdateCartItemResult) SetSuccess(x interface{}) {
+	p.Success = x.(*UpdateCartItemResp)
 }
 
-var fieldIDToName_OrderServiceCancelOrderResult = map[int16]string{
+var fieldIDToName_OrderServiceUpdateCartItemResult = map[int16]string{
 	0: "success",
 }
 
-func (p *OrderServiceCancelOrderResult) IsSetSuccess() bool {
+func (p *OrderServiceUpdateCartItemResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *OrderServiceCancelOrderResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *OrderServiceUpdateCartItemResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -3415,7 +14184,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderServiceCancelOrderResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderServiceUpdateCartItemResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3425,17 +14194,17 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *OrderServiceCancelOrderResult) ReadField0(iprot thrift.TProtocol) error {
-	p.Success = NewCancelOrderResp()
+func (p *OrderServiceUpdateCartItemResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewUpdateCartItemResp()
 	if err := p.Success.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *OrderServiceCancelOrderResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *OrderServiceUpdateCartItemResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("CancelOrder_result"); err != nil {
+	if err = oprot.WriteStructBegin("UpdateCartItem_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3462,7 +14231,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *OrderServiceCancelOrderResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *OrderServiceUpdateCartItemResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -3481,14 +14250,14 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *OrderServiceCancelOrderResult) String() string {
+func (p *OrderServiceUpdateCartItemResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("OrderServiceCancelOrderResult(%+v)", *p)
+	return fmt.Sprintf("OrderServiceUpdateCartItemResult(%+v)", *p)
 }
 
-func (p *OrderServiceCancelOrderResult) DeepEqual(ano *OrderServiceCancelOrderResult) bool {
+func (p *OrderServiceUpdateCartItemResult) DeepEqual(ano *OrderServiceUpdateCartItemResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -3500,7 +14269,7 @@ func (p *OrderServiceCancelOrderResult) DeepEqual(ano *OrderServiceCancelOrderRe
 	return true
 }
 
-func (p *OrderServiceCancelOrderResult) Field0DeepEqual(src *CancelOrderResp) bool {
+func (p *OrderServiceUpdateCartItemResult) Field0DeepEqual(src *UpdateCartItemResp) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -3508,35 +14277,39 @@ func (p *OrderServiceCancelOrderResult) Field0DeepEqual(src *CancelOrderResp) bo
 	return true
 }
 
-type OrderServiceListOrderArgs struct {
-	Req *ListOrderReq `thrift:"req,1" json:"req"`
+type OrderServiceRemoveCartItemArgs struct {
+	Req *RemoveCartItemReq `thrift:"req,1" frugal:"1,default,RemoveCartItemReq" json:"req"`
 }
 
-func NewOrderServiceListOrderArgs() *OrderServiceListOrderArgs {
-	return &OrderServiceListOrderArgs{}
+func NewOrderServiceRemoveCartItemArgs() *OrderServiceRemoveCartItemArgs {
+	return &OrderServiceRemoveCartItemArgs{}
 }
 
-var OrderServiceListOrderArgs_Req_DEFAULT *ListOrderReq
+func (p *OrderServiceRemoveCartItemArgs) InitDefault() {
+	*p = OrderServiceRemoveCartItemArgs{}
+}
 
-func (p *OrderServiceListOrderArgs) GetReq() (v *ListOrderReq) {
+var OrderServiceRemoveCartItemArgs_Req_DEFAULT *RemoveCartItemReq
+
+func (p *OrderServiceRemoveCartItemArgs) GetReq() (v *RemoveCartItemReq) {
 	if !p.IsSetReq() {
-		return OrderServiceListOrderArgs_Req_DEFAULT
+		return OrderServiceRemoveCartItemArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *OrderServiceListOrderArgs) SetReq(val *ListOrderReq) {
+func (p *OrderServiceRemoveCartItemArgs) SetReq(val *RemoveCartItemReq) {
 	p.Req = val
 }
 
-var fieldIDToName_OrderServiceListOrderArgs = map[int16]string{
+var fieldIDToName_OrderServiceRemoveCartItemArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *OrderServiceListOrderArgs) IsSetReq() bool {
+func (p *OrderServiceRemoveCartItemArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *OrderServiceListOrderArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *OrderServiceRemoveCartItemArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -3585,7 +14358,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderServiceListOrderArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderServiceRemoveCartItemArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3595,17 +14368,17 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *OrderServiceListOrderArgs) ReadField1(iprot thrift.TProtocol) error {
-	p.Req = NewListOrderReq()
+func (p *OrderServiceRemoveCartItemArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewRemoveCartItemReq()
 	if err := p.Req.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *OrderServiceListOrderArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *OrderServiceRemoveCartItemArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ListOrder_args"); err != nil {
+	if err = oprot.WriteStructBegin("RemoveCartItem_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3632,7 +14405,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *OrderServiceListOrderArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *OrderServiceRemoveCartItemArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -3649,14 +14422,14 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *OrderServiceListOrderArgs) String() string {
+func (p *OrderServiceRemoveCartItemArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("OrderServiceListOrderArgs(%+v)", *p)
+	return fmt.Sprintf("OrderServiceRemoveCartItemArgs(%+v)", *p)
 }
 
-func (p *OrderServiceListOrderArgs) DeepEqual(ano *OrderServiceListOrderArgs) bool {
+func (p *OrderServiceRemoveCartItemArgs) DeepEqual(ano *OrderServiceRemoveCartItemArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -3668,7 +14441,7 @@ func (p *OrderServiceListOrderArgs) DeepEqual(ano *OrderServiceListOrderArgs) bo
 	return true
 }
 
-func (p *OrderServiceListOrderArgs) Field1DeepEqual(src *ListOrderReq) bool {
+func (p *OrderServiceRemoveCartItemArgs) Field1DeepEqual(src *RemoveCartItemReq) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -3676,35 +14449,39 @@ func (p *OrderServiceListOrderArgs) Field1DeepEqual(src *ListOrderReq) bool {
 	return true
 }
 
-type OrderServiceListOrderResult struct {
-	Success *ListOrderResp `thrift:"success,0" json:"success,omitempty"`
+type OrderServiceRemoveCartItemResult struct {
+	Success *RemoveCartItemResp `thrift:"success,0,optional" frugal:"0,optional,RemoveCartItemResp" json:"success,omitempty"`
 }
 
-func NewOrderServiceListOrderResult() *OrderServiceListOrderResult {
-	return &OrderServiceListOrderResult{}
+func NewOrderServiceRemoveCartItemResult() *OrderServiceRemoveCartItemResult {
+	return &OrderServiceRemoveCartItemResult{}
 }
 
-var OrderServiceListOrderResult_Success_DEFAULT *ListOrderResp
+func (p *OrderServiceRemoveCartItemResult) InitDefault() {
+	*p = OrderServiceRemoveCartItemResult{}
+}
 
-func (p *OrderServiceListOrderResult) GetSuccess() (v *ListOrderResp) {
+var OrderServiceRemoveCartItemResult_Success_DEFAULT *RemoveCartItemResp
+
+func (p *OrderServiceRemoveCartItemResult) GetSuccess() (v *RemoveCartItemResp) {
 	if !p.IsSetSuccess() {
-		return OrderServiceListOrderResult_Success_DEFAULT
+		return OrderServiceRemoveCartItemResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *OrderServiceListOrderResult) SetSuccess(x interface{}) {
-	p.Success = x.(*ListOrderResp)
+func (p *OrderServiceRemoveCartItemResult) SetSuccess(x interface{}) {
+	p.Success = x.(*RemoveCartItemResp)
 }
 
-var fieldIDToName_OrderServiceListOrderResult = map[int16]string{
+var fieldIDToName_OrderServiceRemoveCartItemResult = map[int16]string{
 	0: "success",
 }
 
-func (p *OrderServiceListOrderResult) IsSetSuccess() bool {
+func (p *OrderServiceRemoveCartItemResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *OrderServiceListOrderResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *OrderServiceRemoveCartItemResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -3753,7 +14530,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderServiceListOrderResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderServiceRemoveCartItemResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3763,17 +14540,17 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *OrderServiceListOrderResult) ReadField0(iprot thrift.TProtocol) error {
-	p.Success = NewListOrderResp()
+func (p *OrderServiceRemoveCartItemResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewRemoveCartItemResp()
 	if err := p.Success.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *OrderServiceListOrderResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *OrderServiceRemoveCartItemResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ListOrder_result"); err != nil {
+	if err = oprot.WriteStructBegin("RemoveCartItem_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3800,7 +14577,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *OrderServiceListOrderResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *OrderServiceRemoveCartItemResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -3819,14 +14596,14 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *OrderServiceListOrderResult) String() string {
+func (p *OrderServiceRemoveCartItemResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("OrderServiceListOrderResult(%+v)", *p)
+	return fmt.Sprintf("OrderServiceRemoveCartItemResult(%+v)", *p)
 }
 
-func (p *OrderServiceListOrderResult) DeepEqual(ano *OrderServiceListOrderResult) bool {
+func (p *OrderServiceRemoveCartItemResult) DeepEqual(ano *OrderServiceRemoveCartItemResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -3838,7 +14615,7 @@ func (p *OrderServiceListOrderResult) DeepEqual(ano *OrderServiceListOrderResult
 	return true
 }
 
-func (p *OrderServiceListOrderResult) Field0DeepEqual(src *ListOrderResp) bool {
+func (p *OrderServiceRemoveCartItemResult) Field0DeepEqual(src *RemoveCartItemResp) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -3846,35 +14623,39 @@ func (p *OrderServiceListOrderResult) Field0DeepEqual(src *ListOrderResp) bool {
 	return true
 }
 
-type OrderServiceGetOrderByIdArgs struct {
-	Req *GetOrderByIdReq `thrift:"req,1" json:"req"`
+type OrderServiceGetCartArgs struct {
+	Req *GetCartReq `thrift:"req,1" frugal:"1,default,GetCartReq" json:"req"`
 }
 
-func NewOrderServiceGetOrderByIdArgs() *OrderServiceGetOrderByIdArgs {
-	return &OrderServiceGetOrderByIdArgs{}
+func NewOrderServiceGetCartArgs() *OrderServiceGetCartArgs {
+	return &OrderServiceGetCartArgs{}
 }
 
-var OrderServiceGetOrderByIdArgs_Req_DEFAULT *GetOrderByIdReq
+func (p *OrderServiceGetCartArgs) InitDefault() {
+	*p = OrderServiceGetCartArgs{}
+}
 
-func (p *OrderServiceGetOrderByIdArgs) GetReq() (v *GetOrderByIdReq) {
+var OrderServiceGetCartArgs_Req_DEFAULT *GetCartReq
+
+func (p *OrderServiceGetCartArgs) GetReq() (v *GetCartReq) {
 	if !p.IsSetReq() {
-		return OrderServiceGetOrderByIdArgs_Req_DEFAULT
+		return OrderServiceGetCartArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *OrderServiceGetOrderByIdArgs) SetReq(val *GetOrderByIdReq) {
+func (p *OrderServiceGetCartArgs) SetReq(val *GetCartReq) {
 	p.Req = val
 }
 
-var fieldIDToName_OrderServiceGetOrderByIdArgs = map[int16]string{
+var fieldIDToName_OrderServiceGetCartArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *OrderServiceGetOrderByIdArgs) IsSetReq() bool {
+func (p *OrderServiceGetCartArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *OrderServiceGetOrderByIdArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *OrderServiceGetCartArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -3923,7 +14704,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderServiceGetOrderByIdArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderServiceGetCartArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3933,17 +14714,17 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *OrderServiceGetOrderByIdArgs) ReadField1(iprot thrift.TProtocol) error {
-	p.Req = NewGetOrderByIdReq()
+func (p *OrderServiceGetCartArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewGetCartReq()
 	if err := p.Req.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *OrderServiceGetOrderByIdArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *OrderServiceGetCartArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetOrderById_args"); err != nil {
+	if err = oprot.WriteStructBegin("GetCart_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3970,7 +14751,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *OrderServiceGetOrderByIdArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *OrderServiceGetCartArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -3987,14 +14768,14 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *OrderServiceGetOrderByIdArgs) String() string {
+func (p *OrderServiceGetCartArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("OrderServiceGetOrderByIdArgs(%+v)", *p)
+	return fmt.Sprintf("OrderServiceGetCartArgs(%+v)", *p)
 }
 
-func (p *OrderServiceGetOrderByIdArgs) DeepEqual(ano *OrderServiceGetOrderByIdArgs) bool {
+func (p *OrderServiceGetCartArgs) DeepEqual(ano *OrderServiceGetCartArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -4006,7 +14787,7 @@ func (p *OrderServiceGetOrderByIdArgs) DeepEqual(ano *OrderServiceGetOrderByIdAr
 	return true
 }
 
-func (p *OrderServiceGetOrderByIdArgs) Field1DeepEqual(src *GetOrderByIdReq) bool {
+func (p *OrderServiceGetCartArgs) Field1DeepEqual(src *GetCartReq) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -4014,35 +14795,39 @@ func (p *OrderServiceGetOrderByIdArgs) Field1DeepEqual(src *GetOrderByIdReq) boo
 	return true
 }
 
-type OrderServiceGetOrderByIdResult struct {
-	Success *GetOrderByIdResp `thrift:"success,0" json:"success,omitempty"`
+type OrderServiceGetCartResult struct {
+	Success *GetCartResp `thrift:"success,0,optional" frugal:"0,optional,GetCartResp" json:"success,omitempty"`
 }
 
-func NewOrderServiceGetOrderByIdResult() *OrderServiceGetOrderByIdResult {
-	return &OrderServiceGetOrderByIdResult{}
+func NewOrderServiceGetCartResult() *OrderServiceGetCartResult {
+	return &OrderServiceGetCartResult{}
 }
 
-var OrderServiceGetOrderByIdResult_Success_DEFAULT *GetOrderByIdResp
+func (p *OrderServiceGetCartResult) InitDefault() {
+	*p = OrderServiceGetCartResult{}
+}
 
-func (p *OrderServiceGetOrderByIdResult) GetSuccess() (v *GetOrderByIdResp) {
+var OrderServiceGetCartResult_Success_DEFAULT *GetCartResp
+
+func (p *OrderServiceGetCartResult) GetSuccess() (v *GetCartResp) {
 	if !p.IsSetSuccess() {
-		return OrderServiceGetOrderByIdResult_Success_DEFAULT
+		return OrderServiceGetCartResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *OrderServiceGetOrderByIdResult) SetSuccess(x interface{}) {
-	p.Success = x.(*GetOrderByIdResp)
+func (p *OrderServiceGetCartResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetCartResp)
 }
 
-var fieldIDToName_OrderServiceGetOrderByIdResult = map[int16]string{
+var fieldIDToName_OrderServiceGetCartResult = map[int16]string{
 	0: "success",
 }
 
-func (p *OrderServiceGetOrderByIdResult) IsSetSuccess() bool {
+func (p *OrderServiceGetCartResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *OrderServiceGetOrderByIdResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *OrderServiceGetCartResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -4091,7 +14876,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderServiceGetOrderByIdResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrderServiceGetCartResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4101,17 +14886,17 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *OrderServiceGetOrderByIdResult) ReadField0(iprot thrift.TProtocol) error {
-	p.Success = NewGetOrderByIdResp()
+func (p *OrderServiceGetCartResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewGetCartResp()
 	if err := p.Success.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *OrderServiceGetOrderByIdResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *OrderServiceGetCartResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetOrderById_result"); err != nil {
+	if err = oprot.WriteStructBegin("GetCart_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -4138,7 +14923,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *OrderServiceGetOrderByIdResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *OrderServiceGetCartResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -4157,14 +14942,14 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *OrderServiceGetOrderByIdResult) String() string {
+func (p *OrderServiceGetCartResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("OrderServiceGetOrderByIdResult(%+v)", *p)
+	return fmt.Sprintf("OrderServiceGetCartResult(%+v)", *p)
 }
 
-func (p *OrderServiceGetOrderByIdResult) DeepEqual(ano *OrderServiceGetOrderByIdResult) bool {
+func (p *OrderServiceGetCartResult) DeepEqual(ano *OrderServiceGetCartResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -4176,7 +14961,7 @@ func (p *OrderServiceGetOrderByIdResult) DeepEqual(ano *OrderServiceGetOrderById
 	return true
 }
 
-func (p *OrderServiceGetOrderByIdResult) Field0DeepEqual(src *GetOrderByIdResp) bool {
+func (p *OrderServiceGetCartResult) Field0DeepEqual(src *GetCartResp) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
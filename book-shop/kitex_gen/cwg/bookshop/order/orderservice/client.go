@@ -1,4 +1,4 @@
-// Code generated by Kitex v0.4.3. DO NOT EDIT.
+// Code generated by Kitex v0.6.1. DO NOT EDIT.
 
 package orderservice
 
@@ -13,8 +13,18 @@ import (
 type Client interface {
 	CreateOrder(ctx context.Context, req *order.CreateOrderReq, callOptions ...callopt.Option) (r *order.CreateOrderResp, err error)
 	CancelOrder(ctx context.Context, req *order.CancelOrderReq, callOptions ...callopt.Option) (r *order.CancelOrderResp, err error)
+	PayOrder(ctx context.Context, req *order.PayOrderReq, callOptions ...callopt.Option) (r *order.PayOrderResp, err error)
+	ShipOrder(ctx context.Context, req *order.ShipOrderReq, callOptions ...callopt.Option) (r *order.ShipOrderResp, err error)
+	DeliverOrder(ctx context.Context, req *order.DeliverOrderReq, callOptions ...callopt.Option) (r *order.DeliverOrderResp, err error)
+	ConfirmReceipt(ctx context.Context, req *order.ConfirmReceiptReq, callOptions ...callopt.Option) (r *order.ConfirmReceiptResp, err error)
+	RefundOrder(ctx context.Context, req *order.RefundOrderReq, callOptions ...callopt.Option) (r *order.RefundOrderResp, err error)
+	ShopRefundOrder(ctx context.Context, req *order.ShopRefundOrderReq, callOptions ...callopt.Option) (r *order.ShopRefundOrderResp, err error)
 	ListOrder(ctx context.Context, req *order.ListOrderReq, callOptions ...callopt.Option) (r *order.ListOrderResp, err error)
 	GetOrderById(ctx context.Context, req *order.GetOrderByIdReq, callOptions ...callopt.Option) (r *order.GetOrderByIdResp, err error)
+	AddCartItem(ctx context.Context, req *order.AddCartItemReq, callOptions ...callopt.Option) (r *order.AddCartItemResp, err error)
+	UpdateCartItem(ctx context.Context, req *order.UpdateCartItemReq, callOptions ...callopt.Option) (r *order.UpdateCartItemResp, err error)
+	RemoveCartItem(ctx context.Context, req *order.RemoveCartItemReq, callOptions ...callopt.Option) (r *order.RemoveCartItemResp, err error)
+	GetCart(ctx context.Context, req *order.GetCartReq, callOptions ...callopt.Option) (r *order.GetCartResp, err error)
 }
 
 // NewClient creates a client for the service defined in IDL.
@@ -56,6 +66,36 @@ func (p *kOrderServiceClient) CancelOrder(ctx context.Context, req *order.Cancel
 	return p.kClient.CancelOrder(ctx, req)
 }
 
+func (p *kOrderServiceClient) PayOrder(ctx context.Context, req *order.PayOrderReq, callOptions ...callopt.Option) (r *order.PayOrderResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.PayOrder(ctx, req)
+}
+
+func (p *kOrderServiceClient) ShipOrder(ctx context.Context, req *order.ShipOrderReq, callOptions ...callopt.Option) (r *order.ShipOrderResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ShipOrder(ctx, req)
+}
+
+func (p *kOrderServiceClient) DeliverOrder(ctx context.Context, req *order.DeliverOrderReq, callOptions ...callopt.Option) (r *order.DeliverOrderResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.DeliverOrder(ctx, req)
+}
+
+func (p *kOrderServiceClient) ConfirmReceipt(ctx context.Context, req *order.ConfirmReceiptReq, callOptions ...callopt.Option) (r *order.ConfirmReceiptResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ConfirmReceipt(ctx, req)
+}
+
+func (p *kOrderServiceClient) RefundOrder(ctx context.Context, req *order.RefundOrderReq, callOptions ...callopt.Option) (r *order.RefundOrderResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.RefundOrder(ctx, req)
+}
+
+func (p *kOrderServiceClient) ShopRefundOrder(ctx context.Context, req *order.ShopRefundOrderReq, callOptions ...callopt.Option) (r *order.ShopRefundOrderResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ShopRefundOrder(ctx, req)
+}
+
 func (p *kOrderServiceClient) ListOrder(ctx context.Context, req *order.ListOrderReq, callOptions ...callopt.Option) (r *order.ListOrderResp, err error) {
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.ListOrder(ctx, req)
@@ -65,3 +105,23 @@ func (p *kOrderServiceClient) GetOrderById(ctx context.Context, req *order.GetOr
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.GetOrderById(ctx, req)
 }
+
+func (p *kOrderServiceClient) AddCartItem(ctx context.Context, req *order.AddCartItemReq, callOptions ...callopt.Option) (r *order.AddCartItemResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.AddCartItem(ctx, req)
+}
+
+func (p *kOrderServiceClient) UpdateCartItem(ctx context.Context, req *order.UpdateCartItemReq, callOptions ...callopt.Option) (r *order.UpdateCartItemResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.UpdateCartItem(ctx, req)
+}
+
+func (p *kOrderServiceClient) RemoveCartItem(ctx context.Context, req *order.RemoveCartItemReq, callOptions ...callopt.Option) (r *order.RemoveCartItemResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.RemoveCartItem(ctx, req)
+}
+
+func (p *kOrderServiceClient) GetCart(ctx context.Context, req *order.GetCartReq, callOptions ...callopt.Option) (r *order.GetCartResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetCart(ctx, req)
+}
@@ -1,4 +1,4 @@
-// Code generated by Kitex v0.4.3. DO NOT EDIT.
+// Code generated by Kitex v0.6.1. DO NOT EDIT.
 
 package orderservice
 
@@ -19,10 +19,20 @@ func NewServiceInfo() *kitex.ServiceInfo {
 	serviceName := "OrderService"
 	handlerType := (*order.OrderService)(nil)
 	methods := map[string]kitex.MethodInfo{
-		"CreateOrder":  kitex.NewMethodInfo(createOrderHandler, newOrderServiceCreateOrderArgs, newOrderServiceCreateOrderResult, false),
-		"CancelOrder":  kitex.NewMethodInfo(cancelOrderHandler, newOrderServiceCancelOrderArgs, newOrderServiceCancelOrderResult, false),
-		"ListOrder":    kitex.NewMethodInfo(listOrderHandler, newOrderServiceListOrderArgs, newOrderServiceListOrderResult, false),
-		"GetOrderById": kitex.NewMethodInfo(getOrderByIdHandler, newOrderServiceGetOrderByIdArgs, newOrderServiceGetOrderByIdResult, false),
+		"CreateOrder":     kitex.NewMethodInfo(createOrderHandler, newOrderServiceCreateOrderArgs, newOrderServiceCreateOrderResult, false),
+		"CancelOrder":     kitex.NewMethodInfo(cancelOrderHandler, newOrderServiceCancelOrderArgs, newOrderServiceCancelOrderResult, false),
+		"PayOrder":        kitex.NewMethodInfo(payOrderHandler, newOrderServicePayOrderArgs, newOrderServicePayOrderResult, false),
+		"ShipOrder":       kitex.NewMethodInfo(shipOrderHandler, newOrderServiceShipOrderArgs, newOrderServiceShipOrderResult, false),
+		"DeliverOrder":    kitex.NewMethodInfo(deliverOrderHandler, newOrderServiceDeliverOrderArgs, newOrderServiceDeliverOrderResult, false),
+		"ConfirmReceipt":  kitex.NewMethodInfo(confirmReceiptHandler, newOrderServiceConfirmReceiptArgs, newOrderServiceConfirmReceiptResult, false),
+		"RefundOrder":     kitex.NewMethodInfo(refundOrderHandler, newOrderServiceRefundOrderArgs, newOrderServiceRefundOrderResult, false),
+		"ShopRefundOrder": kitex.NewMethodInfo(shopRefundOrderHandler, newOrderServiceShopRefundOrderArgs, newOrderServiceShopRefundOrderResult, false),
+		"ListOrder":       kitex.NewMethodInfo(listOrderHandler, newOrderServiceListOrderArgs, newOrderServiceListOrderResult, false),
+		"GetOrderById":    kitex.NewMethodInfo(getOrderByIdHandler, newOrderServiceGetOrderByIdArgs, newOrderServiceGetOrderByIdResult, false),
+		"AddCartItem":     kitex.NewMethodInfo(addCartItemHandler, newOrderServiceAddCartItemArgs, newOrderServiceAddCartItemResult, false),
+		"UpdateCartItem":  kitex.NewMethodInfo(updateCartItemHandler, newOrderServiceUpdateCartItemArgs, newOrderServiceUpdateCartItemResult, false),
+		"RemoveCartItem":  kitex.NewMethodInfo(removeCartItemHandler, newOrderServiceRemoveCartItemArgs, newOrderServiceRemoveCartItemResult, false),
+		"GetCart":         kitex.NewMethodInfo(getCartHandler, newOrderServiceGetCartArgs, newOrderServiceGetCartResult, false),
 	}
 	extra := map[string]interface{}{
 		"PackageName": "order",
@@ -32,7 +42,7 @@ func NewServiceInfo() *kitex.ServiceInfo {
 		HandlerType:     handlerType,
 		Methods:         methods,
 		PayloadCodec:    kitex.Thrift,
-		KiteXGenVersion: "v0.4.3",
+		KiteXGenVersion: "v0.6.1",
 		Extra:           extra,
 	}
 	return svcInfo
@@ -74,6 +84,114 @@ func newOrderServiceCancelOrderResult() interface{} {
 	return order.NewOrderServiceCancelOrderResult()
 }
 
+func payOrderHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*order.OrderServicePayOrderArgs)
+	realResult := result.(*order.OrderServicePayOrderResult)
+	success, err := handler.(order.OrderService).PayOrder(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newOrderServicePayOrderArgs() interface{} {
+	return order.NewOrderServicePayOrderArgs()
+}
+
+func newOrderServicePayOrderResult() interface{} {
+	return order.NewOrderServicePayOrderResult()
+}
+
+func shipOrderHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*order.OrderServiceShipOrderArgs)
+	realResult := result.(*order.OrderServiceShipOrderResult)
+	success, err := handler.(order.OrderService).ShipOrder(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newOrderServiceShipOrderArgs() interface{} {
+	return order.NewOrderServiceShipOrderArgs()
+}
+
+func newOrderServiceShipOrderResult() interface{} {
+	return order.NewOrderServiceShipOrderResult()
+}
+
+func deliverOrderHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*order.OrderServiceDeliverOrderArgs)
+	realResult := result.(*order.OrderServiceDeliverOrderResult)
+	success, err := handler.(order.OrderService).DeliverOrder(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newOrderServiceDeliverOrderArgs() interface{} {
+	return order.NewOrderServiceDeliverOrderArgs()
+}
+
+func newOrderServiceDeliverOrderResult() interface{} {
+	return order.NewOrderServiceDeliverOrderResult()
+}
+
+func confirmReceiptHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*order.OrderServiceConfirmReceiptArgs)
+	realResult := result.(*order.OrderServiceConfirmReceiptResult)
+	success, err := handler.(order.OrderService).ConfirmReceipt(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newOrderServiceConfirmReceiptArgs() interface{} {
+	return order.NewOrderServiceConfirmReceiptArgs()
+}
+
+func newOrderServiceConfirmReceiptResult() interface{} {
+	return order.NewOrderServiceConfirmReceiptResult()
+}
+
+func refundOrderHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*order.OrderServiceRefundOrderArgs)
+	realResult := result.(*order.OrderServiceRefundOrderResult)
+	success, err := handler.(order.OrderService).RefundOrder(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newOrderServiceRefundOrderArgs() interface{} {
+	return order.NewOrderServiceRefundOrderArgs()
+}
+
+func newOrderServiceRefundOrderResult() interface{} {
+	return order.NewOrderServiceRefundOrderResult()
+}
+
+func shopRefundOrderHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*order.OrderServiceShopRefundOrderArgs)
+	realResult := result.(*order.OrderServiceShopRefundOrderResult)
+	success, err := handler.(order.OrderService).ShopRefundOrder(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newOrderServiceShopRefundOrderArgs() interface{} {
+	return order.NewOrderServiceShopRefundOrderArgs()
+}
+
+func newOrderServiceShopRefundOrderResult() interface{} {
+	return order.NewOrderServiceShopRefundOrderResult()
+}
+
 func listOrderHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	realArg := arg.(*order.OrderServiceListOrderArgs)
 	realResult := result.(*order.OrderServiceListOrderResult)
@@ -110,6 +228,78 @@ func newOrderServiceGetOrderByIdResult() interface{} {
 	return order.NewOrderServiceGetOrderByIdResult()
 }
 
+func addCartItemHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*order.OrderServiceAddCartItemArgs)
+	realResult := result.(*order.OrderServiceAddCartItemResult)
+	success, err := handler.(order.OrderService).AddCartItem(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newOrderServiceAddCartItemArgs() interface{} {
+	return order.NewOrderServiceAddCartItemArgs()
+}
+
+func newOrderServiceAddCartItemResult() interface{} {
+	return order.NewOrderServiceAddCartItemResult()
+}
+
+func updateCartItemHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*order.OrderServiceUpdateCartItemArgs)
+	realResult := result.(*order.OrderServiceUpdateCartItemResult)
+	success, err := handler.(order.OrderService).UpdateCartItem(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newOrderServiceUpdateCartItemArgs() interface{} {
+	return order.NewOrderServiceUpdateCartItemArgs()
+}
+
+func newOrderServiceUpdateCartItemResult() interface{} {
+	return order.NewOrderServiceUpdateCartItemResult()
+}
+
+func removeCartItemHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*order.OrderServiceRemoveCartItemArgs)
+	realResult := result.(*order.OrderServiceRemoveCartItemResult)
+	success, err := handler.(order.OrderService).RemoveCartItem(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newOrderServiceRemoveCartItemArgs() interface{} {
+	return order.NewOrderServiceRemoveCartItemArgs()
+}
+
+func newOrderServiceRemoveCartItemResult() interface{} {
+	return order.NewOrderServiceRemoveCartItemResult()
+}
+
+func getCartHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*order.OrderServiceGetCartArgs)
+	realResult := result.(*order.OrderServiceGetCartResult)
+	success, err := handler.(order.OrderService).GetCart(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newOrderServiceGetCartArgs() interface{} {
+	return order.NewOrderServiceGetCartArgs()
+}
+
+func newOrderServiceGetCartResult() interface{} {
+	return order.NewOrderServiceGetCartResult()
+}
+
 type kClient struct {
 	c client.Client
 }
@@ -140,6 +330,66 @@ func (p *kClient) CancelOrder(ctx context.Context, req *order.CancelOrderReq) (r
 	return _result.GetSuccess(), nil
 }
 
+func (p *kClient) PayOrder(ctx context.Context, req *order.PayOrderReq) (r *order.PayOrderResp, err error) {
+	var _args order.OrderServicePayOrderArgs
+	_args.Req = req
+	var _result order.OrderServicePayOrderResult
+	if err = p.c.Call(ctx, "PayOrder", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ShipOrder(ctx context.Context, req *order.ShipOrderReq) (r *order.ShipOrderResp, err error) {
+	var _args order.OrderServiceShipOrderArgs
+	_args.Req = req
+	var _result order.OrderServiceShipOrderResult
+	if err = p.c.Call(ctx, "ShipOrder", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) DeliverOrder(ctx context.Context, req *order.DeliverOrderReq) (r *order.DeliverOrderResp, err error) {
+	var _args order.OrderServiceDeliverOrderArgs
+	_args.Req = req
+	var _result order.OrderServiceDeliverOrderResult
+	if err = p.c.Call(ctx, "DeliverOrder", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ConfirmReceipt(ctx context.Context, req *order.ConfirmReceiptReq) (r *order.ConfirmReceiptResp, err error) {
+	var _args order.OrderServiceConfirmReceiptArgs
+	_args.Req = req
+	var _result order.OrderServiceConfirmReceiptResult
+	if err = p.c.Call(ctx, "ConfirmReceipt", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) RefundOrder(ctx context.Context, req *order.RefundOrderReq) (r *order.RefundOrderResp, err error) {
+	var _args order.OrderServiceRefundOrderArgs
+	_args.Req = req
+	var _result order.OrderServiceRefundOrderResult
+	if err = p.c.Call(ctx, "RefundOrder", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ShopRefundOrder(ctx context.Context, req *order.ShopRefundOrderReq) (r *order.ShopRefundOrderResp, err error) {
+	var _args order.OrderServiceShopRefundOrderArgs
+	_args.Req = req
+	var _result order.OrderServiceShopRefundOrderResult
+	if err = p.c.Call(ctx, "ShopRefundOrder", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
 func (p *kClient) ListOrder(ctx context.Context, req *order.ListOrderReq) (r *order.ListOrderResp, err error) {
 	var _args order.OrderServiceListOrderArgs
 	_args.Req = req
@@ -159,3 +409,43 @@ func (p *kClient) GetOrderById(ctx context.Context, req *order.GetOrderByIdReq)
 	}
 	return _result.GetSuccess(), nil
 }
+
+func (p *kClient) AddCartItem(ctx context.Context, req *order.AddCartItemReq) (r *order.AddCartItemResp, err error) {
+	var _args order.OrderServiceAddCartItemArgs
+	_args.Req = req
+	var _result order.OrderServiceAddCartItemResult
+	if err = p.c.Call(ctx, "AddCartItem", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) UpdateCartItem(ctx context.Context, req *order.UpdateCartItemReq) (r *order.UpdateCartItemResp, err error) {
+	var _args order.OrderServiceUpdateCartItemArgs
+	_args.Req = req
+	var _result order.OrderServiceUpdateCartItemResult
+	if err = p.c.Call(ctx, "UpdateCartItem", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) RemoveCartItem(ctx context.Context, req *order.RemoveCartItemReq) (r *order.RemoveCartItemResp, err error) {
+	var _args order.OrderServiceRemoveCartItemArgs
+	_args.Req = req
+	var _result order.OrderServiceRemoveCartItemResult
+	if err = p.c.Call(ctx, "RemoveCartItem", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetCart(ctx context.Context, req *order.GetCartReq) (r *order.GetCartResp, err error) {
+	var _args order.OrderServiceGetCartArgs
+	_args.Req = req
+	var _result order.OrderServiceGetCartResult
+	if err = p.c.Call(ctx, "GetCart", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
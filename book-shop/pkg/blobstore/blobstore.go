@@ -0,0 +1,51 @@
+// Copyright 2023 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package blobstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/cloudwego/biz-demo/book-shop/pkg/conf"
+)
+
+var ErrInvalidKey = errors.New("invalid blob key")
+
+// Store keeps files by key, a key is a relative path separated by slashes such as product/1/2.jpg
+type Store interface {
+	// Put writes the file, an existing one with the key is replaced
+	Put(ctx context.Context, key string, data []byte) error
+	// Delete removes the file, a missing one is not an error
+	Delete(ctx context.Context, key string) error
+	// URL where the file is downloaded from
+	URL(key string) string
+}
+
+var defaultStore = newStore(conf.BlobStore)
+
+// GetStore the store chosen by conf.BlobStore
+func GetStore() Store {
+	return defaultStore
+}
+
+func newStore(kind string) Store {
+	switch kind {
+	case conf.BlobStoreLocal:
+		return NewLocalStore(conf.BlobLocalDir, conf.BlobBaseURL)
+	}
+	panic(fmt.Sprintf("unknown blob store %q", kind))
+}
@@ -15,11 +15,32 @@
 
 package conf
 
+import "time"
+
 const (
 	UserTableName    = "t_user"
 	ProductTableName = "t_product"
 	OrderTableName   = "t_order"
 
+	ProductOutboxTableName           = "t_product_outbox"
+	ProductOutboxDeadLetterTableName = "t_product_outbox_dead_letter"
+	StockReservationTableName        = "t_stock_reservation"
+	ProductHistoryTableName          = "t_product_history"
+	ProductStateScheduleTableName    = "t_product_state_schedule"
+	StockIdempotencyKeyTableName     = "t_stock_idempotency_key"
+	ProductSkuTableName              = "t_product_sku"
+	ProductImportJobTableName        = "t_product_import_job"
+	ProductStockThresholdTableName   = "t_product_stock_threshold"
+	ProductPriceTableName            = "t_product_price"
+	ProductMediaTableName            = "t_product_media"
+	ProductCategoryTableName         = "t_product_category"
+	ProductCategoryRelTableName      = "t_product_category_rel"
+	ProductTagTableName              = "t_product_tag"
+	OrderSagaTableName               = "t_order_saga"
+	OrderLineTableName               = "t_order_line"
+	CartItemTableName                = "t_cart_item"
+	OrderDelayedTaskTableName        = "t_order_delayed_task"
+
 	SecretKey   = "secret key"
 	IdentityKey = "id"
 
@@ -37,6 +58,35 @@ const (
 
 	ProductESIndex = "product"
 
+	// ProductSearchBackend backend of the 2C product reads, use the memory one to run without ES
+	ProductSearchBackend       = ProductSearchBackendES
+	ProductSearchBackendES     = "es"
+	ProductSearchBackendMemory = "memory"
+
+	// LowStockNotifier where the low stock alerts go, LowStockWebhookURL receives them as json when it is the webhook one
+	LowStockNotifier        = LowStockNotifierLog
+	LowStockNotifierLog     = "log"
+	LowStockNotifierWebhook = "webhook"
+	LowStockWebhookURL      = "http://127.0.0.1:8092/low-stock"
+
+	// BlobStore where the uploaded files are kept, the local one writes them under BlobLocalDir
+	// which the facade serves at BlobURLPath
+	BlobStore      = BlobStoreLocal
+	BlobStoreLocal = "local"
+	BlobLocalDir   = "./data/blob"
+	BlobURLPath    = "/media"
+	BlobBaseURL    = "http://" + FacadeServiceAddress + BlobURLPath
+
+	// BookMetadataProvider where the metadata prefilling the new products comes from, the file one
+	// reads a JSON array of books from BookMetadataFile, none turns the prefill off
+	BookMetadataProvider     = BookMetadataProviderFile
+	BookMetadataProviderNone = "none"
+	BookMetadataProviderFile = "file"
+	BookMetadataFile         = "./deploy/metadata/book_metadata.json"
+
+	// OrderPaymentTimeout how long a new order waits for the payment, it is cancelled and its stock given back then
+	OrderPaymentTimeout = 30 * time.Minute
+
 	UserRpcServiceName   = "cwg.bookshop.user"
 	OrderRpcServiceName  = "cwg.bookshop.order"
 	ItemRpcServiceName   = "cwg.bookshop.item"
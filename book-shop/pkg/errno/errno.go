@@ -30,6 +30,15 @@ const (
 	LoginErrCode            = 11001
 	UserNotExistErrCode     = 11002
 	UserAlreadyExistErrCode = 11003
+
+	// Item ErrCode
+	StockNotEnoughErrCode         = 12001
+	ProductVersionConflictErrCode = 12002
+	ISBNAlreadyExistErrCode       = 12003
+	ReservationConfirmedErrCode   = 12004
+
+	// Order ErrCode
+	OrderPermissionErrCode = 13001
 )
 
 type ErrNo struct {
@@ -57,6 +66,15 @@ var (
 	LoginErr            = NewErrNo(LoginErrCode, "Wrong username or password")
 	UserNotExistErr     = NewErrNo(UserNotExistErrCode, "User does not exists")
 	UserAlreadyExistErr = NewErrNo(UserAlreadyExistErrCode, "User already exists")
+	StockNotEnoughErr   = NewErrNo(StockNotEnoughErrCode, "Stock is not enough")
+	// ProductVersionConflictErr the product was changed after it was read, reload and retry
+	ProductVersionConflictErr = NewErrNo(ProductVersionConflictErrCode, "Product has been modified, please reload it")
+	// ISBNAlreadyExistErr another product of the shop has the isbn
+	ISBNAlreadyExistErr = NewErrNo(ISBNAlreadyExistErrCode, "ISBN already exists")
+	// ReservationConfirmedErr the hold to release was confirmed, its stock is taken and has to be reverted instead
+	ReservationConfirmedErr = NewErrNo(ReservationConfirmedErrCode, "Reservation has been confirmed")
+	// OrderPermissionErr the order belongs to another user
+	OrderPermissionErr = NewErrNo(OrderPermissionErrCode, "No permission to the order")
 )
 
 // ConvertErr convert error to Errno
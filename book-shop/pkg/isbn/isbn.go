@@ -0,0 +1,98 @@
+// Copyright 2023 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package isbn validates ISBN-10 and ISBN-13 numbers and normalizes them into the 13 digit form,
+// so that the same book is stored the same way whichever form it was entered in.
+package isbn
+
+import (
+	"errors"
+	"strings"
+)
+
+var ErrInvalid = errors.New("isbn must be a valid ISBN-10 or ISBN-13")
+
+// Normalize strips the hyphens and the spaces, checks the check digit and returns the ISBN-13 form.
+// An ISBN-10 gets the 978 prefix and a recomputed check digit, an empty isbn stays empty.
+func Normalize(s string) (string, error) {
+	digits := strings.Map(func(r rune) rune {
+		if r == '-' || r == ' ' {
+			return -1
+		}
+		if r == 'x' {
+			return 'X'
+		}
+		return r
+	}, strings.TrimSpace(s))
+	switch len(digits) {
+	case 0:
+		return "", nil
+	case 10:
+		if !valid10(digits) {
+			return "", ErrInvalid
+		}
+		prefixed := "978" + digits[:9]
+		return prefixed + string(checkDigit13(prefixed)), nil
+	case 13:
+		if !valid13(digits) {
+			return "", ErrInvalid
+		}
+		return digits, nil
+	}
+	return "", ErrInvalid
+}
+
+// valid10 the weighted sum of the digits is a multiple of 11, the last one can be X for 10
+func valid10(s string) bool {
+	sum := 0
+	for i := 0; i < 10; i++ {
+		var v int
+		switch {
+		case s[i] >= '0' && s[i] <= '9':
+			v = int(s[i] - '0')
+		case s[i] == 'X' && i == 9:
+			v = 10
+		default:
+			return false
+		}
+		sum += (10 - i) * v
+	}
+	return sum%11 == 0
+}
+
+func valid13(s string) bool {
+	for i := 0; i < 13; i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	if !strings.HasPrefix(s, "978") && !strings.HasPrefix(s, "979") {
+		return false
+	}
+	return checkDigit13(s[:12]) == s[12]
+}
+
+// checkDigit13 the check digit of the first 12 digits, which are weighted 1 and 3 in turn
+func checkDigit13(s string) byte {
+	sum := 0
+	for i := 0; i < 12; i++ {
+		v := int(s[i] - '0')
+		if i%2 == 1 {
+			v *= 3
+		}
+		sum += v
+	}
+	return byte('0' + (10-sum%10)%10)
+}
@@ -0,0 +1,189 @@
+// Copyright 2023 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package productio
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/bytedance/sonic"
+)
+
+// maxLineSize the longest JSON line accepted
+const maxLineSize = 1 << 20
+
+// Reader reads the records one by one and returns io.EOF after the last one.
+// A *RowError is returned for a row which can not be decoded, the next call goes on with the following row.
+type Reader interface {
+	Read() (*Record, error)
+}
+
+// NewReader the header of a CSV file is read at once, an unusable header is returned as error
+func NewReader(format string, r io.Reader) (Reader, error) {
+	switch format {
+	case FormatCSV:
+		return newCSVReader(r)
+	case FormatJSONL:
+		scanner := bufio.NewScanner(r)
+		scanner.Buffer(make([]byte, 0, 64*1024), maxLineSize)
+		return &jsonlReader{scanner: scanner}, nil
+	}
+	return nil, ErrUnknownFormat
+}
+
+// Count the number of data rows, a row which can not be decoded is counted as well
+func Count(format string, content []byte) (int, error) {
+	reader, err := NewReader(format, bytes.NewReader(content))
+	if err != nil {
+		return 0, err
+	}
+	n := 0
+	for {
+		_, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return n, nil
+		}
+		var rowErr *RowError
+		if err != nil && !errors.As(err, &rowErr) {
+			return 0, err
+		}
+		n++
+	}
+}
+
+type csvReader struct {
+	reader *csv.Reader
+	index  map[string]int
+	row    int
+}
+
+func newCSVReader(r io.Reader) (*csvReader, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, errors.New("csv header is missing")
+	}
+	if err != nil {
+		return nil, err
+	}
+	index := make(map[string]int, len(header))
+	for i, name := range header {
+		index[name] = i
+	}
+	if _, ok := index["name"]; !ok {
+		return nil, errors.New("csv header has no name column")
+	}
+	return &csvReader{reader: reader, index: index}, nil
+}
+
+func (r *csvReader) Read() (*Record, error) {
+	fields, err := r.reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, io.EOF
+	}
+	r.row++
+	var parseErr *csv.ParseError
+	if errors.As(err, &parseErr) {
+		return nil, &RowError{Row: r.row, Err: err}
+	}
+	if err != nil {
+		return nil, err
+	}
+	record, err := r.decode(fields)
+	if err != nil {
+		return nil, &RowError{Row: r.row, Err: err}
+	}
+	record.Row = r.row
+	return record, nil
+}
+
+func (r *csvReader) decode(fields []string) (*Record, error) {
+	get := func(column string) string {
+		if i, ok := r.index[column]; ok && i < len(fields) {
+			return fields[i]
+		}
+		return ""
+	}
+	getInt := func(column string) (int64, error) {
+		s := get(column)
+		if s == "" {
+			return 0, nil
+		}
+		v, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid %s: %q", column, s)
+		}
+		return v, nil
+	}
+	record := &Record{
+		ProductId:   get("product_id"),
+		Name:        get("name"),
+		Pic:         get("pic"),
+		Description: get("description"),
+		ISBN:        get("isbn"),
+		SpuName:     get("spu_name"),
+		Author:      get("author"),
+	}
+	var err error
+	if record.SpuPrice, err = getInt("spu_price"); err != nil {
+		return nil, err
+	}
+	if record.Price, err = getInt("price"); err != nil {
+		return nil, err
+	}
+	if record.Stock, err = getInt("stock"); err != nil {
+		return nil, err
+	}
+	if record.Status, err = getInt("status"); err != nil {
+		return nil, err
+	}
+	if record.Skus, err = decodeSkus(get("skus")); err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+type jsonlReader struct {
+	scanner *bufio.Scanner
+	row     int
+}
+
+func (r *jsonlReader) Read() (*Record, error) {
+	for r.scanner.Scan() {
+		line := bytes.TrimSpace(r.scanner.Bytes())
+		// blank lines are not rows
+		if len(line) == 0 {
+			continue
+		}
+		r.row++
+		record := &Record{}
+		if err := sonic.Unmarshal(line, record); err != nil {
+			return nil, &RowError{Row: r.row, Err: fmt.Errorf("invalid json: %w", err)}
+		}
+		record.Row = r.row
+		return record, nil
+	}
+	if err := r.scanner.Err(); err != nil {
+		return nil, err
+	}
+	return nil, io.EOF
+}
@@ -0,0 +1,166 @@
+// Copyright 2023 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package productio encodes products as CSV or JSON Lines for the bulk import and export,
+// both sides use the same columns so that an exported file can be imported again.
+package productio
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/bytedance/sonic"
+	"github.com/cloudwego/biz-demo/book-shop/kitex_gen/cwg/bookshop/item"
+)
+
+const (
+	FormatCSV   = "csv"
+	FormatJSONL = "jsonl"
+)
+
+// columns of the CSV header, skus is a JSON array of SkuRecord
+var columns = []string{"product_id", "name", "pic", "description", "isbn", "spu_name", "author", "spu_price", "price", "stock", "status", "skus"}
+
+var ErrUnknownFormat = errors.New("format must be csv or jsonl")
+
+// Record one product of a file, product_id and status are only exported, the import ignores them
+type Record struct {
+	Row         int          `json:"-"`
+	ProductId   string       `json:"product_id,omitempty"`
+	Name        string       `json:"name"`
+	Pic         string       `json:"pic"`
+	Description string       `json:"description"`
+	ISBN        string       `json:"isbn"`
+	SpuName     string       `json:"spu_name"`
+	SpuPrice    int64        `json:"spu_price"`
+	Author      string       `json:"author"`
+	Price       int64        `json:"price"`
+	Stock       int64        `json:"stock"`
+	Status      int64        `json:"status"`
+	Skus        []*SkuRecord `json:"skus,omitempty"`
+}
+
+type SkuRecord struct {
+	SkuId      string            `json:"sku_id,omitempty"`
+	Edition    string            `json:"edition"`
+	Attributes map[string]string `json:"attributes,omitempty"`
+	ISBN       string            `json:"isbn"`
+	Price      int64             `json:"price"`
+	Stock      int64             `json:"stock"`
+}
+
+// RowError a row which can not be decoded, Row counts the data rows from 1
+type RowError struct {
+	Row int
+	Err error
+}
+
+func (e *RowError) Error() string {
+	return fmt.Sprintf("row %d: %v", e.Row, e.Err)
+}
+
+func (e *RowError) Unwrap() error {
+	return e.Err
+}
+
+// ValidFormat reports whether the format is supported
+func ValidFormat(format string) bool {
+	return format == FormatCSV || format == FormatJSONL
+}
+
+// ContentType of the exported file
+func ContentType(format string) string {
+	if format == FormatCSV {
+		return "text/csv; charset=utf-8"
+	}
+	return "application/x-ndjson; charset=utf-8"
+}
+
+// NewRecord the record of an exported product
+func NewRecord(p *item.Product) *Record {
+	r := &Record{
+		ProductId:   strconv.FormatInt(p.ProductId, 10),
+		Name:        p.Name,
+		Pic:         p.Pic,
+		Description: p.Description,
+		Price:       p.Price,
+		Stock:       p.Stock,
+		Status:      int64(p.Status),
+	}
+	if p.Property != nil {
+		r.ISBN = p.Property.Isbn
+		r.SpuName = p.Property.SpuName
+		r.SpuPrice = p.Property.SpuPrice
+		r.Author = p.Property.Author
+	}
+	for _, sku := range p.Skus {
+		r.Skus = append(r.Skus, &SkuRecord{
+			SkuId:      strconv.FormatInt(sku.SkuId, 10),
+			Edition:    sku.Edition,
+			Attributes: sku.Attributes,
+			ISBN:       sku.Isbn,
+			Price:      sku.Price,
+			Stock:      sku.Stock,
+		})
+	}
+	return r
+}
+
+// ToAddReq the request adding the record as a new product, sku ids are not kept
+func (r *Record) ToAddReq(operator string) *item.AddReq {
+	req := &item.AddReq{
+		Name:        r.Name,
+		Pic:         r.Pic,
+		Description: r.Description,
+		Property: &item.BookProperty{
+			Isbn:     r.ISBN,
+			SpuName:  r.SpuName,
+			SpuPrice: r.SpuPrice,
+			Author:   r.Author,
+		},
+		Price:        r.Price,
+		Stock:        r.Stock,
+		OperatorName: &operator,
+	}
+	for _, sku := range r.Skus {
+		req.Skus = append(req.Skus, &item.Sku{
+			Edition:    sku.Edition,
+			Attributes: sku.Attributes,
+			Isbn:       sku.ISBN,
+			Price:      sku.Price,
+			Stock:      sku.Stock,
+		})
+	}
+	return req
+}
+
+func encodeSkus(skus []*SkuRecord) (string, error) {
+	if len(skus) == 0 {
+		return "", nil
+	}
+	return sonic.MarshalString(skus)
+}
+
+func decodeSkus(s string) ([]*SkuRecord, error) {
+	if s == "" {
+		return nil, nil
+	}
+	skus := make([]*SkuRecord, 0)
+	if err := sonic.UnmarshalString(s, &skus); err != nil {
+		return nil, fmt.Errorf("invalid skus: %w", err)
+	}
+	return skus, nil
+}
@@ -0,0 +1,106 @@
+// Copyright 2023 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package productio
+
+import (
+	"bufio"
+	"encoding/csv"
+	"io"
+	"strconv"
+
+	"github.com/bytedance/sonic"
+)
+
+// Writer encodes records, Flush must be called after the last one
+type Writer interface {
+	Write(record *Record) error
+	Flush() error
+}
+
+func NewWriter(format string, w io.Writer) (Writer, error) {
+	switch format {
+	case FormatCSV:
+		return &csvWriter{writer: csv.NewWriter(w)}, nil
+	case FormatJSONL:
+		return &jsonlWriter{writer: bufio.NewWriter(w)}, nil
+	}
+	return nil, ErrUnknownFormat
+}
+
+type csvWriter struct {
+	writer        *csv.Writer
+	headerWritten bool
+}
+
+func (w *csvWriter) writeHeader() error {
+	if w.headerWritten {
+		return nil
+	}
+	w.headerWritten = true
+	return w.writer.Write(columns)
+}
+
+func (w *csvWriter) Write(record *Record) error {
+	if err := w.writeHeader(); err != nil {
+		return err
+	}
+	skus, err := encodeSkus(record.Skus)
+	if err != nil {
+		return err
+	}
+	return w.writer.Write([]string{
+		record.ProductId,
+		record.Name,
+		record.Pic,
+		record.Description,
+		record.ISBN,
+		record.SpuName,
+		record.Author,
+		strconv.FormatInt(record.SpuPrice, 10),
+		strconv.FormatInt(record.Price, 10),
+		strconv.FormatInt(record.Stock, 10),
+		strconv.FormatInt(record.Status, 10),
+		skus,
+	})
+}
+
+// Flush an empty export still gets the header
+func (w *csvWriter) Flush() error {
+	if err := w.writeHeader(); err != nil {
+		return err
+	}
+	w.writer.Flush()
+	return w.writer.Error()
+}
+
+type jsonlWriter struct {
+	writer *bufio.Writer
+}
+
+func (w *jsonlWriter) Write(record *Record) error {
+	line, err := sonic.Marshal(record)
+	if err != nil {
+		return err
+	}
+	if _, err := w.writer.Write(line); err != nil {
+		return err
+	}
+	return w.writer.WriteByte('\n')
+}
+
+func (w *jsonlWriter) Flush() error {
+	return w.writer.Flush()
+}
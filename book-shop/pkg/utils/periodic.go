@@ -0,0 +1,60 @@
+// Copyright 2023 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package utils
+
+import (
+	"context"
+	"time"
+)
+
+// PeriodicRunner calls run every interval in background, the background workers embed it
+// to get their Start and Stop
+type PeriodicRunner struct {
+	interval time.Duration
+	run      func(ctx context.Context)
+	stopCh   chan struct{}
+}
+
+func NewPeriodicRunner(interval time.Duration, run func(ctx context.Context)) *PeriodicRunner {
+	return &PeriodicRunner{
+		interval: interval,
+		run:      run,
+		stopCh:   make(chan struct{}),
+	}
+}
+
+// Start run in background
+func (r *PeriodicRunner) Start() {
+	go r.loop()
+}
+
+// Stop the background runs, a run in progress is not interrupted
+func (r *PeriodicRunner) Stop() {
+	close(r.stopCh)
+}
+
+func (r *PeriodicRunner) loop() {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-r.stopCh:
+			return
+		case <-ticker.C:
+			r.run(context.Background())
+		}
+	}
+}